@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/lyrion-l2/lyrion-node/internal/accounts"
+	"github.com/lyrion-l2/lyrion-node/internal/config"
+)
+
+const accountUsage = `Usage: lyrion-node account <command> [flags]
+
+Commands:
+  new                 Create a new encrypted account
+  list                List accounts in the keystore
+  import <keyfile>    Import a hex-encoded private key from a file
+
+Flags:
+  --keystore <dir>    Keystore directory
+  --password <file>   File holding the account passphrase (prompted if omitted)
+`
+
+// runAccountCommand implements `lyrion-node account new|list|import`.
+func runAccountCommand(cfg *config.Config, args []string) error {
+	if len(args) < 1 {
+		fmt.Print(accountUsage)
+		return fmt.Errorf("missing account command")
+	}
+	cmd := args[0]
+
+	fs := flag.NewFlagSet("account "+cmd, flag.ExitOnError)
+	fs.Usage = func() { fmt.Print(accountUsage) }
+	keystoreDir := fs.String("keystore", cfg.KeystoreDir, "Keystore directory")
+	passwordFile := fs.String("password", "", "File holding the account passphrase")
+	fs.Parse(args[1:])
+
+	am := accounts.NewManager(*keystoreDir, false)
+
+	switch cmd {
+	case "new":
+		passphrase, err := readPassphrase(*passwordFile)
+		if err != nil {
+			return err
+		}
+		account, err := am.NewAccount(passphrase)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("🔑 New account: %s\n", account.Address.Hex())
+		fmt.Printf("   Key file:    %s\n", account.URL.Path)
+
+	case "list":
+		for i, account := range am.Accounts() {
+			fmt.Printf("Account #%d: %s %s\n", i, account.Address.Hex(), account.URL.Path)
+		}
+
+	case "import":
+		if fs.NArg() < 1 {
+			return fmt.Errorf("missing key file")
+		}
+		key, err := crypto.LoadECDSA(fs.Arg(0))
+		if err != nil {
+			return fmt.Errorf("failed to load key: %w", err)
+		}
+		passphrase, err := readPassphrase(*passwordFile)
+		if err != nil {
+			return err
+		}
+		account, err := am.Import(key, passphrase)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("🔑 Imported account: %s\n", account.Address.Hex())
+
+	default:
+		fmt.Print(accountUsage)
+		return fmt.Errorf("unknown account command %q", cmd)
+	}
+	return nil
+}
+
+// readPassphrase reads the passphrase from a file, or prompts for it twice on stdin.
+func readPassphrase(passwordFile string) (string, error) {
+	if passwordFile != "" {
+		return accounts.ReadPassphraseFile(passwordFile)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Passphrase: ")
+	passphrase, _ := reader.ReadString('\n')
+	passphrase = strings.TrimRight(passphrase, "\r\n")
+	if passphrase == "" {
+		return "", accounts.ErrEmptyPassphrase
+	}
+	fmt.Print("Repeat passphrase: ")
+	again, _ := reader.ReadString('\n')
+	if strings.TrimRight(again, "\r\n") != passphrase {
+		return "", fmt.Errorf("passphrases do not match")
+	}
+	return passphrase, nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/lyrion-l2/lyrion-node/internal/accounts"
+)
+
+// devAccountKeys are the well-known Foundry/Anvil keys unlocked in --dev mode.
+// Account #0 (Alice) is funded at genesis and used by the DEX front-end.
+var devAccountKeys = []string{
+	"ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+}
+
+// openDevKeystore imports the dev accounts into a local keystore (empty
+// passphrase, light scrypt) and unlocks them for eth_sendTransaction.
+func openDevKeystore(dir string) (*accounts.Manager, error) {
+	am := accounts.NewManager(dir, true)
+	
+	for _, keyHex := range devAccountKeys {
+		key, err := crypto.HexToECDSA(keyHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dev key: %w", err)
+		}
+		account, err := am.Import(key, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to import dev account: %w", err)
+		}
+		if err := am.Unlock(account.Address, ""); err != nil {
+			return nil, fmt.Errorf("failed to unlock dev account %s: %w", account.Address.Hex(), err)
+		}
+		log.Printf("🔓 Dev account unlocked: %s", account.Address.Hex())
+	}
+	return am, nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/execution"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+// setupGenesis writes the genesis state: the first dev account (Alice) gets
+// 1M LYR, FLR and USDT and seeds the LYR-FLR pool with 500k of each.
+func setupGenesis(executor *execution.Executor, st state.StateDB, chainID *big.Int, aliceKey *ecdsa.PrivateKey) error {
+	alice := crypto.PubkeyToAddress(aliceKey.PublicKey)
+
+	// 1 ETH = 10^18 Wei
+	oneEth := new(big.Int).SetInt64(1000000000000000000)
+	amount := new(big.Int).Mul(big.NewInt(1000000), oneEth) // 1M Tokens (10^24)
+
+	// Use Alice as the initial liquidity provider
+	executor.Mint(alice, amount, amount)      // LYR + FLR
+	executor.MintToken(alice, "USDT", amount) // USDT (1M)
+
+	// Create TX to add liquidity: 500k LYR & 500k FLR
+	liquidityAmount := new(big.Int).Mul(big.NewInt(500000), oneEth)
+
+	tx, err := core.SignTx(&core.Transaction{
+		Type:  core.TxTypeAddLiquidity,
+		Value: liquidityAmount,
+		Nonce: st.GetNonce(alice),
+		Gas:   50000,
+	}, chainID, aliceKey)
+	if err != nil {
+		return fmt.Errorf("failed to sign genesis tx: %v", err)
+	}
+
+	// Force direct execution for genesis (bypass mempool for setup),
+	// but still require a valid signature
+	sender, err := tx.Sender(chainID)
+	if err != nil {
+		return fmt.Errorf("invalid genesis tx signature: %v", err)
+	}
+	if err := executor.ExecuteTransaction(tx, sender); err != nil {
+		return fmt.Errorf("genesis liquidity failed: %v", err)
+	}
+	return nil
+}
+
+// genesisHash identifies the chain: keccak256 of the chain ID and the root of
+// the genesis state. It is computed on a scratch state, so every node gets
+// the same hash however far its own chain has advanced.
+func genesisHash(chainID *big.Int, aliceKey *ecdsa.PrivateKey) (common.Hash, error) {
+	st, err := state.NewInMemoryBadgerStateDB()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	defer st.Close()
+	if err := setupGenesis(execution.NewExecutor(st, chainID), st, chainID, aliceKey); err != nil {
+		return common.Hash{}, err
+	}
+	root, err := st.Commit(true)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(common.BigToHash(chainID).Bytes(), root.Bytes()), nil
+}
@@ -1,15 +1,22 @@
 package main
 
 import (
+	"crypto/ecdsa"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"math/big"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 	
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/lyrion-l2/lyrion-node/internal/accounts"
 	"github.com/lyrion-l2/lyrion-node/internal/api"
 	"github.com/lyrion-l2/lyrion-node/internal/config"
 	"github.com/lyrion-l2/lyrion-node/internal/consensus"
@@ -24,8 +31,62 @@ import (
 func main() {
 	cfg := config.DefaultConfig()
 	
+	// Account management: lyrion-node account new|list|import
+	if len(os.Args) > 1 && os.Args[1] == "account" {
+		if err := runAccountCommand(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		return
+	}
+	
+	flag.BoolVar(&cfg.DevMode, "dev", cfg.DevMode, "Dev mode: unlock local dev accounts and enable eth_sendTransaction")
+	flag.BoolVar(&cfg.VerifierMode, "verifier", cfg.VerifierMode, "Verifier mode: rebuild the chain from L1 batch data and check the submitted roots")
+	followerMode := flag.Bool("follower", !cfg.IsSequencer, "Follower mode: import the blocks of --sequencer.address from P2P instead of producing them")
+	flag.IntVar(&cfg.HTTPPort, "http.port", cfg.HTTPPort, "JSON-RPC port")
+	flag.IntVar(&cfg.P2PPort, "p2p.port", cfg.P2PPort, "P2P listen port (TCP and QUIC)")
+	flag.IntVar(&cfg.P2PMinPeers, "p2p.minpeers", cfg.P2PMinPeers, "Connections kept when trimming")
+	flag.IntVar(&cfg.P2PMaxPeers, "p2p.maxpeers", cfg.P2PMaxPeers, "Connections above which the least useful peers are dropped")
+	flag.BoolVar(&cfg.P2PMDNS, "p2p.mdns", cfg.P2PMDNS, "Discover peers of the same network on the LAN with mDNS (always on with --dev)")
+	bootnodes := flag.String("p2p.bootnodes", strings.Join(cfg.P2PBootnodes, ","), "Comma-separated multiaddrs (/ip4/.../tcp/9000/p2p/<peer ID>) dialled at startup to join the network")
+	staticPeers := flag.String("p2p.staticpeers", strings.Join(cfg.P2PStaticPeers, ","), "Comma-separated multiaddrs of peers kept connected and redialled when they drop")
+	trustedPeers := flag.String("p2p.trustedpeers", strings.Join(cfg.P2PTrustedPeers, ","), "Comma-separated multiaddrs or peer IDs never banned or trimmed")
+	flag.StringVar(&cfg.KeystoreDir, "keystore", cfg.KeystoreDir, "Keystore directory")
+	flag.StringVar(&cfg.SequencerAddress, "sequencer.address", cfg.SequencerAddress, "Keystore account used to sign L2 blocks")
+	flag.StringVar(&cfg.SequencerPasswordFile, "sequencer.password", cfg.SequencerPasswordFile, "Passphrase file for the sequencer account")
+	flag.StringVar(&cfg.BatchSubmitterAddress, "batcher.address", cfg.BatchSubmitterAddress, "Keystore account used to submit batches to L1")
+	flag.StringVar(&cfg.BatchSubmitterPasswordFile, "batcher.password", cfg.BatchSubmitterPasswordFile, "Passphrase file for the batch submitter account")
+	flag.StringVar(&cfg.ChallengerAddress, "challenger.address", cfg.ChallengerAddress, "Keystore account used to challenge invalid batches (verifier mode)")
+	flag.StringVar(&cfg.ChallengerPasswordFile, "challenger.password", cfg.ChallengerPasswordFile, "Passphrase file for the challenger account")
+	flag.StringVar(&cfg.FlareRPC, "l1.rpc", cfg.FlareRPC, "Flare L1 JSON-RPC endpoint")
+	flag.StringVar(&cfg.BridgeAddress, "l1.bridge", cfg.BridgeAddress, "LyrionBridge contract address on L1")
+	flag.StringVar(&cfg.BatchInboxAddress, "l1.batch-inbox", cfg.BatchInboxAddress, "L1 address batch data is published to (default derived from the network ID)")
+	flag.Uint64Var(&cfg.L1Confirmations, "l1.confirmations", cfg.L1Confirmations, "L1 confirmations before a deposit is credited or a batch is confirmed")
+	flag.Uint64Var(&cfg.L1StartBlock, "l1.start-block", cfg.L1StartBlock, "L1 block to start scanning for deposits (bridge deployment block)")
+	flag.Uint64Var(&cfg.L1FeeBumpPercent, "l1.fee-bump", cfg.L1FeeBumpPercent, "Percent fee increase when replacing a stuck L1 tx")
+	flag.DurationVar(&cfg.L1ResubmitInterval, "l1.resubmit-interval", cfg.L1ResubmitInterval, "How long an L1 tx may stay pending before its fees are bumped")
+	flag.Uint64Var(&cfg.L1MaxFeeGwei, "l1.max-fee", cfg.L1MaxFeeGwei, "Maximum L1 fee per gas in gwei (0 = no cap)")
+	flag.Uint64Var(&cfg.L1MinBalance, "l1.min-balance", cfg.L1MinBalance, "Alert when the batch submitter holds less FLR than this")
+	flag.Uint64Var(&cfg.BatchMaxBlocks, "batch.max-blocks", cfg.BatchMaxBlocks, "Close a batch once this many L2 blocks are pending (0 = no limit)")
+	flag.Uint64Var(&cfg.BatchMaxBytes, "batch.max-bytes", cfg.BatchMaxBytes, "Close a batch once its compressed data reaches this many bytes (0 = no limit)")
+	flag.DurationVar(&cfg.BatchMaxAge, "batch.max-age", cfg.BatchMaxAge, "Close a batch once its oldest block is this old (0 = no limit)")
+	flag.Uint64Var(&cfg.BatchGasPriceGwei, "batch.gas-price", cfg.BatchGasPriceGwei, "Settle pending blocks early while the L1 gas price is at or below this many gwei (0 = off)")
+	flag.DurationVar(&cfg.BatchPollInterval, "batch.poll-interval", cfg.BatchPollInterval, "How often the batching policy is checked")
+	flag.StringVar(&cfg.OracleFtsoAddress, "oracle.ftso", cfg.OracleFtsoAddress, "FTSOv2 contract on L1 to read price feeds from (empty = no price oracle)")
+	oracleFeeds := flag.String("oracle.feeds", strings.Join(cfg.OracleFeeds, ","), "Comma-separated symbols of the FTSO USD feeds posted to L2")
+	flag.DurationVar(&cfg.OracleInterval, "oracle.interval", cfg.OracleInterval, "How often the FTSO price feeds are read")
+	flag.Parse()
+	cfg.OracleFeeds = priceFeeds(*oracleFeeds)
+	cfg.P2PBootnodes = config.SplitList(*bootnodes)
+	cfg.P2PStaticPeers = config.SplitList(*staticPeers)
+	cfg.P2PTrustedPeers = config.SplitList(*trustedPeers)
+	cfg.IsSequencer = !*followerMode
+	if *followerMode && cfg.VerifierMode {
+		log.Fatalf("--follower and --verifier are exclusive: followers sync from P2P, verifiers from L1")
+	}
+	
 	fmt.Println("🚀 Starting LYRION L2 Node...")
 	fmt.Printf("🌌 Network ID: %d\n", cfg.NetworkID)
+	chainID := new(big.Int).SetUint64(cfg.NetworkID)
 	
 	stateDB, err := state.NewBadgerStateDB(cfg.DataDir)
 	if err != nil {
@@ -33,93 +94,228 @@ func main() {
 	}
 	defer stateDB.Close()
 	
-	executor := execution.NewExecutor(stateDB)
-	mp := mempool.NewMempool()
+	executor := execution.NewExecutor(stateDB, chainID)
+	mp := mempool.NewMempool(chainID)
+	
+	// Alice (Foundry Default Account #0)
+	aliceKey, err := crypto.HexToECDSA(devAccountKeys[0])
+	if err != nil {
+		log.Fatalf("Invalid genesis key: %v", err)
+	}
+	alice := crypto.PubkeyToAddress(aliceKey.PublicKey)
+	
+	// Keys are loaded by address from the encrypted keystore
+	am := accounts.NewManager(cfg.KeystoreDir, false)
 	
 	// Sequencer (Miner)
-	sequencerAddr := common.HexToAddress("0x9999999999999999999999999999999999999999")
-	seq := consensus.NewSequencer(stateDB, mp, executor, sequencerAddr)
+	var sequencerKey *ecdsa.PrivateKey
+	if !cfg.IsSequencer {
+		// Followers only check the sequencer's seal, no key needed
+	} else if cfg.SequencerAddress != "" {
+		sequencerKey, err = am.LoadKey(common.HexToAddress(cfg.SequencerAddress), cfg.SequencerPasswordFile)
+		if err != nil {
+			log.Fatalf("Failed to load sequencer key: %v", err)
+		}
+	} else if cfg.DevMode {
+		sequencerKey = aliceKey // Dev chains are sequenced by the first dev account
+	} else if cfg.VerifierMode {
+		cfg.IsSequencer = false // Blocks come from L1, no key needed
+	} else {
+		log.Fatalf("No sequencer key configured: create one with `lyrion-node account new` and pass --sequencer.address/--sequencer.password (or run with --dev)")
+	}
+	seq := consensus.NewSequencer(stateDB, mp, executor, chainID, sequencerKey)
+	
+	// Blocks must be sealed by this address, on import and on gossip
+	var sequencerAddr common.Address
+	switch {
+	case sequencerKey != nil:
+		sequencerAddr = crypto.PubkeyToAddress(sequencerKey.PublicKey)
+	case cfg.SequencerAddress != "":
+		sequencerAddr = common.HexToAddress(cfg.SequencerAddress)
+	case cfg.DevMode:
+		sequencerAddr = alice // Dev chains are sequenced by the first dev account
+	}
 	
 	// 2. Genesis State & AMM Setup
-	// Alice (Foundry Default Account #0)
-	// Private Key: 0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80
-	alice := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
 	pool := stateDB.GetPool("LYR-FLR")
 	
 	// If pool is empty, bootstrap it
 	if pool.TotalSupply.Cmp(big.NewInt(0)) == 0 {
 		fmt.Println("🌱 Bootstrapping Genesis State & Liquidity Pool...")
-		
-		// 1 ETH = 10^18 Wei
-		oneEth := new(big.Int).SetInt64(1000000000000000000)
-		amount := new(big.Int).Mul(big.NewInt(1000000), oneEth) // 1M Tokens (10^24)
-		
-		// Use Alice as the initial liquidity provider
-		executor.Mint(alice, amount, amount) // LYR + FLR
-		executor.MintToken(alice, "USDT", amount) // USDT (1M) 
-		
-		// Create TX to add liquidity: 500k LYR & 500k FLR
-		liquidityAmount := new(big.Int).Mul(big.NewInt(500000), oneEth)
-		
-		tx := &core.Transaction{
-			Type:  core.TxTypeAddLiquidity,
-			From:  &alice,
-			Value: liquidityAmount, 
-			Nonce: stateDB.GetNonce(alice),
-			Gas:   50000,
-		}
-		
-		// Force direct execution for genesis (bypass mempool for setup)
-		err := executor.ExecuteTransaction(tx, alice)
-		if err != nil {
-			log.Fatalf("Genesis Liquidity Failed: %v", err)
+		if err := setupGenesis(executor, stateDB, chainID, aliceKey); err != nil {
+			log.Fatalf("Genesis setup failed: %v", err)
 		}
 		fmt.Println("💧 Initial Liquidity Added: 500k LYR / 500k FLR")
 	}
+	genesis, err := genesisHash(chainID, aliceKey)
+	if err != nil {
+		log.Fatalf("Failed to compute genesis hash: %v", err)
+	}
+	fmt.Printf("🌱 Genesis: %s\n", genesis.Hex())
+	
+	// Followers import and re-execute the sequencer's blocks
+	var follower *consensus.Follower
+	if !cfg.IsSequencer && !cfg.VerifierMode {
+		if sequencerAddr == (common.Address{}) {
+			log.Fatalf("Follower mode needs the sequencer address blocks must be signed by (--sequencer.address)")
+		}
+		follower = consensus.NewFollower(seq, executor, stateDB, chainID, sequencerAddr)
+		fmt.Printf("👥 Follower mode: importing blocks sealed by %s\n", sequencerAddr.Hex())
+	}
 	
 	// 3. Start P2P Node
 	p2pCfg := &node.P2PConfig{
-		ListenPort: 9000,
-		EnableMDNS: true,
+		ListenPort:     cfg.P2PPort,
+		BootstrapPeers: cfg.P2PBootnodes,
+		StaticPeers:    cfg.P2PStaticPeers,
+		TrustedPeers:   cfg.P2PTrustedPeers,
+		EnableMDNS:     cfg.P2PMDNS || cfg.DevMode,
+		ChainID:        cfg.NetworkID,
+		GenesisHash:    genesis,
+		Sequencer:      sequencerAddr,
+		DataDir:        cfg.DataDir,
+		MinPeers:       cfg.P2PMinPeers,
+		MaxPeers:       cfg.P2PMaxPeers,
 	}
 	
+	var syncer *node.SyncManager
 	p2pNode, err := node.NewP2PNode(p2pCfg)
 	if err != nil {
 		log.Printf("⚠️ Failed to start P2P node: %v", err)
 	} else {
-		p2pNode.Start()
+		p2pNode.SetChain(seq) // Serve our blocks to syncing peers
+		
+		// Followers catch up from peers before relying on gossip
+		if follower != nil {
+			syncer = node.NewSyncManager(p2pNode, seq, func(block *core.Block) error {
+				if err := follower.ImportBlock(block); err != nil && !errors.Is(err, consensus.ErrKnownBlock) {
+					return err
+				}
+				return nil
+			})
+		}
 		
-		// Handle incoming transactions
-		p2pNode.SetTxHandler(func(tx *core.Transaction) {
-			if err := mp.Add(tx); err == nil {
+		// Handle incoming transactions (signature verified by the mempool).
+		// Only admitted txs are relayed to other peers.
+		p2pNode.SetTxHandler(func(tx *core.Transaction) error {
+			err := mp.Ingest(tx) // Validators run in parallel, their txs are verified in batches
+			if err == nil {
 				log.Printf("📥 P2P: Received new tx from %s", tx.From.Hex())
+			} else if !errors.Is(err, mempool.ErrTxExists) {
+				log.Printf("⚠️ P2P: Rejected tx: %v", err)
+			}
+			return err
+		})
+		
+		// Gossip every tx admitted to the mempool (RPC or P2P), so any node's
+		// RPC reaches the sequencer. Txs from peers are already known to the
+		// P2P node and not sent again.
+		mp.SetAddHandler(func(tx *core.Transaction) {
+			if err := p2pNode.BroadcastTransaction(tx); err != nil {
+				log.Printf("⚠️ P2P: Failed to gossip tx %s: %v", tx.Hash().Hex(), err)
 			}
 		})
 		
-		// Handle incoming blocks (Logging only for Sequencer)
+		// Handle incoming blocks: followers import them, the sequencer only logs
 		p2pNode.SetBlockHandler(func(block *core.Block) {
-			log.Printf("📥 P2P: Received Block #%d with %d txs", block.Header.Number, len(block.Transactions))
+			if follower == nil {
+				log.Printf("📥 P2P: Received Block #%d with %d txs", block.Header.Number, len(block.Transactions))
+				return
+			}
+			if syncer.Syncing() {
+				return // Fetched by the sync anyway
+			}
+			switch err := follower.ImportBlock(block); {
+			case err == nil:
+				log.Printf("📥 P2P: Imported Block #%d with %d txs", block.Header.Number, len(block.Transactions))
+			case errors.Is(err, consensus.ErrKnownBlock):
+			case errors.Is(err, consensus.ErrFutureBlock):
+				syncer.Trigger() // Fell behind
+			default:
+				log.Printf("⚠️ P2P: Rejected block: %v", err)
+			}
 		})
+		
+		p2pNode.Start()
+		if syncer != nil {
+			syncer.Start()
+		}
 	}
 
 	// 4. Start API Server
-	rpcServer := api.NewServer(stateDB, mp, seq)
+	rpcServer := api.NewServer(stateDB, mp, seq, chainID)
+	rpcServer.SetIntentRelayer(sequencerKey) // The sequencer relays signed DEX intents (gasless for users)
+	if cfg.DevMode {
+		devAccounts, err := openDevKeystore(cfg.DevKeystoreDir)
+		if err != nil {
+			log.Fatalf("Failed to open dev keystore: %v", err)
+		}
+		rpcServer.SetDevKeystore(devAccounts.KeyStore())
+		fmt.Println("🧪 Dev mode: eth_sendTransaction enabled for unlocked accounts")
+	}
+	rpcServer.SetP2PNode(p2pNode)
 	rpcServer.StartHTTP(cfg.HTTPPort)
 	
+	if follower != nil {
+		rpcServer.SetFollower(follower)
+		rpcServer.SetSyncManager(syncer)
+		waitForShutdown("Follower")
+		if p2pNode != nil {
+			p2pNode.Close()
+		}
+		return
+	}
+	
+	if cfg.VerifierMode {
+		runVerifier(cfg, stateDB, executor, seq, rpcServer, chainID, sequencerAddr)
+		if p2pNode != nil {
+			p2pNode.Close()
+		}
+		return
+	}
+	
 	// 5. Start L1 Settlement Relayer
-	l1PrivKey := cfg.BatchSubmitterPri
-	if l1PrivKey == "" {
-		l1PrivKey = "demo" // Fallback to demo mode if no private key configured
+	var batcherKey *ecdsa.PrivateKey // nil falls back to demo mode
+	if cfg.BatchSubmitterAddress != "" {
+		batcherKey, err = am.LoadKey(common.HexToAddress(cfg.BatchSubmitterAddress), cfg.BatchSubmitterPasswordFile)
+		if err != nil {
+			log.Fatalf("Failed to load batch submitter key: %v", err)
+		}
 	}
-	relayer, err := settlement.NewRelayer(cfg.FlareRPC, seq, l1PrivKey, 2) // Settle every 2 blocks
+	relayer, err := settlement.NewRelayer(cfg.FlareRPC, common.HexToAddress(cfg.BridgeAddress), seq, stateDB, batcherKey, batchPolicy(cfg))
 	if err != nil {
 		log.Printf("⚠️ Failed to create relayer: %v (continuing without L1 settlement)", err)
 	} else {
+		relayer.SetConfirmations(cfg.L1Confirmations)
+		relayer.SetTxConfig(l1TxConfig(cfg))
+		relayer.SetBatchInbox(batchInbox(cfg))
 		relayer.Start()
 		rpcServer.SetRelayer(relayer)
 	}
 	
-	// 6. Block Production Loop
+	// 6. Credit L1 deposits on L2
+	if cfg.BridgeAddress != "" {
+		watcher, err := settlement.NewDepositWatcher(cfg.FlareRPC, common.HexToAddress(cfg.BridgeAddress), stateDB, seq, cfg.L1Confirmations, cfg.L1StartBlock)
+		if err != nil {
+			log.Printf("⚠️ Failed to start deposit watcher: %v (L1 deposits won't be credited)", err)
+		} else {
+			watcher.Start()
+			rpcServer.SetDepositWatcher(watcher)
+		}
+	}
+	
+	// Post FTSO prices to L2 state
+	if cfg.OracleFtsoAddress != "" {
+		oracle, err := settlement.NewPriceOracle(cfg.FlareRPC, common.HexToAddress(cfg.OracleFtsoAddress), stateDB, seq, cfg.OracleFeeds, cfg.L1Confirmations, cfg.OracleInterval)
+		if err != nil {
+			log.Printf("⚠️ Failed to start price oracle: %v (no FTSO prices on L2)", err)
+		} else {
+			oracle.Start()
+			rpcServer.SetPriceOracle(oracle)
+		}
+	}
+	
+	// 7. Block Production Loop
 	fmt.Println("⏳ Starting Block Production Loop (3s)...")
 	ticker := time.NewTicker(3 * time.Second)
 	done := make(chan bool)
@@ -128,7 +324,7 @@ func main() {
 		for {
 			select {
 			case <-ticker.C:
-				if mp.Len() > 0 {
+				if mp.Len() > 0 || seq.PendingDeposits() > 0 || seq.HasPriceUpdate() {
 					block, err := seq.ProduceBlock()
 					if err != nil {
 						log.Printf("❌ Mining Error: %v", err)
@@ -148,7 +344,7 @@ func main() {
 		}
 	}()
 	
-	// 7. Handle Shutdown
+	// 8. Handle Shutdown
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 	
@@ -159,3 +355,102 @@ func main() {
 	}
 	done <- true
 }
+
+// l1TxConfig converts the L1 transaction flags to tx manager settings.
+func l1TxConfig(cfg *config.Config) settlement.TxConfig {
+	txCfg := settlement.DefaultTxConfig()
+	txCfg.FeeBumpPercent = cfg.L1FeeBumpPercent
+	txCfg.ResubmitInterval = cfg.L1ResubmitInterval
+	txCfg.MaxFeeCap = nil
+	if cfg.L1MaxFeeGwei > 0 {
+		txCfg.MaxFeeCap = new(big.Int).Mul(new(big.Int).SetUint64(cfg.L1MaxFeeGwei), big.NewInt(params.GWei))
+	}
+	txCfg.MinBalance = new(big.Int).Mul(new(big.Int).SetUint64(cfg.L1MinBalance), big.NewInt(params.Ether))
+	return txCfg
+}
+
+// batchPolicy builds the relayer's batching policy from the config.
+func batchPolicy(cfg *config.Config) settlement.BatchPolicy {
+	policy := settlement.BatchPolicy{
+		MaxBlocks:    cfg.BatchMaxBlocks,
+		MaxBytes:     cfg.BatchMaxBytes,
+		MaxAge:       cfg.BatchMaxAge,
+		PollInterval: cfg.BatchPollInterval,
+	}
+	if cfg.BatchGasPriceGwei > 0 {
+		policy.GasPriceCap = new(big.Int).Mul(new(big.Int).SetUint64(cfg.BatchGasPriceGwei), big.NewInt(params.GWei))
+	}
+	if policy.MaxBlocks == 0 && policy.MaxBytes == 0 && policy.MaxAge == 0 && policy.GasPriceCap == nil {
+		log.Fatalf("Batching policy has no limit set: batches would never close (set --batch.max-blocks, --batch.max-bytes, --batch.max-age or --batch.gas-price)")
+	}
+	return policy
+}
+
+// priceFeeds splits the --oracle.feeds list into upper case symbols.
+func priceFeeds(list string) []string {
+	var symbols []string
+	for _, symbol := range strings.Split(list, ",") {
+		if symbol = strings.ToUpper(strings.TrimSpace(symbol)); symbol != "" {
+			symbols = append(symbols, symbol)
+		}
+	}
+	return symbols
+}
+
+// batchInbox returns the L1 address batch data is published to.
+func batchInbox(cfg *config.Config) common.Address {
+	if cfg.BatchInboxAddress != "" {
+		return common.HexToAddress(cfg.BatchInboxAddress)
+	}
+	return settlement.DefaultBatchInbox(cfg.NetworkID)
+}
+
+// runVerifier derives the chain from L1 instead of producing blocks, until
+// the node is stopped.
+func runVerifier(cfg *config.Config, stateDB state.StateDB, executor *execution.Executor, seq *consensus.Sequencer, rpcServer *api.Server, chainID *big.Int, sequencerAddr common.Address) {
+	if cfg.BridgeAddress == "" {
+		log.Fatalf("Verifier mode needs the LyrionBridge address (--l1.bridge or LYRION_BRIDGE_ADDRESS)")
+	}
+	verifier, err := settlement.NewVerifier(cfg.FlareRPC, common.HexToAddress(cfg.BridgeAddress), batchInbox(cfg),
+		stateDB, executor, seq, chainID, cfg.L1Confirmations, cfg.L1StartBlock)
+	if err != nil {
+		log.Fatalf("Failed to start verifier: %v", err)
+	}
+	if sequencerAddr != (common.Address{}) {
+		verifier.SetSequencerAddress(sequencerAddr)
+	} else {
+		log.Printf("⚠️ No --sequencer.address: derived blocks are credited to the bridge batcher, which must then be the L2 sequencer too")
+	}
+	if cfg.ChallengerAddress != "" {
+		am := accounts.NewManager(cfg.KeystoreDir, false)
+		challengerKey, err := am.LoadKey(common.HexToAddress(cfg.ChallengerAddress), cfg.ChallengerPasswordFile)
+		if err != nil {
+			log.Fatalf("Failed to load challenger key: %v", err)
+		}
+		challenger, err := settlement.NewChallenger(cfg.FlareRPC, common.HexToAddress(cfg.BridgeAddress), stateDB, challengerKey)
+		if err != nil {
+			log.Fatalf("Failed to start challenger: %v", err)
+		}
+		challenger.SetTxConfig(l1TxConfig(cfg))
+		challenger.Start()
+		verifier.SetChallenger(challenger)
+		rpcServer.SetChallenger(challenger)
+	}
+	if cfg.OracleFtsoAddress != "" {
+		if err := verifier.SetPriceFeed(common.HexToAddress(cfg.OracleFtsoAddress)); err != nil {
+			log.Fatalf("Failed to bind FTSOv2: %v", err)
+		}
+	}
+	verifier.Start()
+	rpcServer.SetVerifier(verifier)
+	
+	waitForShutdown("Verifier")
+}
+
+// waitForShutdown blocks until the node is stopped.
+func waitForShutdown(mode string) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+	fmt.Printf("\n🛑 Shutting down LYRION %s...\n", mode)
+}
@@ -43,6 +43,12 @@ contract LyrionBridge is Ownable, ReentrancyGuard {
     /// @notice Deposit nonce counter
     uint256 public depositNonce;
 
+    /// @notice Accounts allowed to challenge batches (verifier operators)
+    mapping(address => bool) public challengers;
+
+    /// @notice Number of times a batch number was invalidated by a challenge
+    mapping(uint256 => uint256) public batchInvalidations;
+
     // ============ Events ============
 
     event DepositInitiated(
@@ -75,6 +81,17 @@ contract LyrionBridge is Ownable, ReentrancyGuard {
     );
     event ChallengePeriodUpdated(uint256 oldPeriod, uint256 newPeriod);
 
+    event BatchChallenged(
+        uint256 indexed batchNumber,
+        address indexed challenger,
+        bytes32 claimedRoot,
+        bytes32 derivedRoot,
+        bytes32 proofHash,
+        uint256 timestamp
+    );
+
+    event ChallengerUpdated(address indexed challenger, bool allowed);
+
     // ============ Errors ============
 
     error InvalidSequencer();
@@ -85,6 +102,8 @@ contract LyrionBridge is Ownable, ReentrancyGuard {
     error BatchNotFinalized();
     error InsufficientBridgeBalance();
     error TransferFailed();
+    error NotChallenger();
+    error BatchNotChallengeable();
 
     // ============ Constructor ============
 
@@ -179,11 +198,7 @@ contract LyrionBridge is Ownable, ReentrancyGuard {
         bytes32[] calldata proof
     ) external nonReentrant {
         // Verify batch is finalized (challenge period passed)
-        if (
-            block.timestamp < batchSubmissionTime[batchNumber] + challengePeriod
-        ) {
-            revert BatchNotFinalized();
-        }
+        if (!_isFinalized(batchNumber)) revert BatchNotFinalized();
 
         // Create withdrawal hash
         bytes32 withdrawalHash = keccak256(
@@ -219,6 +234,50 @@ contract LyrionBridge is Ownable, ReentrancyGuard {
         );
     }
 
+    // ============ Challenge Functions ============
+
+    /**
+     * @notice Invalidate a batch whose state root does not match re-execution
+     * @dev This is a permissioned challenge, not a fraud proof: nothing is
+     * verified on-chain, so any registered challenger can drop every
+     * unfinalized batch. Only register accounts trusted as much as the owner.
+     * proofHash lets anyone check the published dispute off-chain (see the
+     * node's challenger). Later batches build on the invalid state, so they
+     * are dropped as well and the sequencer has to resubmit from batchNumber.
+     * @param batchNumber First invalid batch
+     * @param derivedRoot Root the challenger derived from L1 data
+     * @param proofHash keccak256 of the published dispute and step proof
+     */
+    function challengeBatch(
+        uint256 batchNumber,
+        bytes32 derivedRoot,
+        bytes32 proofHash
+    ) external {
+        if (!challengers[msg.sender]) revert NotChallenger();
+        if (
+            batchNumber == 0 ||
+            batchNumber > currentBatchNumber ||
+            _isFinalized(batchNumber)
+        ) revert BatchNotChallengeable();
+
+        bytes32 claimedRoot = batchStateRoots[batchNumber];
+        for (uint256 n = batchNumber; n <= currentBatchNumber; n++) {
+            delete batchStateRoots[n];
+            delete batchSubmissionTime[n];
+            batchInvalidations[n]++;
+        }
+        currentBatchNumber = batchNumber - 1;
+
+        emit BatchChallenged(
+            batchNumber,
+            msg.sender,
+            claimedRoot,
+            derivedRoot,
+            proofHash,
+            block.timestamp
+        );
+    }
+
     // ============ View Functions ============
 
     /**
@@ -233,7 +292,7 @@ contract LyrionBridge is Ownable, ReentrancyGuard {
     {
         stateRoot = batchStateRoots[batchNumber];
         submissionTime = batchSubmissionTime[batchNumber];
-        isFinalized = block.timestamp >= submissionTime + challengePeriod;
+        isFinalized = _isFinalized(batchNumber);
     }
 
     /**
@@ -242,9 +301,14 @@ contract LyrionBridge is Ownable, ReentrancyGuard {
     function isBatchFinalized(
         uint256 batchNumber
     ) external view returns (bool) {
+        return _isFinalized(batchNumber);
+    }
+
+    /// @dev A batch is final once it exists and its challenge period passed
+    function _isFinalized(uint256 batchNumber) internal view returns (bool) {
+        uint256 submittedAt = batchSubmissionTime[batchNumber];
         return
-            block.timestamp >=
-            batchSubmissionTime[batchNumber] + challengePeriod;
+            submittedAt != 0 && block.timestamp >= submittedAt + challengePeriod;
     }
 
     /**
@@ -290,6 +354,14 @@ contract LyrionBridge is Ownable, ReentrancyGuard {
         emit ChallengePeriodUpdated(oldPeriod, _period);
     }
 
+    /**
+     * @notice Allow or revoke a batch challenger
+     */
+    function setChallenger(address challenger, bool allowed) external onlyOwner {
+        challengers[challenger] = allowed;
+        emit ChallengerUpdated(challenger, allowed);
+    }
+
     /**
      * @notice Emergency withdrawal (owner only, for emergencies)
      */
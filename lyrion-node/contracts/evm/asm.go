@@ -0,0 +1,323 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// program is an EVM assembler with labels and a few expression helpers.
+// Expressions (expr) push exactly one value, statements leave the stack as
+// they found it.
+//
+// Memory layout: 0x00-0x3f is scratch space for mapping slots, locals live
+// at 0x80 + 32*i, 0x200-0x2ff holds packed hash inputs and 0x300 onwards
+// is the output buffer of returns, logs and reverts. Expressions only use
+// memory below 0x300, so output can be built up while they run.
+type program struct {
+	code   []byte
+	labels map[string]int
+	refs   map[int]string // Offset of a 2-byte label placeholder -> label
+	next   int
+
+	// Signatures of the events emitted and errors raised
+	events, errors map[string]bool
+}
+
+const (
+	memLocals = 0x80
+	memHash   = 0x200
+	memOut    = 0x300
+)
+
+type expr func(p *program)
+
+func newProgram() *program {
+	return &program{
+		labels: make(map[string]int),
+		refs:   make(map[int]string),
+		events: make(map[string]bool),
+		errors: make(map[string]bool),
+	}
+}
+
+func (p *program) op(ops ...vm.OpCode) {
+	for _, o := range ops {
+		p.code = append(p.code, byte(o))
+	}
+}
+
+// push pushes v with the smallest PUSHn. PUSH0 is avoided, so the code
+// also runs before Shanghai.
+func (p *program) push(v *big.Int) {
+	b := v.Bytes()
+	if len(b) == 0 {
+		b = []byte{0}
+	}
+	if len(b) > 32 {
+		panic(fmt.Sprintf("push: %d bytes", len(b)))
+	}
+	p.code = append(p.code, byte(vm.PUSH1)+byte(len(b)-1))
+	p.code = append(p.code, b...)
+}
+
+func (p *program) pushU(v uint64) { p.push(new(big.Int).SetUint64(v)) }
+
+// fresh returns a new unique label name.
+func (p *program) fresh(name string) string {
+	p.next++
+	return fmt.Sprintf("%s#%d", name, p.next)
+}
+
+// label places a jump destination.
+func (p *program) label(name string) {
+	if _, ok := p.labels[name]; ok {
+		panic("duplicate label " + name)
+	}
+	p.labels[name] = len(p.code)
+	p.op(vm.JUMPDEST)
+}
+
+func (p *program) pushLabel(name string) {
+	p.op(vm.PUSH2)
+	p.refs[len(p.code)] = name
+	p.code = append(p.code, 0, 0)
+}
+
+func (p *program) jump(name string) {
+	p.pushLabel(name)
+	p.op(vm.JUMP)
+}
+
+// jumpIf jumps to name if cond is non-zero.
+func (p *program) jumpIf(cond expr, name string) {
+	cond(p)
+	p.pushLabel(name)
+	p.op(vm.JUMPI)
+}
+
+// assemble resolves the labels.
+func (p *program) assemble() ([]byte, error) {
+	code := append([]byte(nil), p.code...)
+	for at, name := range p.refs {
+		dest, ok := p.labels[name]
+		if !ok {
+			return nil, fmt.Errorf("undefined label %s", name)
+		}
+		if dest > 0xffff {
+			return nil, fmt.Errorf("label %s out of PUSH2 range", name)
+		}
+		binary.BigEndian.PutUint16(code[at:], uint16(dest))
+	}
+	return code, nil
+}
+
+// Expressions
+
+func num(v uint64) expr { return func(p *program) { p.pushU(v) } }
+
+func bignum(v *big.Int) expr { return func(p *program) { p.push(v) } }
+
+// word pushes b as a left-aligned 32-byte word (e.g. a selector or string).
+func word(b []byte) expr {
+	w := make([]byte, 32)
+	copy(w, b)
+	return bignum(new(big.Int).SetBytes(w))
+}
+
+func opExpr(o vm.OpCode) expr { return func(p *program) { p.op(o) } }
+
+var (
+	caller       = opExpr(vm.CALLER)
+	callvalue    = opExpr(vm.CALLVALUE)
+	timestamp    = opExpr(vm.TIMESTAMP)
+	selfbalance  = opExpr(vm.SELFBALANCE)
+	calldatasize = opExpr(vm.CALLDATASIZE)
+)
+
+// unary applies o to a.
+func unary(o vm.OpCode, a expr) expr {
+	return func(p *program) {
+		a(p)
+		p.op(o)
+	}
+}
+
+// binop applies o with a on top of the stack: o(a, b), e.g. SUB gives a-b
+// and LT gives a<b.
+func binop(o vm.OpCode, a, b expr) expr {
+	return func(p *program) {
+		b(p)
+		a(p)
+		p.op(o)
+	}
+}
+
+func add(a, b expr) expr   { return binop(vm.ADD, a, b) }
+func sub(a, b expr) expr   { return binop(vm.SUB, a, b) }
+func mul(a, b expr) expr   { return binop(vm.MUL, a, b) }
+func lt(a, b expr) expr    { return binop(vm.LT, a, b) }
+func gt(a, b expr) expr    { return binop(vm.GT, a, b) }
+func eq(a, b expr) expr    { return binop(vm.EQ, a, b) }
+func and(a, b expr) expr   { return binop(vm.AND, a, b) }
+func or(a, b expr) expr    { return binop(vm.OR, a, b) }
+func iszero(a expr) expr   { return unary(vm.ISZERO, a) }
+func sload(slot expr) expr { return unary(vm.SLOAD, slot) }
+func mload(off expr) expr  { return unary(vm.MLOAD, off) }
+func cload(off expr) expr  { return unary(vm.CALLDATALOAD, off) }
+
+// shl shifts v left by bits, shr shifts it right.
+func shl(bits uint64, v expr) expr { return binop(vm.SHL, num(bits), v) }
+func shr(bits uint64, v expr) expr { return binop(vm.SHR, num(bits), v) }
+
+// signext sign-extends the low byte of v (int8).
+func signext(v expr) expr { return binop(vm.SIGNEXTEND, num(0), v) }
+
+// arg loads the i-th static ABI argument.
+func arg(i int) expr { return cload(num(4 + 32*uint64(i))) }
+
+// local loads local variable i.
+func local(i int) expr { return mload(num(memLocals + 32*uint64(i))) }
+
+// keccak hashes size bytes of memory at off.
+func keccak(off, size expr) expr { return binop(vm.KECCAK256, off, size) }
+
+// mapSlot is the storage slot of key in the mapping at slot.
+func mapSlot(key expr, slot expr) expr {
+	return func(p *program) {
+		p.mstore(num(0), key)
+		p.mstore(num(32), slot)
+		keccak(num(0), num(64))(p)
+	}
+}
+
+// checkedAdd is a+b, reverting with Panic(0x11) on overflow like Solidity.
+func checkedAdd(a, b expr) expr {
+	return func(p *program) {
+		b(p)
+		a(p)
+		p.op(vm.DUP2, vm.DUP2, vm.ADD) // b a s
+		p.op(vm.DUP1, vm.DUP3, vm.GT)  // b a s (a>s)
+		p.pushLabel("panic-overflow")
+		p.op(vm.JUMPI)
+		p.op(vm.SWAP2, vm.POP, vm.POP)
+	}
+}
+
+// Statements
+
+func (p *program) mstore(off, v expr) {
+	v(p)
+	off(p)
+	p.op(vm.MSTORE)
+}
+
+func (p *program) sstore(slot, v expr) {
+	v(p)
+	slot(p)
+	p.op(vm.SSTORE)
+}
+
+func (p *program) setLocal(i int, v expr) { p.mstore(num(memLocals+32*uint64(i)), v) }
+
+// ifThen runs body if cond is non-zero.
+func (p *program) ifThen(cond expr, body func()) {
+	end := p.fresh("endif")
+	p.jumpIf(iszero(cond), end)
+	body()
+	p.label(end)
+}
+
+// ifElse runs then if cond is non-zero, otherwise els.
+func (p *program) ifElse(cond expr, then, els func()) {
+	elseLabel, end := p.fresh("else"), p.fresh("endif")
+	p.jumpIf(iszero(cond), elseLabel)
+	then()
+	p.jump(end)
+	p.label(elseLabel)
+	els()
+	p.label(end)
+}
+
+// while runs body as long as cond is non-zero.
+func (p *program) while(cond expr, body func()) {
+	start, end := p.fresh("loop"), p.fresh("endloop")
+	p.label(start)
+	p.jumpIf(iszero(cond), end)
+	body()
+	p.jump(start)
+	p.label(end)
+}
+
+func (p *program) stop() { p.op(vm.STOP) }
+
+// revertEmpty reverts without data, like Solidity's ABI decoder and
+// non-payable checks.
+func (p *program) revertEmpty() {
+	p.pushU(0)
+	p.pushU(0)
+	p.op(vm.REVERT)
+}
+
+// revertWith reverts with a custom error: signature like "NotOwner()" and
+// its static arguments.
+func (p *program) revertWith(sig string, args ...expr) {
+	p.errors[sig] = true
+	p.mstore(num(memOut), shl(224, bignum(new(big.Int).SetBytes(selector(sig)))))
+	for i, a := range args {
+		p.mstore(num(memOut+4+32*uint64(i)), a)
+	}
+	p.pushU(4 + 32*uint64(len(args)))
+	p.pushU(memOut)
+	p.op(vm.REVERT)
+}
+
+// require reverts with the custom error sig unless cond holds.
+func (p *program) require(cond expr, sig string, args ...expr) {
+	p.ifThen(iszero(cond), func() { p.revertWith(sig, args...) })
+}
+
+// returnWords returns static ABI words.
+func (p *program) returnWords(vals ...expr) {
+	for i, v := range vals {
+		p.mstore(num(memOut+32*uint64(i)), v)
+	}
+	p.pushU(32 * uint64(len(vals)))
+	p.pushU(memOut)
+	p.op(vm.RETURN)
+}
+
+// emit logs the event sig with its indexed topics and static data words.
+func (p *program) emit(sig string, topics []expr, data ...expr) {
+	p.events[sig] = true
+	for i, d := range data {
+		p.mstore(num(memOut+32*uint64(i)), d)
+	}
+	for i := len(topics) - 1; i >= 0; i-- {
+		topics[i](p)
+	}
+	word(crypto.Keccak256([]byte(sig)))(p)
+	p.pushU(32 * uint64(len(data)))
+	p.pushU(memOut)
+	p.op(vm.LOG1 + vm.OpCode(len(topics)))
+}
+
+// call sends value wei to addr with all remaining gas and no data, and
+// pushes whether it succeeded.
+func call(addr, value expr) expr {
+	return func(p *program) {
+		p.pushU(0) // retSize
+		p.pushU(0) // retOffset
+		p.pushU(0) // argsSize
+		p.pushU(0) // argsOffset
+		value(p)
+		addr(p)
+		p.op(vm.GAS, vm.CALL)
+	}
+}
+
+// selector returns the 4-byte selector of a function or error signature.
+func selector(sig string) []byte { return crypto.Keccak256([]byte(sig))[:4] }
@@ -0,0 +1,262 @@
+package main
+
+// LyrionBridge storage, in the order solc lays out LyrionBridge.sol
+// (Ownable, ReentrancyGuard, then the bridge's own variables)
+const (
+	bridgeOwner = iota
+	bridgeReentrancy
+	bridgeStateRoots
+	bridgeSubmissionTime
+	bridgeProcessedDeposits
+	bridgeProcessedWithdrawals
+	bridgeCurrentBatch
+	bridgeChallengePeriod
+	bridgeSequencer
+	bridgeTotalDeposited
+	bridgeTotalWithdrawn
+	bridgeDepositNonce
+	bridgeChallengers
+	bridgeInvalidations
+)
+
+// ReentrancyGuard states
+const (
+	notEntered = 1
+	entered    = 2
+)
+
+func bridgeContract() *contract {
+	return &contract{
+		name:     "LyrionBridge",
+		ctorArgs: []string{"address"},
+		ctor: func(p *program) {
+			// Ownable(msg.sender)
+			p.sstore(num(bridgeOwner), caller)
+			p.emit("OwnershipTransferred(address,address)", []expr{num(0), caller})
+			p.sstore(num(bridgeReentrancy), num(notEntered))
+			p.sstore(num(bridgeChallengePeriod), num(10*60))
+			p.sstore(num(bridgeSequencer), local(0))
+			p.emit("SequencerUpdated(address,address)", []expr{num(0), local(0)})
+		},
+		receive: func(p *program) { p.stop() },
+		functions: functions{
+			{sig: "depositToL2(address)", payable: true, body: func(p *program) { deposit(p, arg(0)) }},
+			{sig: "depositToL2()", payable: true, body: func(p *program) { deposit(p, caller) }},
+			{sig: "submitBatch(bytes32,uint256,uint256,uint256)", body: submitBatch},
+			{sig: "withdrawFromL2(uint256,address,uint256,bytes32[])", body: withdrawFromL2},
+			{sig: "challengeBatch(uint256,bytes32,bytes32)", body: challengeBatch},
+
+			{sig: "batchStateRoots(uint256)", body: getter(mapSlot(arg(0), num(bridgeStateRoots)))},
+			{sig: "batchSubmissionTime(uint256)", body: getter(mapSlot(arg(0), num(bridgeSubmissionTime)))},
+			{sig: "processedDeposits(uint256)", body: getter(mapSlot(arg(0), num(bridgeProcessedDeposits)))},
+			{sig: "processedWithdrawals(bytes32)", body: getter(mapSlot(arg(0), num(bridgeProcessedWithdrawals)))},
+			{sig: "currentBatchNumber()", body: getter(num(bridgeCurrentBatch))},
+			{sig: "challengePeriod()", body: getter(num(bridgeChallengePeriod))},
+			{sig: "sequencer()", body: getter(num(bridgeSequencer))},
+			{sig: "totalDeposited()", body: getter(num(bridgeTotalDeposited))},
+			{sig: "totalWithdrawn()", body: getter(num(bridgeTotalWithdrawn))},
+			{sig: "depositNonce()", body: getter(num(bridgeDepositNonce))},
+			{sig: "challengers(address)", body: getter(mapSlot(arg(0), num(bridgeChallengers)))},
+			{sig: "batchInvalidations(uint256)", body: getter(mapSlot(arg(0), num(bridgeInvalidations)))},
+			{sig: "isBatchFinalized(uint256)", body: func(p *program) {
+				isFinalized(p, 0, arg(0))
+				p.returnWords(local(0))
+			}},
+			{sig: "getBatchInfo(uint256)", body: func(p *program) {
+				isFinalized(p, 0, arg(0))
+				p.returnWords(
+					sload(mapSlot(arg(0), num(bridgeStateRoots))),
+					sload(mapSlot(arg(0), num(bridgeSubmissionTime))),
+					local(0),
+				)
+			}},
+			{sig: "getBridgeStats()", body: func(p *program) {
+				p.returnWords(
+					sload(num(bridgeCurrentBatch)),
+					sload(num(bridgeTotalDeposited)),
+					sload(num(bridgeTotalWithdrawn)),
+					selfbalance,
+					sload(num(bridgeDepositNonce)),
+				)
+			}},
+
+			{sig: "setSequencer(address)", body: func(p *program) {
+				onlyOwner(p, bridgeOwner)
+				p.setLocal(0, sload(num(bridgeSequencer)))
+				p.sstore(num(bridgeSequencer), arg(0))
+				p.emit("SequencerUpdated(address,address)", []expr{local(0), arg(0)})
+				p.stop()
+			}},
+			{sig: "setChallengePeriod(uint256)", body: func(p *program) {
+				onlyOwner(p, bridgeOwner)
+				p.setLocal(0, sload(num(bridgeChallengePeriod)))
+				p.sstore(num(bridgeChallengePeriod), arg(0))
+				p.emit("ChallengePeriodUpdated(uint256,uint256)", nil, local(0), arg(0))
+				p.stop()
+			}},
+			{sig: "setChallenger(address,bool)", body: func(p *program) {
+				onlyOwner(p, bridgeOwner)
+				p.sstore(mapSlot(arg(0), num(bridgeChallengers)), arg(1))
+				p.emit("ChallengerUpdated(address,bool)", []expr{arg(0)}, arg(1))
+				p.stop()
+			}},
+			{sig: "emergencyWithdraw(address,uint256)", body: func(p *program) {
+				onlyOwner(p, bridgeOwner)
+				p.require(call(arg(0), arg(1)), "TransferFailed()")
+				p.stop()
+			}},
+		}.withOwnable(bridgeOwner),
+	}
+}
+
+// deposit is depositToL2: it counts msg.value and logs the deposit for the
+// sequencer to credit recipient on L2.
+func deposit(p *program, recipient expr) {
+	nonReentrant(p, func() {
+		p.require(callvalue, "InvalidAmount()")
+		p.setLocal(0, sload(num(bridgeDepositNonce)))
+		p.sstore(num(bridgeDepositNonce), checkedAdd(local(0), num(1)))
+		p.sstore(num(bridgeTotalDeposited), checkedAdd(sload(num(bridgeTotalDeposited)), callvalue))
+		p.emit("DepositInitiated(uint256,address,address,uint256,uint256)",
+			[]expr{local(0), caller, recipient}, callvalue, timestamp)
+	})
+	p.stop()
+}
+
+func submitBatch(p *program) {
+	p.require(eq(caller, sload(num(bridgeSequencer))), "InvalidSequencer()")
+	p.setLocal(0, checkedAdd(sload(num(bridgeCurrentBatch)), num(1)))
+	p.sstore(num(bridgeCurrentBatch), local(0))
+	p.sstore(mapSlot(local(0), num(bridgeStateRoots)), arg(0))
+	p.sstore(mapSlot(local(0), num(bridgeSubmissionTime)), timestamp)
+	p.emit("BatchSubmitted(uint256,bytes32,uint256,uint256,uint256,uint256)",
+		[]expr{local(0)}, arg(0), arg(1), arg(2), arg(3), timestamp)
+	p.stop()
+}
+
+// withdrawFromL2 pays out a withdrawal proven against a final batch root.
+// Locals: 0 finalized, 1 withdrawal hash, 2 computed root, 3 proof
+// elements, 4 proof length, 5 index
+func withdrawFromL2(p *program) {
+	p.arrayArg(3, 3, 4)
+	nonReentrant(p, func() {
+		isFinalized(p, 0, arg(0))
+		p.require(local(0), "BatchNotFinalized()")
+
+		// keccak256(abi.encodePacked(batchNumber, recipient, amount))
+		p.mstore(num(memHash+20), arg(1))
+		p.mstore(num(memHash), arg(0))
+		p.mstore(num(memHash+52), arg(2))
+		p.setLocal(1, keccak(num(memHash), num(84)))
+		p.ifThen(sload(mapSlot(local(1), num(bridgeProcessedWithdrawals))), func() {
+			p.revertWith("WithdrawalAlreadyProcessed()")
+		})
+
+		// MerkleProof.verify: leaf = keccak256(abi.encodePacked(recipient,
+		// amount)), pairs hashed in sorted order
+		p.mstore(num(memHash-12), arg(1))
+		p.mstore(num(memHash+20), arg(2))
+		p.setLocal(2, keccak(num(memHash), num(52)))
+		p.setLocal(5, num(0))
+		p.while(lt(local(5), local(4)), func() {
+			proof := cload(add(local(3), mul(num(32), local(5))))
+			p.ifElse(lt(local(2), proof), func() {
+				p.mstore(num(0), local(2))
+				p.mstore(num(32), proof)
+			}, func() {
+				p.mstore(num(0), proof)
+				p.mstore(num(32), local(2))
+			})
+			p.setLocal(2, keccak(num(0), num(64)))
+			p.setLocal(5, add(local(5), num(1)))
+		})
+		p.require(eq(local(2), sload(mapSlot(arg(0), num(bridgeStateRoots)))), "InvalidProof()")
+
+		p.ifThen(lt(selfbalance, arg(2)), func() { p.revertWith("InsufficientBridgeBalance()") })
+		p.sstore(mapSlot(local(1), num(bridgeProcessedWithdrawals)), num(1))
+		p.sstore(num(bridgeTotalWithdrawn), checkedAdd(sload(num(bridgeTotalWithdrawn)), arg(2)))
+		p.require(call(arg(1), arg(2)), "TransferFailed()")
+		p.emit("WithdrawalCompleted(bytes32,address,uint256,uint256)",
+			[]expr{local(1), arg(1)}, arg(2), timestamp)
+	})
+	p.stop()
+}
+
+// challengeBatch drops batch n and every batch after it.
+// Locals: 0 finalized, 1 claimed root, 2 batch being dropped
+func challengeBatch(p *program) {
+	p.require(sload(mapSlot(caller, num(bridgeChallengers))), "NotChallenger()")
+	p.ifThen(iszero(arg(0)), func() { p.revertWith("BatchNotChallengeable()") })
+	p.ifThen(gt(arg(0), sload(num(bridgeCurrentBatch))), func() { p.revertWith("BatchNotChallengeable()") })
+	isFinalized(p, 0, arg(0))
+	p.ifThen(local(0), func() { p.revertWith("BatchNotChallengeable()") })
+
+	p.setLocal(1, sload(mapSlot(arg(0), num(bridgeStateRoots))))
+	p.setLocal(2, arg(0))
+	p.while(iszero(gt(local(2), sload(num(bridgeCurrentBatch)))), func() {
+		p.sstore(mapSlot(local(2), num(bridgeStateRoots)), num(0))
+		p.sstore(mapSlot(local(2), num(bridgeSubmissionTime)), num(0))
+		p.sstore(mapSlot(local(2), num(bridgeInvalidations)),
+			checkedAdd(sload(mapSlot(local(2), num(bridgeInvalidations))), num(1)))
+		p.setLocal(2, checkedAdd(local(2), num(1)))
+	})
+	p.sstore(num(bridgeCurrentBatch), sub(arg(0), num(1)))
+	p.emit("BatchChallenged(uint256,address,bytes32,bytes32,bytes32,uint256)",
+		[]expr{arg(0), caller}, local(1), arg(1), arg(2), timestamp)
+	p.stop()
+}
+
+// isFinalized sets local dst to whether batch n exists and its challenge
+// period passed (_isFinalized).
+func isFinalized(p *program, dst int, n expr) {
+	p.setLocal(dst, sload(mapSlot(n, num(bridgeSubmissionTime))))
+	p.ifElse(local(dst), func() {
+		p.setLocal(dst, iszero(lt(timestamp, checkedAdd(local(dst), sload(num(bridgeChallengePeriod))))))
+	}, func() {
+		p.setLocal(dst, num(0))
+	})
+}
+
+// nonReentrant wraps body in ReentrancyGuard's modifier.
+func nonReentrant(p *program, body func()) {
+	p.ifThen(eq(sload(num(bridgeReentrancy)), num(entered)), func() {
+		p.revertWith("ReentrancyGuardReentrantCall()")
+	})
+	p.sstore(num(bridgeReentrancy), num(entered))
+	body()
+	p.sstore(num(bridgeReentrancy), num(notEntered))
+}
+
+// getter returns the word stored at slot.
+func getter(slot expr) func(p *program) {
+	return func(p *program) { p.returnWords(sload(slot)) }
+}
+
+// onlyOwner reverts unless the caller is the Ownable owner at slot.
+func onlyOwner(p *program, slot uint64) {
+	p.require(eq(caller, sload(num(slot))), "OwnableUnauthorizedAccount(address)", caller)
+}
+
+type functions []function
+
+// withOwnable adds Ownable's external functions, with the owner at slot.
+func (fs functions) withOwnable(slot uint64) []function {
+	transfer := func(p *program, newOwner expr) {
+		p.setLocal(0, sload(num(slot)))
+		p.sstore(num(slot), newOwner)
+		p.emit("OwnershipTransferred(address,address)", []expr{local(0), newOwner})
+		p.stop()
+	}
+	return append(fs,
+		function{sig: "owner()", body: getter(num(slot))},
+		function{sig: "renounceOwnership()", body: func(p *program) {
+			onlyOwner(p, slot)
+			transfer(p, num(0))
+		}},
+		function{sig: "transferOwnership(address)", body: func(p *program) {
+			onlyOwner(p, slot)
+			p.require(arg(0), "OwnableInvalidOwner(address)", num(0))
+			transfer(p, arg(0))
+		}},
+	)
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// contract describes a contract to assemble: its external functions, an
+// optional receive function and its constructor.
+type contract struct {
+	name      string
+	ctorArgs  []string // Constructor argument types
+	ctor      func(p *program)
+	functions []function
+	receive   func(p *program)
+}
+
+// function is an external function. Its arguments are checked like the
+// Solidity ABI decoder does (size, address/bool/bytesN/intN ranges, array
+// bounds) and the call reverts if it sends value to a non-payable function.
+type function struct {
+	sig     string
+	payable bool
+	body    func(p *program)
+}
+
+// argTypes parses the argument types of a signature like "f(uint256,address)".
+func argTypes(sig string) []string {
+	inner := sig[strings.Index(sig, "(")+1 : len(sig)-1]
+	if inner == "" {
+		return nil
+	}
+	return strings.Split(inner, ",")
+}
+
+// checkArg reverts unless the word v is a valid value of type typ.
+func (p *program) checkArg(typ string, v expr) {
+	var valid expr
+	switch {
+	case typ == "address":
+		valid = iszero(shr(160, v))
+	case typ == "bool":
+		valid = lt(v, num(2))
+	case typ == "int8":
+		valid = eq(signext(v), v)
+	case typ == "bytes21":
+		valid = iszero(shl(21*8, v))
+	case typ == "uint256" || typ == "bytes32":
+		return
+	default:
+		panic("unsupported argument type " + typ)
+	}
+	p.ifThen(iszero(valid), p.revertEmpty)
+}
+
+// arrayArg returns the calldata offset of the first element and the length
+// of the dynamic array argument i, after checking that it lies within the
+// calldata.
+func (p *program) arrayArg(i int, elems, length int) {
+	// locals[elems] = 4 + offset + 32, locals[length] = length
+	p.ifThen(gt(arg(i), num(0xffffffff)), p.revertEmpty)
+	p.setLocal(length, cload(add(num(4), arg(i))))
+	p.ifThen(gt(local(length), num(0xffffffff)), p.revertEmpty)
+	p.setLocal(elems, add(num(4+32), arg(i)))
+	p.ifThen(gt(add(local(elems), mul(num(32), local(length))), calldatasize), p.revertEmpty)
+}
+
+// runtime assembles the deployed code.
+func (c *contract) runtime() ([]byte, error) {
+	return c.runtimeProgram().assemble()
+}
+
+func (c *contract) runtimeProgram() *program {
+	p := newProgram()
+
+	p.jumpIf(lt(calldatasize, num(4)), "no-selector")
+	shr(224, cload(num(0)))(p)
+	for _, f := range c.functions {
+		p.op(vm.DUP1)
+		p.push(new(big.Int).SetBytes(selector(f.sig)))
+		p.op(vm.EQ)
+		p.pushLabel(f.sig)
+		p.op(vm.JUMPI)
+	}
+	p.revertEmpty()
+
+	p.label("no-selector")
+	if c.receive != nil {
+		p.ifThen(iszero(calldatasize), func() { c.receive(p) })
+	}
+	p.revertEmpty()
+
+	for _, f := range c.functions {
+		p.label(f.sig)
+		if !f.payable {
+			p.ifThen(callvalue, p.revertEmpty)
+		}
+		types := argTypes(f.sig)
+		p.ifThen(lt(calldatasize, num(4+32*uint64(len(types)))), p.revertEmpty)
+		for i, typ := range types {
+			if !strings.HasSuffix(typ, "[]") {
+				p.checkArg(typ, arg(i))
+			}
+		}
+		f.body(p)
+	}
+
+	p.label("panic-overflow")
+	p.revertWith("Panic(uint256)", num(0x11))
+	return p
+}
+
+// initcode assembles the creation code: the constructor, which reads its
+// arguments into locals 0.., followed by the runtime code.
+func (c *contract) initcode() ([]byte, error) {
+	runtime, err := c.runtime()
+	if err != nil {
+		return nil, err
+	}
+	build := func(offset int) ([]byte, error) {
+		p := newProgram()
+		p.ifThen(callvalue, p.revertEmpty)
+		if n := uint64(len(c.ctorArgs)); n > 0 {
+			// codecopy(locals, codesize - 32n, 32n)
+			p.pushU(32 * n)
+			sub(opExpr(vm.CODESIZE), num(32*n))(p)
+			p.pushU(memLocals)
+			p.op(vm.CODECOPY)
+			for i, typ := range c.ctorArgs {
+				p.checkArg(typ, local(i))
+			}
+		}
+		if c.ctor != nil {
+			c.ctor(p)
+		}
+		p.op(vm.PUSH2)
+		p.code = append(p.code, byte(len(runtime)>>8), byte(len(runtime)))
+		p.op(vm.PUSH2)
+		p.code = append(p.code, byte(offset>>8), byte(offset))
+		p.pushU(0)
+		p.op(vm.CODECOPY)
+		p.op(vm.PUSH2)
+		p.code = append(p.code, byte(len(runtime)>>8), byte(len(runtime)))
+		p.pushU(0)
+		p.op(vm.RETURN)
+
+		p.label("panic-overflow")
+		p.revertWith("Panic(uint256)", num(0x11))
+		return p.assemble()
+	}
+	// The runtime goes after the init code, whose size doesn't depend on
+	// the (fixed-width) offset
+	init, err := build(0)
+	if err != nil {
+		return nil, err
+	}
+	if init, err = build(len(init)); err != nil {
+		return nil, err
+	}
+	return append(init, runtime...), nil
+}
+
+// checkABI makes sure the contract implements exactly the functions of its
+// ABI and emits all of its events, and that the events and errors in its
+// code are declared there. Like in the Solidity sources, not every declared
+// error has to be raised.
+func (c *contract) checkABI(a *abi.ABI) error {
+	declared := make(map[string]bool)
+	for _, m := range a.Methods {
+		declared[m.Sig] = true
+	}
+	for _, f := range c.functions {
+		if !declared[f.sig] {
+			return fmt.Errorf("%s is not in the ABI", f.sig)
+		}
+		delete(declared, f.sig)
+	}
+	for sig := range declared {
+		return fmt.Errorf("%s is not implemented", sig)
+	}
+	if (c.receive != nil) != a.HasReceive() {
+		return fmt.Errorf("receive function does not match the ABI")
+	}
+
+	// The constructor's events count too
+	p := c.runtimeProgram()
+	init := newProgram()
+	if c.ctor != nil {
+		c.ctor(init)
+	}
+	for sig := range init.events {
+		p.events[sig] = true
+	}
+	for sig := range init.errors {
+		p.errors[sig] = true
+	}
+	for _, e := range a.Events {
+		if !p.events[e.Sig] {
+			return fmt.Errorf("event %s is never emitted", e.Sig)
+		}
+		delete(p.events, e.Sig)
+	}
+	for sig := range p.events {
+		return fmt.Errorf("event %s is not in the ABI", sig)
+	}
+	delete(p.errors, "Panic(uint256)")
+	for _, e := range a.Errors {
+		delete(p.errors, e.Sig)
+	}
+	for sig := range p.errors {
+		return fmt.Errorf("error %s is not in the ABI", sig)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// MockFtsoV2 storage: owner and the uint64 timestamp share slot 0, like
+// solc packs them, and each Feed takes two slots (value; decimals | exists).
+const (
+	ftsoOwnerTimestamp = iota
+	ftsoFeeds
+)
+
+var addressMask = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+
+var (
+	ftsoOwner     = and(sload(num(ftsoOwnerTimestamp)), bignum(addressMask))
+	ftsoTimestamp = shr(160, sload(num(ftsoOwnerTimestamp)))
+)
+
+func ftsoContract() *contract {
+	return &contract{
+		name: "MockFtsoV2",
+		ctor: func(p *program) {
+			p.sstore(num(ftsoOwnerTimestamp), caller)
+		},
+		functions: functions{
+			{sig: "owner()", body: func(p *program) { p.returnWords(ftsoOwner) }},
+			{sig: "timestamp()", body: func(p *program) { p.returnWords(ftsoTimestamp) }},
+			// Locals: 0 decimals | exists
+			{sig: "feeds(bytes21)", body: func(p *program) {
+				p.setLocal(0, sload(add(mapSlot(arg(0), num(ftsoFeeds)), num(1))))
+				p.returnWords(sload(mapSlot(arg(0), num(ftsoFeeds))),
+					signext(local(0)), and(shr(8, local(0)), num(0xff)))
+			}},
+			{sig: "setFeed(bytes21,uint256,int8)", body: func(p *program) {
+				p.require(eq(caller, ftsoOwner), "NotOwner()")
+				p.sstore(mapSlot(arg(0), num(ftsoFeeds)), arg(1))
+				p.sstore(add(mapSlot(arg(0), num(ftsoFeeds)), num(1)),
+					or(and(arg(2), num(0xff)), num(1<<8)))
+				p.sstore(num(ftsoOwnerTimestamp),
+					or(ftsoOwner, shl(160, and(timestamp, num(1<<64-1)))))
+				p.emit("FeedUpdated(bytes21,uint256,int8,uint64)", []expr{arg(0)}, arg(1), arg(2), ftsoTimestamp)
+				p.stop()
+			}},
+			// Locals: 0 decimals | exists
+			{sig: "getFeedById(bytes21)", body: func(p *program) {
+				loadFeed(p, arg(0), 0)
+				p.returnWords(sload(mapSlot(arg(0), num(ftsoFeeds))), signext(local(0)), ftsoTimestamp)
+			}},
+			// Output: [values offset, decimals offset, timestamp, n, values..., n, decimals...]
+			// Locals: 0 first feed ID, 1 n, 2 i, 3 feed ID, 4 decimals | exists
+			{sig: "getFeedsById(bytes21[])", body: func(p *program) {
+				p.arrayArg(0, 0, 1)
+				values := func(i expr) expr { return add(num(memOut+0x80), mul(num(32), i)) }
+				decimals := func(i expr) expr { return add(values(local(1)), add(num(32), mul(num(32), i))) }
+
+				p.mstore(num(memOut), num(0x60))
+				p.mstore(num(memOut+0x20), sub(values(local(1)), num(memOut)))
+				p.mstore(num(memOut+0x40), ftsoTimestamp)
+				p.mstore(num(memOut+0x60), local(1))
+				p.mstore(values(local(1)), local(1))
+				p.setLocal(2, num(0))
+				p.while(lt(local(2), local(1)), func() {
+					p.setLocal(3, cload(add(local(0), mul(num(32), local(2)))))
+					p.checkArg("bytes21", local(3))
+					loadFeed(p, local(3), 4)
+					p.mstore(values(local(2)), sload(mapSlot(local(3), num(ftsoFeeds))))
+					p.mstore(decimals(local(2)), signext(local(4)))
+					p.setLocal(2, add(local(2), num(1)))
+				})
+				sub(decimals(local(1)), num(memOut))(p)
+				p.pushU(memOut)
+				p.op(vm.RETURN)
+			}},
+		},
+	}
+}
+
+// loadFeed loads the decimals | exists slot of feed id into local dst,
+// reverting with UnknownFeed if it was never set.
+func loadFeed(p *program, id expr, dst int) {
+	p.setLocal(dst, sload(add(mapSlot(id, num(ftsoFeeds)), num(1))))
+	p.require(and(shr(8, local(dst)), num(0xff)), "UnknownFeed(bytes21)", id)
+}
@@ -0,0 +1,66 @@
+// Command evm assembles the creation bytecode of LyrionBridge, LyrionToken
+// and MockFtsoV2 into contracts/bin, where the Go bindings embed it from.
+//
+// The code is written directly in EVM assembly, following the Solidity
+// sources statement by statement (storage layout, checks, custom errors and
+// events), so that l1sim can deploy the contracts without a Solidity
+// toolchain. It is a stand-in for the solc output: `forge build` followed by
+// contracts/scripts/export-bin.sh replaces the .bin files with the real
+// thing. Each contract is checked against its ABI in contracts/abi.
+//
+// Usage (in lyrion-node/): go run ./contracts/evm && go generate ./internal/settlement/bindings
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func main() {
+	abiDir := flag.String("abi", "contracts/abi", "directory with the contract ABIs")
+	outDir := flag.String("out", "contracts/bin", "output directory for the .bin files")
+	flag.Parse()
+
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+	for _, c := range []*contract{bridgeContract(), tokenContract(), ftsoContract()} {
+		if err := build(c, *abiDir, *outDir); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %s: %v\n", c.name, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// build assembles c, checks it against its ABI and writes its creation code
+// as hex, like export-bin.sh does.
+func build(c *contract, abiDir, outDir string) error {
+	data, err := os.ReadFile(filepath.Join(abiDir, c.name+".json"))
+	if err != nil {
+		return err
+	}
+	parsed, err := abi.JSON(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if err := c.checkABI(&parsed); err != nil {
+		return err
+	}
+	code, err := c.initcode()
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(outDir, c.name+".bin")
+	if err := os.WriteFile(path, []byte(hex.EncodeToString(code)+"\n"), 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("✅ %s (%d bytes)\n", path, len(code))
+	return nil
+}
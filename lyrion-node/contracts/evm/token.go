@@ -0,0 +1,158 @@
+package main
+
+import (
+	"math/big"
+)
+
+// LyrionToken storage, in the order solc lays out LyrionToken.sol (ERC20,
+// Ownable, then the token's own variables)
+const (
+	tokenBalances = iota
+	tokenAllowances
+	tokenTotalSupply
+	tokenName
+	tokenSymbol
+	tokenOwner
+	tokenBridge
+)
+
+var (
+	tokenNameValue   = "Lyrion Token"
+	tokenSymbolValue = "LYR"
+
+	// MAX_SUPPLY, 1 billion tokens
+	maxSupply = new(big.Int).Mul(big.NewInt(1_000_000_000), big.NewInt(1e18))
+
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+)
+
+func tokenContract() *contract {
+	return &contract{
+		name:     "LyrionToken",
+		ctorArgs: []string{"address"},
+		ctor: func(p *program) {
+			// ERC20(name, symbol), stored as short strings
+			p.sstore(num(tokenName), shortString(tokenNameValue))
+			p.sstore(num(tokenSymbol), shortString(tokenSymbolValue))
+			// Ownable(msg.sender)
+			p.sstore(num(tokenOwner), caller)
+			p.emit("OwnershipTransferred(address,address)", []expr{num(0), caller})
+			p.sstore(num(tokenBridge), local(0))
+			p.emit("BridgeUpdated(address,address)", []expr{num(0), local(0)})
+		},
+		functions: functions{
+			{sig: "name()", body: func(p *program) { returnString(p, tokenNameValue) }},
+			{sig: "symbol()", body: func(p *program) { returnString(p, tokenSymbolValue) }},
+			{sig: "decimals()", body: func(p *program) { p.returnWords(num(18)) }},
+			{sig: "totalSupply()", body: getter(num(tokenTotalSupply))},
+			{sig: "balanceOf(address)", body: getter(mapSlot(arg(0), num(tokenBalances)))},
+			{sig: "allowance(address,address)", body: getter(allowanceSlot(arg(0), arg(1)))},
+			{sig: "MAX_SUPPLY()", body: func(p *program) { p.returnWords(bignum(maxSupply)) }},
+			{sig: "bridge()", body: getter(num(tokenBridge))},
+
+			{sig: "transfer(address,uint256)", body: func(p *program) {
+				transferTokens(p, caller, arg(0), arg(1))
+				p.returnWords(num(1))
+			}},
+			{sig: "approve(address,uint256)", body: func(p *program) {
+				approve(p, caller, arg(0), arg(1), true)
+				p.returnWords(num(1))
+			}},
+			// Locals: 0 allowance
+			{sig: "transferFrom(address,address,uint256)", body: func(p *program) {
+				p.setLocal(0, sload(allowanceSlot(arg(0), caller)))
+				p.ifThen(lt(local(0), bignum(maxUint256)), func() {
+					p.ifThen(lt(local(0), arg(2)), func() {
+						p.revertWith("ERC20InsufficientAllowance(address,uint256,uint256)", caller, local(0), arg(2))
+					})
+					approve(p, arg(0), caller, sub(local(0), arg(2)), false)
+				})
+				transferTokens(p, arg(0), arg(1), arg(2))
+				p.returnWords(num(1))
+			}},
+			{sig: "mint(address,uint256)", body: func(p *program) {
+				p.require(eq(caller, sload(num(tokenBridge))), "OnlyBridge()")
+				p.ifThen(gt(checkedAdd(sload(num(tokenTotalSupply)), arg(1)), bignum(maxSupply)), func() {
+					p.revertWith("MaxSupplyExceeded()")
+				})
+				p.require(arg(0), "ERC20InvalidReceiver(address)", num(0))
+				update(p, nil, arg(0), arg(1))
+				p.stop()
+			}},
+			{sig: "burn(uint256)", body: func(p *program) {
+				p.require(caller, "ERC20InvalidSender(address)", num(0))
+				update(p, caller, nil, arg(0))
+				p.stop()
+			}},
+			{sig: "setBridge(address)", body: func(p *program) {
+				onlyOwner(p, tokenOwner)
+				p.setLocal(0, sload(num(tokenBridge)))
+				p.sstore(num(tokenBridge), arg(0))
+				p.emit("BridgeUpdated(address,address)", []expr{local(0), arg(0)})
+				p.stop()
+			}},
+		}.withOwnable(tokenOwner),
+	}
+}
+
+// transferTokens is ERC20._transfer.
+func transferTokens(p *program, from, to, value expr) {
+	p.require(from, "ERC20InvalidSender(address)", num(0))
+	p.require(to, "ERC20InvalidReceiver(address)", num(0))
+	update(p, from, to, value)
+}
+
+// update is ERC20._update; a nil from mints and a nil to burns.
+// Locals: 1 balance of from
+func update(p *program, from, to, value expr) {
+	if from == nil {
+		p.sstore(num(tokenTotalSupply), checkedAdd(sload(num(tokenTotalSupply)), value))
+	} else {
+		p.setLocal(1, sload(mapSlot(from, num(tokenBalances))))
+		p.ifThen(lt(local(1), value), func() {
+			p.revertWith("ERC20InsufficientBalance(address,uint256,uint256)", from, local(1), value)
+		})
+		p.sstore(mapSlot(from, num(tokenBalances)), sub(local(1), value))
+	}
+	if to == nil {
+		p.sstore(num(tokenTotalSupply), sub(sload(num(tokenTotalSupply)), value))
+	} else {
+		p.sstore(mapSlot(to, num(tokenBalances)), add(sload(mapSlot(to, num(tokenBalances))), value))
+	}
+	if from == nil {
+		from = num(0)
+	}
+	if to == nil {
+		to = num(0)
+	}
+	p.emit("Transfer(address,address,uint256)", []expr{from, to}, value)
+}
+
+// approve is ERC20._approve.
+func approve(p *program, owner, spender, value expr, emitEvent bool) {
+	p.require(owner, "ERC20InvalidApprover(address)", num(0))
+	p.require(spender, "ERC20InvalidSpender(address)", num(0))
+	p.sstore(allowanceSlot(owner, spender), value)
+	if emitEvent {
+		p.emit("Approval(address,address,uint256)", []expr{owner, spender}, value)
+	}
+}
+
+// allowanceSlot is the slot of _allowances[owner][spender].
+func allowanceSlot(owner, spender expr) expr {
+	return mapSlot(spender, mapSlot(owner, num(tokenAllowances)))
+}
+
+// shortString is how solc stores a string of at most 31 bytes: the bytes
+// left-aligned and twice the length in the last byte.
+func shortString(s string) expr {
+	w := make([]byte, 32)
+	copy(w, s)
+	w[31] = byte(2 * len(s))
+	return bignum(new(big.Int).SetBytes(w))
+}
+
+// returnString returns a string of at most 32 bytes.
+func returnString(p *program, s string) {
+	p.returnWords(num(32), num(uint64(len(s))), word([]byte(s)))
+}
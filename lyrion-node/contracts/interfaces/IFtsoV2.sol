@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+/**
+ * @title IFtsoV2
+ * @notice The read side of Flare's FTSOv2 price feeds used by the LYRION price oracle
+ * @dev Mirrors the view functions of Flare's TestFtsoV2Interface, which serve
+ *      the same data as FtsoV2Interface for off-chain reads (eth_call).
+ *      A feed ID is a category byte (0x01 = crypto) followed by the feed
+ *      name, e.g. "FLR/USD", right-padded with zeros to 21 bytes.
+ */
+interface IFtsoV2 {
+    /**
+     * @notice Current value of a feed
+     * @param _feedId Feed ID
+     * @return _value Feed value, scaled by 10^_decimals
+     * @return _decimals Decimals of _value (may be negative)
+     * @return _timestamp Timestamp of the voting round the value comes from
+     */
+    function getFeedById(
+        bytes21 _feedId
+    ) external view returns (uint256 _value, int8 _decimals, uint64 _timestamp);
+
+    /**
+     * @notice Current values of several feeds, from the same voting round
+     * @param _feedIds Feed IDs
+     * @return _values Feed values, scaled by 10^_decimals
+     * @return _decimals Decimals of each value
+     * @return _timestamp Timestamp of the voting round the values come from
+     */
+    function getFeedsById(
+        bytes21[] calldata _feedIds
+    ) external view returns (uint256[] memory _values, int8[] memory _decimals, uint64 _timestamp);
+}
@@ -32,6 +32,15 @@ interface ILyrionBridge {
         uint256 timestamp
     );
 
+    event BatchChallenged(
+        uint256 indexed batchNumber,
+        address indexed challenger,
+        bytes32 claimedRoot,
+        bytes32 derivedRoot,
+        bytes32 proofHash,
+        uint256 timestamp
+    );
+
     // ============ Deposit Functions ============
 
     function depositToL2(address recipient) external payable;
@@ -55,6 +64,14 @@ interface ILyrionBridge {
         bytes32[] calldata proof
     ) external;
 
+    // ============ Challenge Functions ============
+
+    function challengeBatch(
+        uint256 batchNumber,
+        bytes32 derivedRoot,
+        bytes32 proofHash
+    ) external;
+
     // ============ View Functions ============
 
     function batchStateRoots(
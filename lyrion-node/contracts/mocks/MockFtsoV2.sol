@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "../interfaces/IFtsoV2.sol";
+
+/**
+ * @title MockFtsoV2
+ * @notice FTSOv2 stand-in for local networks and tests
+ * @dev The owner sets feed values directly. Unknown feeds revert, like on
+ *      Flare.
+ */
+contract MockFtsoV2 is IFtsoV2 {
+    struct Feed {
+        uint256 value;
+        int8 decimals;
+        bool exists;
+    }
+
+    address public owner;
+    uint64 public timestamp;
+    mapping(bytes21 => Feed) public feeds;
+
+    event FeedUpdated(bytes21 indexed feedId, uint256 value, int8 decimals, uint64 timestamp);
+
+    error NotOwner();
+    error UnknownFeed(bytes21 feedId);
+
+    constructor() {
+        owner = msg.sender;
+    }
+
+    /**
+     * @notice Set a feed value and start a new voting round
+     * @param _feedId Feed ID
+     * @param _value Feed value, scaled by 10^_decimals
+     * @param _decimals Decimals of _value
+     */
+    function setFeed(bytes21 _feedId, uint256 _value, int8 _decimals) external {
+        if (msg.sender != owner) revert NotOwner();
+        feeds[_feedId] = Feed(_value, _decimals, true);
+        timestamp = uint64(block.timestamp);
+        emit FeedUpdated(_feedId, _value, _decimals, timestamp);
+    }
+
+    /// @inheritdoc IFtsoV2
+    function getFeedById(
+        bytes21 _feedId
+    ) external view returns (uint256 _value, int8 _decimals, uint64 _timestamp) {
+        Feed memory feed = _feed(_feedId);
+        return (feed.value, feed.decimals, timestamp);
+    }
+
+    /// @inheritdoc IFtsoV2
+    function getFeedsById(
+        bytes21[] calldata _feedIds
+    ) external view returns (uint256[] memory _values, int8[] memory _decimals, uint64 _timestamp) {
+        _values = new uint256[](_feedIds.length);
+        _decimals = new int8[](_feedIds.length);
+        for (uint256 i = 0; i < _feedIds.length; i++) {
+            Feed memory feed = _feed(_feedIds[i]);
+            _values[i] = feed.value;
+            _decimals[i] = feed.decimals;
+        }
+        return (_values, _decimals, timestamp);
+    }
+
+    function _feed(bytes21 _feedId) internal view returns (Feed memory feed) {
+        feed = feeds[_feedId];
+        if (!feed.exists) revert UnknownFeed(_feedId);
+    }
+}
@@ -38,7 +38,7 @@ async function main() {
     console.log("   LyrionToken:  ", tokenAddress);
     console.log("   LyrionBridge: ", bridgeAddress);
     console.log("\n💡 Next Steps:");
-    console.log("   1. Update internal/settlement/relayer.go with bridge address");
+    console.log("   1. Start the node with --l1.bridge <LyrionBridge address> (or LYRION_BRIDGE_ADDRESS)");
     console.log("   2. Update internal/config/config.go with token address");
     console.log("   3. Restart the Lyrion node");
     console.log("=".repeat(60) + "\n");
@@ -0,0 +1,24 @@
+#!/bin/sh
+# Exports the creation bytecode of the deployable contracts from the Foundry
+# build output to contracts/bin, where the Go bindings embed it from
+# (go generate ./internal/settlement/bindings). Commit the .bin files with
+# the regenerated bindings so l1sim can deploy the contracts without a
+# Solidity toolchain. Without Foundry, `go run ./contracts/evm` (in
+# lyrion-node/) writes hand-assembled stand-ins for the same contracts.
+#
+# Usage (in contracts/): forge build && ./scripts/export-bin.sh
+set -e
+
+cd "$(dirname "$0")/.."
+command -v jq >/dev/null || { echo "❌ jq is required" >&2; exit 1; }
+mkdir -p bin
+
+for name in LyrionBridge LyrionToken MockFtsoV2; do
+    artifact="out/$name.sol/$name.json"
+    if [ ! -f "$artifact" ]; then
+        echo "❌ $artifact not found, run forge build first" >&2
+        exit 1
+    fi
+    jq -r '.bytecode.object' "$artifact" | sed 's/^0x//' > "bin/$name.bin"
+    echo "✅ bin/$name.bin"
+done
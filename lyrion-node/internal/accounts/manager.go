@@ -0,0 +1,135 @@
+package accounts
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	ErrAccountNotFound = errors.New("account not found in keystore")
+	ErrEmptyPassphrase = errors.New("empty passphrase")
+)
+
+// Manager handles the node's encrypted keystore.
+// Keys are stored as Web3 Secret Storage (v3) JSON files, one per account,
+// so they can be moved between the node, geth and other Ethereum tooling.
+type Manager struct {
+	dir string
+	ks  *keystore.KeyStore
+}
+
+// NewManager opens (or creates) the keystore in dir.
+// lightKDF trades brute-force resistance for speed and is meant for dev keys only.
+func NewManager(dir string, lightKDF bool) *Manager {
+	scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+	if lightKDF {
+		scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+	}
+	return &Manager{
+		dir: dir,
+		ks:  keystore.NewKeyStore(dir, scryptN, scryptP),
+	}
+}
+
+// Dir returns the keystore directory.
+func (m *Manager) Dir() string {
+	return m.dir
+}
+
+// KeyStore exposes the underlying go-ethereum keystore (e.g. for RPC signing).
+func (m *Manager) KeyStore() *keystore.KeyStore {
+	return m.ks
+}
+
+// NewAccount generates a new key and stores it encrypted with passphrase.
+func (m *Manager) NewAccount(passphrase string) (accounts.Account, error) {
+	if passphrase == "" {
+		return accounts.Account{}, ErrEmptyPassphrase
+	}
+	return m.ks.NewAccount(passphrase)
+}
+
+// Import stores an existing private key encrypted with passphrase.
+// If the account is already present, the existing entry is returned.
+func (m *Manager) Import(key *ecdsa.PrivateKey, passphrase string) (accounts.Account, error) {
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	if account, err := m.Find(addr); err == nil {
+		return account, nil
+	}
+	return m.ks.ImportECDSA(key, passphrase)
+}
+
+// Accounts lists all accounts in the keystore.
+func (m *Manager) Accounts() []accounts.Account {
+	return m.ks.Accounts()
+}
+
+// Find looks up an account by address.
+func (m *Manager) Find(addr common.Address) (accounts.Account, error) {
+	account, err := m.ks.Find(accounts.Account{Address: addr})
+	if err != nil {
+		return accounts.Account{}, fmt.Errorf("%w: %s", ErrAccountNotFound, addr.Hex())
+	}
+	return account, nil
+}
+
+// Unlock unlocks an account for signing through the keystore (e.g. RPC).
+func (m *Manager) Unlock(addr common.Address, passphrase string) error {
+	account, err := m.Find(addr)
+	if err != nil {
+		return err
+	}
+	return m.ks.Unlock(account, passphrase)
+}
+
+// PrivateKey decrypts and returns the private key of addr.
+// Used by components that sign outside the keystore (sequencer, batch submitter).
+func (m *Manager) PrivateKey(addr common.Address, passphrase string) (*ecdsa.PrivateKey, error) {
+	account, err := m.Find(addr)
+	if err != nil {
+		return nil, err
+	}
+	keyJSON, err := os.ReadFile(account.URL.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt key %s: %w", addr.Hex(), err)
+	}
+	return key.PrivateKey, nil
+}
+
+// LoadKey resolves addr to its private key, reading the passphrase from passwordFile.
+func (m *Manager) LoadKey(addr common.Address, passwordFile string) (*ecdsa.PrivateKey, error) {
+	passphrase, err := ReadPassphraseFile(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+	return m.PrivateKey(addr, passphrase)
+}
+
+// ReadPassphraseFile reads a passphrase from the first line of a file.
+// Trailing whitespace (including the newline) is ignored.
+func ReadPassphraseFile(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("no passphrase file given")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase file: %w", err)
+	}
+	passphrase := strings.TrimRight(strings.SplitN(string(data), "\n", 2)[0], "\r \t")
+	if passphrase == "" {
+		return "", ErrEmptyPassphrase
+	}
+	return passphrase, nil
+}
@@ -0,0 +1,119 @@
+package accounts
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestManagerAccounts(t *testing.T) {
+	dir := t.TempDir()
+	m := NewManager(dir, true)
+
+	if _, err := m.NewAccount(""); !errors.Is(err, ErrEmptyPassphrase) {
+		t.Fatalf("account created without a passphrase: %v", err)
+	}
+	created, err := m.NewAccount("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := m.PrivateKey(created.Address, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.PubkeyToAddress(key.PublicKey) != created.Address {
+		t.Fatal("decrypted key is not the account's")
+	}
+
+	// Importing a key twice keeps the first entry
+	imported, _ := crypto.GenerateKey()
+	account, err := m.Import(imported, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := m.Import(imported, "changed")
+	if err != nil || again.URL != account.URL {
+		t.Fatalf("second import gave %v (%v), want %v", again.URL, err, account.URL)
+	}
+	if _, err := m.PrivateKey(account.Address, "changed"); !errors.Is(err, keystore.ErrDecrypt) {
+		t.Fatalf("second import changed the passphrase: %v", err)
+	}
+
+	// The keys are on disk for the next start
+	m = NewManager(dir, true)
+	if len(m.Accounts()) != 2 {
+		t.Fatalf("%d accounts after reopening, want 2", len(m.Accounts()))
+	}
+	key, err = m.PrivateKey(account.Address, "other")
+	if err != nil || !key.Equal(imported) {
+		t.Fatalf("imported key not decrypted after reopening: %v", err)
+	}
+
+	if err := m.Unlock(created.Address, "wrong"); !errors.Is(err, keystore.ErrDecrypt) {
+		t.Fatalf("unlocked with a wrong passphrase: %v", err)
+	}
+	if err := m.Unlock(created.Address, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.KeyStore().SignHash(created, make([]byte, 32)); err != nil {
+		t.Fatalf("unlocked account can't sign: %v", err)
+	}
+
+	unknown := common.HexToAddress("0x1")
+	if _, err := m.PrivateKey(unknown, "secret"); !errors.Is(err, ErrAccountNotFound) {
+		t.Fatalf("key of an unknown account: %v", err)
+	}
+	if err := m.Unlock(unknown, "secret"); !errors.Is(err, ErrAccountNotFound) {
+		t.Fatalf("unlocked an unknown account: %v", err)
+	}
+}
+
+func TestReadPassphraseFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	for content, want := range map[string]string{
+		"secret":               "secret",
+		"secret\n":             "secret",
+		"secret \t\r\n":        "secret",
+		"  spaced out\nnext\n": "  spaced out",
+	} {
+		got, err := ReadPassphraseFile(write("password", content))
+		if err != nil || got != want {
+			t.Fatalf("read %q (%v) from %q, want %q", got, err, content, want)
+		}
+	}
+
+	if _, err := ReadPassphraseFile(write("empty", "\nsecret\n")); !errors.Is(err, ErrEmptyPassphrase) {
+		t.Fatalf("empty first line: %v", err)
+	}
+	for _, path := range []string{"", filepath.Join(dir, "missing")} {
+		if _, err := ReadPassphraseFile(path); err == nil {
+			t.Fatalf("read passphrase from %q", path)
+		}
+	}
+
+	// LoadKey goes through the file
+	m := NewManager(filepath.Join(dir, "keystore"), true)
+	account, err := m.NewAccount("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.LoadKey(account.Address, write("password", "secret\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.LoadKey(account.Address, write("password", "wrong\n")); !errors.Is(err, keystore.ErrDecrypt) {
+		t.Fatalf("key loaded with a wrong password file: %v", err)
+	}
+}
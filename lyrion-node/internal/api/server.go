@@ -1,19 +1,28 @@
 package api
 
 import (
+	"bytes"
+	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"math/big"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/lyrion-l2/lyrion-node/internal/consensus"
 	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/execution"
 	"github.com/lyrion-l2/lyrion-node/internal/mempool"
+	"github.com/lyrion-l2/lyrion-node/internal/node"
 	"github.com/lyrion-l2/lyrion-node/internal/settlement"
 	"github.com/lyrion-l2/lyrion-node/internal/state"
 )
@@ -23,13 +32,28 @@ type Server struct {
 	mempool   *mempool.Mempool
 	sequencer *consensus.Sequencer
 	relayer   *settlement.Relayer
+	deposits  *settlement.DepositWatcher
+	verifier  *settlement.Verifier
+	challenger *settlement.Challenger
+	oracle    *settlement.PriceOracle
+	follower  *consensus.Follower
+	syncer    *node.SyncManager
+	p2p       *node.P2PNode
+	chainID   *big.Int
+	
+	// Unlocked dev accounts used by eth_sendTransaction (--dev only)
+	devKeystore *keystore.KeyStore
+	
+	// Key that wraps and pays for signed intents (lyr_sendIntent)
+	intentRelayer *ecdsa.PrivateKey
 }
 
-func NewServer(state state.StateDB, mp *mempool.Mempool, seq *consensus.Sequencer) *Server {
+func NewServer(state state.StateDB, mp *mempool.Mempool, seq *consensus.Sequencer, chainID *big.Int) *Server {
 	return &Server{
 		state:     state,
 		mempool:   mp,
 		sequencer: seq,
+		chainID:   chainID,
 	}
 }
 
@@ -38,6 +62,53 @@ func (s *Server) SetRelayer(r *settlement.Relayer) {
 	s.relayer = r
 }
 
+// SetDepositWatcher sets the L1 deposit watcher (reported in lyr_getSettlementStats)
+func (s *Server) SetDepositWatcher(w *settlement.DepositWatcher) {
+	s.deposits = w
+}
+
+// SetVerifier sets the L1 derivation verifier (--verifier nodes)
+func (s *Server) SetVerifier(v *settlement.Verifier) {
+	s.verifier = v
+}
+
+// SetChallenger sets the batch challenger (--verifier nodes with --challenger.address)
+func (s *Server) SetChallenger(c *settlement.Challenger) {
+	s.challenger = c
+}
+
+// SetPriceOracle sets the FTSO price oracle (reported in lyr_getSettlementStats)
+func (s *Server) SetPriceOracle(o *settlement.PriceOracle) {
+	s.oracle = o
+}
+
+// SetFollower sets the block importer (--follower nodes)
+func (s *Server) SetFollower(f *consensus.Follower) {
+	s.follower = f
+}
+
+// SetP2PNode sets the P2P node (reported in lyr_getPeers)
+func (s *Server) SetP2PNode(n *node.P2PNode) {
+	s.p2p = n
+}
+
+// SetSyncManager sets the P2P block sync (reported in lyr_getSyncStatus)
+func (s *Server) SetSyncManager(m *node.SyncManager) {
+	s.syncer = m
+}
+
+// SetDevKeystore enables eth_sendTransaction, signing with the unlocked
+// accounts of the given keystore. Only used in --dev mode.
+func (s *Server) SetDevKeystore(ks *keystore.KeyStore) {
+	s.devKeystore = ks
+}
+
+// SetIntentRelayer enables lyr_sendIntent. The relayer key signs the
+// TxTypeIntent transactions and pays their gas.
+func (s *Server) SetIntentRelayer(key *ecdsa.PrivateKey) {
+	s.intentRelayer = key
+}
+
 // StartHTTP starts the JSON-RPC HTTP server.
 func (s *Server) StartHTTP(port int) {
 	mux := http.NewServeMux()
@@ -97,6 +168,18 @@ func (s *Server) handleRPC(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	
+	// Batch requests are JSON arrays
+	if trimmed := bytes.TrimLeft(body, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '[' {
+		var reqs []RPCRequest
+		if err := json.Unmarshal(body, &reqs); err != nil || len(reqs) == 0 {
+			http.Error(w, "invalid batch", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(s.executeBatch(reqs))
+		return
+	}
+	
 	var req RPCRequest
 	if err := json.Unmarshal(body, &req); err != nil {
 		http.Error(w, "invalid json", http.StatusBadRequest)
@@ -109,13 +192,49 @@ func (s *Server) handleRPC(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
+// executeBatch answers a batch request in order. The raw txs of its
+// eth_sendRawTransaction calls are admitted together with AddBatch, so their
+// signatures are checked in parallel.
+func (s *Server) executeBatch(reqs []RPCRequest) []*RPCResponse {
+	responses := make([]*RPCResponse, len(reqs))
+	var txs []*core.Transaction
+	var txIndexes []int
+	for i := range reqs {
+		if reqs[i].Method != "eth_sendRawTransaction" {
+			continue
+		}
+		tx, err := decodeRawTransaction(reqs[i].Params)
+		if err != nil {
+			responses[i] = &RPCResponse{JSONRPC: "2.0", ID: reqs[i].ID, Error: &RPCError{Code: -32000, Message: err.Error()}}
+			continue
+		}
+		txs = append(txs, tx)
+		txIndexes = append(txIndexes, i)
+	}
+	for j, err := range s.mempool.AddBatch(txs) {
+		i := txIndexes[j]
+		if err != nil {
+			responses[i] = &RPCResponse{JSONRPC: "2.0", ID: reqs[i].ID, Error: &RPCError{Code: -32000, Message: err.Error()}}
+		} else {
+			responses[i] = &RPCResponse{JSONRPC: "2.0", ID: reqs[i].ID, Result: txs[j].Hash().Hex()}
+		}
+	}
+	
+	for i := range reqs {
+		if responses[i] == nil {
+			responses[i] = s.executeMethod(&reqs[i])
+		}
+	}
+	return responses
+}
+
 func (s *Server) executeMethod(req *RPCRequest) *RPCResponse {
 	var result interface{}
 	var err error
 	
 	switch req.Method {
 	case "eth_chainId":
-		result = "0xa455" // 42069
+		result = hexutil.EncodeBig(s.chainID)
 		
 	case "eth_getBalance":
 		result, err = s.ethGetBalance(req.Params)
@@ -159,6 +278,9 @@ func (s *Server) executeMethod(req *RPCRequest) *RPCResponse {
 	case "lyr_getNetworkStats":
 		result, err = s.lyrGetNetworkStats(req.Params)
 
+	case "lyr_getPrice":
+		result, err = s.lyrGetPrice(req.Params)
+
 	case "lyr_getTransactionsByBlock":
 		result, err = s.lyrGetTransactionsByBlock(req.Params)
 
@@ -173,6 +295,36 @@ func (s *Server) executeMethod(req *RPCRequest) *RPCResponse {
 
 	case "lyr_getTransactionsByAddress":
 		result, err = s.lyrGetTransactionsByAddress(req.Params)
+
+	case "lyr_getVerifierStatus":
+		result, err = s.lyrGetVerifierStatus(req.Params)
+
+	case "lyr_getSyncStatus":
+		result, err = s.lyrGetSyncStatus(req.Params)
+
+	case "lyr_getPeers":
+		result, err = s.lyrGetPeers(req.Params)
+
+	case "lyr_getDisputes":
+		result, err = s.lyrGetDisputes(req.Params)
+
+	case "lyr_verifyStepProof":
+		result, err = s.lyrVerifyStepProof(req.Params)
+
+	case "lyr_getBlockFinality":
+		result, err = s.lyrGetBlockFinality(req.Params)
+
+	case "lyr_getWithdrawalProof":
+		result, err = s.lyrGetWithdrawalProof(req.Params)
+
+	case "lyr_sendIntent":
+		result, err = s.lyrSendIntent(req.Params)
+
+	case "lyr_getIntentNonce":
+		result, err = s.lyrGetIntentNonce(req.Params)
+
+	case "lyr_getIntentTypedData":
+		result, err = s.lyrGetIntentTypedData(req.Params)
 		
 	default:
 		return &RPCResponse{
@@ -253,49 +405,45 @@ func (s *Server) ethEstimateGas(params []interface{}) (string, error) {
 }
 
 func (s *Server) ethSendRawTransaction(params []interface{}) (string, error) {
+	tx, err := decodeRawTransaction(params)
+	if err != nil {
+		return "", err
+	}
+	
+	// Signature is verified (and the sender recovered) by the mempool
+	if err := s.mempool.Add(tx); err != nil {
+		return "", err
+	}
+	
+	return tx.Hash().Hex(), nil
+}
+
+// decodeRawTransaction decodes the signed tx of eth_sendRawTransaction.
+func decodeRawTransaction(params []interface{}) (*core.Transaction, error) {
 	if len(params) < 1 {
-		return "", fmt.Errorf("missing raw tx param")
+		return nil, fmt.Errorf("missing raw tx param")
 	}
 	rawTxStr, ok := params[0].(string)
 	if !ok {
-		return "", fmt.Errorf("invalid raw tx param")
+		return nil, fmt.Errorf("invalid raw tx param")
 	}
 	
 	rawTxBytes, err := hexutil.Decode(rawTxStr)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	
 	var ethTx ethtypes.Transaction
 	if err := ethTx.UnmarshalBinary(rawTxBytes); err != nil {
-		return "", fmt.Errorf("tx decode failed: %v", err)
-	}
-	
-	signer := ethtypes.LatestSignerForChainID(big.NewInt(42069)) 
-	from, err := ethtypes.Sender(signer, &ethTx)
-	if err != nil {
-		return "", fmt.Errorf("signature verification failed: %v", err)
-	}
-	
-	tx := &core.Transaction{
-		Type:  uint8(ethTx.Type()),
-		From:  &from,
-		To:    ethTx.To(),
-		Value: ethTx.Value(),
-		Nonce: ethTx.Nonce(),
-		Gas:   ethTx.Gas(),
-		GasPrice: ethTx.GasPrice(),
-		Data:  ethTx.Data(),
-	}
-	
-	if err := s.mempool.Add(tx); err != nil {
-		return "", err
+		return nil, fmt.Errorf("tx decode failed: %v", err)
 	}
-	
-	return ethTx.Hash().Hex(), nil
+	return core.NewTransactionFromEth(&ethTx)
 }
 
 func (s *Server) ethSendTransaction(params []interface{}) (string, error) {
+	if s.devKeystore == nil {
+		return "", fmt.Errorf("eth_sendTransaction is only available in --dev mode, use eth_sendRawTransaction")
+	}
 	if len(params) < 1 {
 		return "", fmt.Errorf("missing tx params")
 	}
@@ -310,6 +458,10 @@ func (s *Server) ethSendTransaction(params []interface{}) (string, error) {
 	valStr, _ := txMap["value"].(string) // hex
 	
 	from := common.HexToAddress(fromStr)
+	account := accounts.Account{Address: from}
+	if !s.devKeystore.HasAddress(from) {
+		return "", fmt.Errorf("unknown account %s", from.Hex())
+	}
 	to := common.HexToAddress(toStr)
 	val, _ := hexutil.DecodeBig(valStr)
 	if val == nil {
@@ -336,6 +488,11 @@ func (s *Server) ethSendTransaction(params []interface{}) (string, error) {
 		}
 	}
 
+	// DEX operations are addressed to the router (see core.RouterAddress)
+	if typeVal != core.TxTypeTransfer {
+		to = core.RouterAddress
+	}
+
 	tx := &core.Transaction{
 		Type:  uint8(typeVal),
 		From:  &from,
@@ -346,14 +503,24 @@ func (s *Server) ethSendTransaction(params []interface{}) (string, error) {
 		Gas:   21000,
 	}
 	
+	// Sign with the unlocked dev account
+	signer := core.NewEIP155Signer(s.chainID)
+	hash := signer.SigningHash(tx)
+	sig, err := s.devKeystore.SignHash(account, hash[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign tx: %v", err)
+	}
+	signedTx, err := signer.WithSignature(tx, sig)
+	if err != nil {
+		return "", err
+	}
+	
 	// Add to Mempool
-	if err := s.mempool.Add(tx); err != nil {
+	if err := s.mempool.Add(signedTx); err != nil {
 		return "", err
 	}
 	
-	// Calculate a fake hash for now (in real node, hash is computed from RLP)
-	// We'll just return a random-looking hash
-	return "0x" + common.Bytes2Hex(common.BigToHash(big.NewInt(int64(s.mempool.Len()))).Bytes()), nil
+	return signedTx.Hash().Hex(), nil
 }
 
 func (s *Server) ethGetBlockByNumber(params []interface{}) (interface{}, error) {
@@ -556,6 +723,17 @@ func (s *Server) lyrGetNetworkStats(params []interface{}) (interface{}, error) {
 	pool := s.state.GetPool("LYR-FLR")
 	tvl := new(big.Int).Add(pool.Reserve0, pool.Reserve1)
 	
+	// USD TVL values both reserves at the FTSO FLR price and LYR at its
+	// USD price (never the spot price, which a swap can move), nil until
+	// the oracle posted one
+	var tvlUSD interface{}
+	if lyr, _, _, err := s.usdPrice("LYR"); err == nil {
+		flr, _ := execution.GetPrice(s.state, "FLR")
+		value := new(big.Int).Mul(pool.Reserve0, lyr)
+		value.Add(value, new(big.Int).Mul(pool.Reserve1, flr))
+		tvlUSD = formatPrice(value.Quo(value, priceUnit))
+	}
+	
 	return map[string]interface{}{
 		"blockHeight":      height,
 		"totalTransactions": totalTxs,
@@ -563,10 +741,76 @@ func (s *Server) lyrGetNetworkStats(params []interface{}) (interface{}, error) {
 		"avgBlockTime":      3, // Hardcoded block time from config
 		"activeValidators":  1, // Single sequencer for now
 		"tvl":               tvl.String(),
-		"chainId":           42069,
+		"tvlUSD":            tvlUSD,
+		"chainId":           s.chainID.Uint64(),
 	}, nil
 }
 
+// priceUnit is 1 USD in L2 price units
+var priceUnit = new(big.Int).Exp(big.NewInt(10), big.NewInt(core.PriceDecimals), nil)
+
+// lyrGetPrice returns the USD price of a token. FTSO feeds are read from L2
+// state, where the price oracle posts them; LYR uses its FTSO feed once one
+// is posted, and the time-weighted LYR-FLR pool price until then.
+func (s *Server) lyrGetPrice(params []interface{}) (interface{}, error) {
+	if len(params) < 1 {
+		return nil, fmt.Errorf("missing symbol param")
+	}
+	symbol, ok := params[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid symbol param")
+	}
+	symbol = strings.ToUpper(symbol)
+	
+	price, timestamp, source, err := s.usdPrice(symbol)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"symbol":    symbol,
+		"price":     price.String(),
+		"decimals":  core.PriceDecimals,
+		"usd":       formatPrice(price),
+		"timestamp": timestamp,
+		"source":    source,
+	}, nil
+}
+
+// usdPrice returns symbol's USD price with core.PriceDecimals decimals, the
+// FTSO timestamp it is based on and where it comes from.
+func (s *Server) usdPrice(symbol string) (*big.Int, uint64, string, error) {
+	if !core.ValidPriceSymbol(symbol) {
+		return nil, 0, "", fmt.Errorf("invalid symbol %q", symbol)
+	}
+	if price, timestamp := execution.GetPrice(s.state, symbol); price != nil {
+		return price, timestamp, "ftso", nil
+	}
+	if symbol != "LYR" {
+		return nil, 0, "", fmt.Errorf("no %s/USD price posted", symbol)
+	}
+	
+	flr, timestamp := execution.GetPrice(s.state, "FLR")
+	if flr == nil {
+		return nil, 0, "", fmt.Errorf("no FLR/USD price posted")
+	}
+	twap, _ := execution.GetPoolPrice(s.state, "LYR-FLR")
+	if twap == nil {
+		return nil, 0, "", fmt.Errorf("LYR-FLR pool has no price")
+	}
+	// FLR per LYR averaged over core.PoolPriceWindow, times FLR/USD
+	price := new(big.Int).Mul(flr, twap)
+	price.Quo(price, priceUnit)
+	return price, timestamp, "LYR-FLR pool TWAP", nil
+}
+
+// formatPrice renders an amount with core.PriceDecimals decimals as a
+// decimal string, e.g. "0.021500".
+func formatPrice(v *big.Int) string {
+	f := new(big.Float).SetInt(v)
+	f.Quo(f, new(big.Float).SetInt(priceUnit))
+	return f.Text('f', 6)
+}
+
 func (s *Server) lyrGetTransactionsByBlock(params []interface{}) (interface{}, error) {
 	if len(params) < 1 {
 		return nil, fmt.Errorf("missing block number param")
@@ -630,9 +874,153 @@ func (s *Server) lyrGetSettlementStats(params []interface{}) (interface{}, error
 	}
 	
 	stats := s.relayer.GetStats()
+	if s.deposits != nil {
+		stats["deposits"] = s.deposits.GetStats()
+	}
+	if s.oracle != nil {
+		stats["oracle"] = s.oracle.GetStats()
+	}
+	return stats, nil
+}
+
+// lyrGetVerifierStatus reports how far a --verifier node has rebuilt and
+// checked the chain from L1.
+func (s *Server) lyrGetVerifierStatus(params []interface{}) (interface{}, error) {
+	if s.verifier == nil {
+		return nil, fmt.Errorf("node is not running in verifier mode")
+	}
+	return s.verifier.GetStats(), nil
+}
+
+// lyrGetPeers lists the connected P2P peers with their GossipSub scores,
+// and the banned ones.
+func (s *Server) lyrGetPeers(params []interface{}) (interface{}, error) {
+	if s.p2p == nil {
+		return nil, fmt.Errorf("P2P networking is not running")
+	}
+	return s.p2p.GetStats(), nil
+}
+
+// lyrGetSyncStatus reports how a --follower node keeps up with the
+// sequencer's blocks.
+func (s *Server) lyrGetSyncStatus(params []interface{}) (interface{}, error) {
+	if s.follower == nil {
+		return nil, fmt.Errorf("node is not running in follower mode")
+	}
+	stats := s.follower.GetStats()
+	if s.syncer != nil {
+		stats["sync"] = s.syncer.GetStats()
+	}
 	return stats, nil
 }
 
+// lyrGetDisputes lists the batches this node's challenger disputed, with
+// their evidence and challenge status.
+func (s *Server) lyrGetDisputes(params []interface{}) (interface{}, error) {
+	if s.challenger == nil {
+		return nil, fmt.Errorf("challenger not configured")
+	}
+	return s.challenger.GetDisputes(), nil
+}
+
+// lyrVerifyStepProof re-executes a step proof (the "step" of a dispute) and
+// reports whether it holds. Any node can check another node's dispute.
+func (s *Server) lyrVerifyStepProof(params []interface{}) (interface{}, error) {
+	if len(params) < 1 {
+		return nil, fmt.Errorf("missing step proof param")
+	}
+	raw, err := json.Marshal(params[0])
+	if err != nil {
+		return nil, err
+	}
+	var proof settlement.StepProof
+	if err := json.Unmarshal(raw, &proof); err != nil {
+		return nil, fmt.Errorf("invalid step proof: %v", err)
+	}
+	result := map[string]interface{}{
+		"valid":   true,
+		"txHash":  proof.TxHash.Hex(),
+		"txFails": proof.Error != "",
+	}
+	if err := settlement.VerifyStepProof(&proof, s.chainID); err != nil {
+		result["valid"] = false
+		result["error"] = err.Error()
+	}
+	return result, nil
+}
+
+// lyrGetBlockFinality reports whether an L2 block is unsafe, safe (its
+// batch is confirmed on L1) or finalized (past the challenge period).
+func (s *Server) lyrGetBlockFinality(params []interface{}) (interface{}, error) {
+	if s.relayer == nil {
+		return nil, fmt.Errorf("settlement relayer not configured")
+	}
+	if len(params) < 1 {
+		return nil, fmt.Errorf("missing block number param")
+	}
+	
+	var number uint64
+	switch v := params[0].(type) {
+	case string:
+		n, err := hexutil.DecodeUint64(v)
+		if err != nil {
+			return nil, err
+		}
+		number = n
+	case float64:
+		number = uint64(v)
+	default:
+		return nil, fmt.Errorf("invalid block number param")
+	}
+	if number >= s.sequencer.CurrentHeight() {
+		return nil, fmt.Errorf("block %d not found", number)
+	}
+	
+	status, batch := s.relayer.BlockFinality(number)
+	result := map[string]interface{}{
+		"blockNumber": number,
+		"status":      status,
+	}
+	if batch != nil {
+		result["batchNumber"] = batch.BatchNumber
+		result["batchStatus"] = batch.Status
+		result["l1TxHash"] = batch.SettledTxHash
+	}
+	return result, nil
+}
+
+// lyrGetWithdrawalProof returns the arguments for LyrionBridge.withdrawFromL2
+// for a settled withdrawal tx.
+func (s *Server) lyrGetWithdrawalProof(params []interface{}) (interface{}, error) {
+	if s.relayer == nil {
+		return nil, fmt.Errorf("settlement relayer not configured")
+	}
+	if len(params) < 1 {
+		return nil, fmt.Errorf("missing tx hash param")
+	}
+	hashStr, ok := params[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid tx hash param")
+	}
+	
+	wp, err := s.relayer.GetWithdrawalProof(common.HexToHash(hashStr))
+	if err != nil {
+		return nil, err
+	}
+	proof := make([]string, len(wp.Proof))
+	for i, p := range wp.Proof {
+		proof[i] = p.Hex()
+	}
+	return map[string]interface{}{
+		"batchNumber": wp.BatchNumber,
+		"recipient":   wp.Recipient.Hex(),
+		"amount":      wp.Amount.String(),
+		"proof":       proof,
+		"root":        wp.Root.Hex(),
+		"leaf":        wp.Leaf.Hex(),
+	}, nil
+}
+
 func (s *Server) lyrForceSettle(params []interface{}) (interface{}, error) {
 	if s.relayer == nil {
 		return nil, fmt.Errorf("settlement relayer not configured")
@@ -679,6 +1067,14 @@ func (s *Server) lyrGetTransactionsByAddress(params []interface{}) (interface{},
 					txType = "add_liquidity"
 				} else if tx.Type == 3 {
 					txType = "remove_liquidity"
+				} else if tx.Type == core.TxTypeIntent {
+					txType = "intent"
+				} else if tx.Type == core.TxTypeDeposit {
+					txType = "deposit"
+				} else if tx.Type == core.TxTypeWithdrawal {
+					txType = "withdrawal"
+				} else if tx.Type == core.TxTypePriceUpdate {
+					txType = "price_update"
 				}
 				
 				direction := "send"
@@ -691,7 +1087,11 @@ func (s *Server) lyrGetTransactionsByAddress(params []interface{}) (interface{},
 				
 				// Token symbol from data field
 				symbol := "LYR"
-				if len(tx.Data) > 0 {
+				if tx.Type == core.TxTypeDeposit || tx.Type == core.TxTypeWithdrawal {
+					symbol = "FLR"
+				} else if tx.Type == core.TxTypePriceUpdate {
+					symbol = ""
+				} else if len(tx.Data) > 0 {
 					symbol = string(tx.Data)
 				}
 				
@@ -728,3 +1128,103 @@ func (s *Server) lyrGetTransactionsByAddress(params []interface{}) (interface{},
 	return txList, nil
 }
 
+// parseIntent decodes an intent object from RPC params.
+func parseIntent(param interface{}) (*core.Intent, error) {
+	raw, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	var intent core.Intent
+	if err := json.Unmarshal(raw, &intent); err != nil {
+		return nil, fmt.Errorf("invalid intent: %v", err)
+	}
+	return &intent, nil
+}
+
+// lyrGetIntentTypedData returns the EIP-712 payload to pass to eth_signTypedData_v4.
+func (s *Server) lyrGetIntentTypedData(params []interface{}) (interface{}, error) {
+	if len(params) < 1 {
+		return nil, fmt.Errorf("missing intent param")
+	}
+	intent, err := parseIntent(params[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := intent.Validate(); err != nil {
+		return nil, err
+	}
+	return intent.TypedData(s.chainID), nil
+}
+
+// lyrGetIntentNonce returns the next intent nonce of an owner.
+func (s *Server) lyrGetIntentNonce(params []interface{}) (string, error) {
+	if len(params) < 1 {
+		return "", fmt.Errorf("missing address param")
+	}
+	addrStr, ok := params[0].(string)
+	if !ok {
+		return "", fmt.Errorf("invalid address param")
+	}
+	nonce := s.state.GetState(core.RouterAddress, core.IntentNonceKey(common.HexToAddress(addrStr)))
+	return hexutil.EncodeBig(nonce.Big()), nil
+}
+
+// lyrSendIntent wraps a signed intent in a TxTypeIntent transaction paid
+// by the relayer. Params: [intent, signature].
+func (s *Server) lyrSendIntent(params []interface{}) (interface{}, error) {
+	if s.intentRelayer == nil {
+		return nil, fmt.Errorf("intent relaying is not enabled on this node")
+	}
+	if len(params) < 2 {
+		return nil, fmt.Errorf("missing intent or signature param")
+	}
+	intent, err := parseIntent(params[0])
+	if err != nil {
+		return nil, err
+	}
+	sigStr, ok := params[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid signature param")
+	}
+	sig, err := hexutil.Decode(sigStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %v", err)
+	}
+	
+	// Reject bad intents before the relayer pays for them
+	signed := &core.SignedIntent{Intent: *intent, Signature: sig}
+	if err := signed.Verify(s.chainID); err != nil {
+		return nil, err
+	}
+	if uint64(time.Now().Unix()) > intent.Deadline {
+		return nil, fmt.Errorf("intent deadline passed")
+	}
+	data, err := core.EncodeSignedIntent(signed)
+	if err != nil {
+		return nil, err
+	}
+	
+	relayer := crypto.PubkeyToAddress(s.intentRelayer.PublicKey)
+	router := core.RouterAddress
+	tx, err := core.SignTx(&core.Transaction{
+		Type:  core.TxTypeIntent,
+		To:    &router,
+		Value: big.NewInt(0),
+		Data:  data,
+		Nonce: s.state.GetNonce(relayer) + s.mempool.PendingCount(relayer),
+		Gas:   50000,
+	}, s.chainID, s.intentRelayer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign intent tx: %v", err)
+	}
+	if err := s.mempool.Add(tx); err != nil {
+		return nil, err
+	}
+	
+	return map[string]interface{}{
+		"txHash":  tx.Hash().Hex(),
+		"relayer": relayer.Hex(),
+		"owner":   intent.Owner.Hex(),
+		"nonce":   intent.Nonce,
+	}, nil
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 )
 
 type Config struct {
@@ -10,21 +12,62 @@ type Config struct {
 	NetworkID uint64
 	
 	// Paths
-	DataDir string
+	DataDir        string
+	KeystoreDir    string // Encrypted Web3 Secret Storage keystore
+	DevKeystoreDir string // Keystore holding the unlocked --dev accounts
 	
 	// Networking
-	HTTPHost string
-	HTTPPort int
-	WSHost   string
-	WSPort   int
+	HTTPHost    string
+	HTTPPort    int
+	WSHost      string
+	WSPort      int
+	P2PPort     int
+	P2PMinPeers int // Connection manager watermarks: above P2PMaxPeers,
+	P2PMaxPeers int // connections are trimmed back to P2PMinPeers
+	P2PMDNS     bool // Discover peers on the LAN (always on in dev mode)
+	
+	// P2P peers, as multiaddrs ending in /p2p/<peer ID>
+	P2PBootnodes    []string // Dialled at startup to join the network
+	P2PStaticPeers  []string // Kept connected, redialled when they drop
+	P2PTrustedPeers []string // Never banned or trimmed (bare peer IDs allowed)
+	
+	// Dev mode: unlocked local accounts, eth_sendTransaction enabled
+	DevMode bool
 	
 	// Consensus / Sequencer
-	IsSequencer       bool
-	SequencerPrivKey  string // Hex string, should be loaded from secure secret store in prod
+	IsSequencer           bool // false = follower: import the sequencer's blocks from P2P
+	VerifierMode          bool // Derive the chain from L1 instead of producing blocks
+	SequencerAddress      string // Keystore account that signs L2 blocks (followers: address blocks must be signed by)
+	SequencerPasswordFile string
 	
 	// L1 Interaction (Flare)
-	FlareRPC          string
-	BatchSubmitterPri string // Private key for submitting batches to L1
+	FlareRPC                   string
+	BridgeAddress              string // LyrionBridge contract on L1
+	BatchInboxAddress          string // L1 address batch data is sent to (empty = derived from NetworkID)
+	L1Confirmations            uint64 // L1 confirmations before a deposit is credited or a batch is confirmed
+	L1StartBlock               uint64 // First L1 block scanned for deposits (bridge deployment)
+	BatchSubmitterAddress      string // Keystore account that submits batches to L1
+	BatchSubmitterPasswordFile string
+	ChallengerAddress          string // Keystore account that challenges invalid batches (verifier mode)
+	ChallengerPasswordFile     string
+	
+	// L1 transaction management
+	L1FeeBumpPercent   uint64        // Fee increase when replacing a stuck tx
+	L1ResubmitInterval time.Duration // Pending time before a tx gets its fees bumped
+	L1MaxFeeGwei       uint64        // Cap on maxFeePerGas (0 = no cap)
+	L1MinBalance       uint64        // Alert when the batch submitter holds less FLR than this
+	
+	// Batching policy: a batch is closed by whichever limit is hit first (0 = off)
+	BatchMaxBlocks    uint64
+	BatchMaxBytes     uint64        // Compressed batch data
+	BatchMaxAge       time.Duration // Age of the oldest pending block
+	BatchGasPriceGwei uint64        // Settle pending blocks while L1 gas is at or below this
+	BatchPollInterval time.Duration
+	
+	// FTSO price oracle (off when no FTSOv2 address is set)
+	OracleFtsoAddress string        // FTSOv2 contract on L1
+	OracleFeeds       []string      // Symbols of the USD feeds posted to L2
+	OracleInterval    time.Duration // How often the FTSO is read
 }
 
 // DefaultConfig returns a standard configuration for local dev
@@ -42,17 +85,63 @@ func DefaultConfig() *Config {
 		flareRPC = "https://coston2-api.flare.network/ext/C/rpc" // Flare Coston2 Testnet
 	}
 	
-	l1PrivKey := os.Getenv("LYRION_L1_PRIVATE_KEY") // Set this to enable real L1 settlement
+	keystoreDir := os.Getenv("LYRION_KEYSTORE")
+	if keystoreDir == "" {
+		keystoreDir = fmt.Sprintf("%s/.lyrion/keystore", home)
+	}
 
 	return &Config{
 		NetworkID:         42069, // LYRION Testnet
 		DataDir:           dataDir,
+		KeystoreDir:       keystoreDir,
+		DevKeystoreDir:    fmt.Sprintf("%s/.lyrion/dev-keystore", home),
 		HTTPHost:          "127.0.0.1",
 		HTTPPort:          8545,
 		WSHost:            "127.0.0.1",
 		WSPort:            8546,
+		P2PPort:           9000,
+		P2PMinPeers:       32,
+		P2PMaxPeers:       64,
 		IsSequencer:       true,
 		FlareRPC:          flareRPC,
-		BatchSubmitterPri: l1PrivKey,
+		
+		L1Confirmations:      12,
+		L1FeeBumpPercent:     15,
+		L1ResubmitInterval:   45 * time.Second,
+		L1MaxFeeGwei:         1000,
+		L1MinBalance:         10,
+		
+		BatchMaxBlocks:    100,
+		BatchMaxBytes:     119_980, // One frame: a single L1 data tx
+		BatchMaxAge:       30 * time.Second,
+		BatchPollInterval: 10 * time.Second,
+		
+		OracleFeeds:    []string{"FLR", "BTC", "ETH"},
+		OracleInterval: 90 * time.Second, // One FTSO voting epoch
+		
+		// Set these (or the matching flags) to enable real L1 settlement
+		SequencerAddress:           os.Getenv("LYRION_SEQUENCER_ADDRESS"),
+		SequencerPasswordFile:      os.Getenv("LYRION_SEQUENCER_PASSWORD_FILE"),
+		BatchSubmitterAddress:      os.Getenv("LYRION_BATCHER_ADDRESS"),
+		BatchSubmitterPasswordFile: os.Getenv("LYRION_BATCHER_PASSWORD_FILE"),
+		ChallengerAddress:          os.Getenv("LYRION_CHALLENGER_ADDRESS"),
+		ChallengerPasswordFile:     os.Getenv("LYRION_CHALLENGER_PASSWORD_FILE"),
+		BridgeAddress:              os.Getenv("LYRION_BRIDGE_ADDRESS"),
+		BatchInboxAddress:          os.Getenv("LYRION_BATCH_INBOX"),
+		OracleFtsoAddress:          os.Getenv("LYRION_FTSO_ADDRESS"),
+		P2PBootnodes:               SplitList(os.Getenv("LYRION_BOOTNODES")),
+		P2PStaticPeers:             SplitList(os.Getenv("LYRION_STATIC_PEERS")),
+		P2PTrustedPeers:            SplitList(os.Getenv("LYRION_TRUSTED_PEERS")),
+	}
+}
+
+// SplitList splits a comma-separated list, dropping blanks.
+func SplitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
 	}
+	return items
 }
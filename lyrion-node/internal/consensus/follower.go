@@ -0,0 +1,217 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/execution"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+// maxFutureBlocks bounds how many blocks ahead of the head a follower keeps
+// while it waits for the missing ones.
+const maxFutureBlocks = 256
+
+var (
+	ErrKnownBlock       = errors.New("block already imported")
+	ErrConflictingBlock = errors.New("block conflicts with the local chain")
+	ErrFutureBlock      = errors.New("block is too far ahead")
+	ErrInvalidBlock     = errors.New("invalid block")
+)
+
+// Follower imports the blocks of the sequencer instead of producing its own:
+// each block must extend the local head, be sealed by the sequencer and give
+// the state root in its header when its txs are re-executed. Imported
+// blocks are stored through the Sequencer, so RPC serves them like local
+// ones.
+type Follower struct {
+	sequencer *Sequencer
+	executor  *execution.Executor
+	state     state.StateDB
+	chainID   *big.Int
+	signer    common.Address // Sequencer address blocks must be sealed by
+
+	future   map[uint64]*core.Block // Sealed blocks waiting for their parent
+	imported uint64
+	rejected uint64
+	lastErr  error
+	mu       sync.Mutex
+}
+
+// NewFollower creates a follower importing blocks sealed by signer into seq.
+func NewFollower(seq *Sequencer, exec *execution.Executor, st state.StateDB, chainID *big.Int, signer common.Address) *Follower {
+	if _, ok := st.(snapshotState); !ok {
+		log.Printf("⚠️ Follower state can't roll back: a rejected block may leave partial changes")
+	}
+	return &Follower{
+		sequencer: seq,
+		executor:  exec,
+		state:     st,
+		chainID:   chainID,
+		signer:    signer,
+		future:    make(map[uint64]*core.Block),
+	}
+}
+
+// ImportBlock validates and imports a block received from the network.
+// Blocks ahead of the head are kept until their parent arrives.
+func (f *Follower) ImportBlock(block *core.Block) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	err := f.importBlock(block)
+	if err != nil && !errors.Is(err, ErrKnownBlock) {
+		f.rejected++
+		f.lastErr = err
+	}
+	return err
+}
+
+func (f *Follower) importBlock(block *core.Block) error {
+	if block == nil || block.Header == nil {
+		return fmt.Errorf("%w: no header", ErrInvalidBlock)
+	}
+	number := block.Header.Number
+	head := f.sequencer.CurrentHeight()
+
+	if number < head {
+		if local := f.sequencer.GetBlock(number); local != nil && local.Header.Hash() == block.Header.Hash() {
+			return ErrKnownBlock
+		}
+		return fmt.Errorf("%w: block #%d", ErrConflictingBlock, number)
+	}
+	if err := f.checkSeal(block.Header); err != nil {
+		return err
+	}
+	if number > head {
+		if number >= head+maxFutureBlocks {
+			return fmt.Errorf("%w: block #%d, head is #%d", ErrFutureBlock, number, head-1)
+		}
+		f.future[number] = block
+		return nil
+	}
+
+	delete(f.future, number)
+	if err := f.apply(block); err != nil {
+		return err
+	}
+	// Blocks that were waiting for this one. Their failures are their own:
+	// this block was imported either way.
+	for {
+		next, ok := f.future[f.sequencer.CurrentHeight()]
+		if !ok {
+			break
+		}
+		delete(f.future, next.Header.Number)
+		if err := f.apply(next); err != nil {
+			log.Printf("⚠️ Follower: Dropped queued block #%d: %v", next.Header.Number, err)
+			f.rejected++
+			f.lastErr = err
+			break
+		}
+	}
+	return nil
+}
+
+// checkSeal makes sure the header was sealed by the sequencer.
+func (f *Follower) checkSeal(h *core.Header) error {
+	signer, err := h.SealSigner(f.chainID)
+	if err != nil {
+		return fmt.Errorf("block #%d: %w", h.Number, err)
+	}
+	if signer != f.signer || h.Coinbase != f.signer {
+		return fmt.Errorf("block #%d: %w: sealed by %s, expected sequencer %s", h.Number, core.ErrInvalidSeal, signer.Hex(), f.signer.Hex())
+	}
+	return nil
+}
+
+// apply checks the next block against the local chain, re-executes it and
+// stores it. A block that fails is rolled back.
+func (f *Follower) apply(block *core.Block) error {
+	h := block.Header
+	var parentHash common.Hash
+	if h.Number > 1 {
+		parent := f.sequencer.GetBlock(h.Number - 1)
+		if parent == nil {
+			return fmt.Errorf("block #%d: parent not found", h.Number)
+		}
+		parentHash = parent.Header.Hash()
+	}
+	if h.ParentHash != parentHash {
+		return fmt.Errorf("%w: block #%d has parent %s, local head is %s", ErrInvalidBlock, h.Number, h.ParentHash.Hex(), parentHash.Hex())
+	}
+	if root := core.TxRoot(block.Transactions); h.TxRoot != root {
+		return fmt.Errorf("%w: block #%d tx root is %s, header claims %s", ErrInvalidBlock, h.Number, root.Hex(), h.TxRoot.Hex())
+	}
+
+	err := revertOnError(f.state, f.executor, func(st state.StateDB, exec *execution.Executor) error {
+		root, err := f.execute(st, exec, block)
+		if err == nil && root != h.Root {
+			err = fmt.Errorf("%w: block #%d state root is %s, header claims %s", ErrInvalidBlock, h.Number, root.Hex(), h.Root.Hex())
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := f.state.Commit(true); err != nil {
+		return err
+	}
+
+	if err := f.sequencer.InsertBlock(block); err != nil {
+		return err
+	}
+	f.imported++
+	return nil
+}
+
+// execute runs the block's txs on st and returns the resulting state root.
+func (f *Follower) execute(st state.StateDB, exec *execution.Executor, block *core.Block) (common.Hash, error) {
+	exec.SetBlockTime(block.Header.Time)
+
+	// Recover all senders up front, in parallel. System txs are unsigned,
+	// their errors are ignored.
+	senders, sigErrs := core.RecoverSenders(block.Transactions, f.chainID)
+	for i, tx := range block.Transactions {
+		var err error
+		switch tx.Type {
+		case core.TxTypeDeposit:
+			err = exec.ExecuteDeposit(tx)
+		case core.TxTypePriceUpdate:
+			err = exec.ExecutePriceUpdate(tx)
+		default:
+			if err = sigErrs[i]; err == nil {
+				tx.From = &senders[i]
+				err = exec.ExecuteTransaction(tx, senders[i])
+			}
+		}
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("%w: block #%d: tx %s: %v", ErrInvalidBlock, block.Header.Number, tx.Hash().Hex(), err)
+		}
+	}
+	return st.Commit(true)
+}
+
+// GetStats returns follower statistics
+func (f *Follower) GetStats() map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	head := f.sequencer.CurrentHeight() - 1
+	stats := map[string]interface{}{
+		"head":      head,
+		"sequencer": f.signer.Hex(),
+		"imported":  f.imported,
+		"rejected":  f.rejected,
+		"waiting":   len(f.future),
+	}
+	if f.lastErr != nil {
+		stats["lastError"] = f.lastErr.Error()
+	}
+	return stats
+}
@@ -0,0 +1,255 @@
+package consensus
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/execution"
+	"github.com/lyrion-l2/lyrion-node/internal/mempool"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+var testChainID = big.NewInt(42069)
+
+type testChain struct {
+	state *state.BadgerStateDB
+	seq   *Sequencer
+	pool  *mempool.Mempool
+	exec  *execution.Executor
+}
+
+// newTestChain creates a chain on an in-memory state whose genesis funds
+// user. key seals blocks, nil for followers.
+func newTestChain(t *testing.T, key *ecdsa.PrivateKey, user common.Address) *testChain {
+	t.Helper()
+	st, err := state.NewInMemoryBadgerStateDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(st.Close)
+	exec := execution.NewExecutor(st, testChainID)
+	exec.Mint(user, big.NewInt(params.Ether), new(big.Int))
+	if _, err := st.Commit(true); err != nil {
+		t.Fatal(err)
+	}
+	pool := mempool.NewMempool(testChainID)
+	return &testChain{state: st, seq: NewSequencer(st, pool, exec, testChainID, key), pool: pool, exec: exec}
+}
+
+// transfer returns a signed transfer of value from key at nonce.
+func transfer(t *testing.T, key *ecdsa.PrivateKey, nonce uint64, value int64) *core.Transaction {
+	t.Helper()
+	to := common.HexToAddress("0xb0b")
+	tx, err := core.SignTx(&core.Transaction{Type: core.TxTypeTransfer, Nonce: nonce, To: &to, Value: big.NewInt(value), Gas: 21000}, testChainID, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+// followerSetup holds a sequencer chain of n blocks, each with one transfer
+// from user, and a follower of it at genesis.
+type followerSetup struct {
+	seqKey, userKey *ecdsa.PrivateKey
+	blocks          []*core.Block // blocks[i] is block #i+1
+	chain           *testChain
+	follower        *Follower
+}
+
+func newFollowerSetup(t *testing.T, n int) *followerSetup {
+	t.Helper()
+	s := &followerSetup{}
+	s.seqKey, _ = crypto.GenerateKey()
+	s.userKey, _ = crypto.GenerateKey()
+	user := crypto.PubkeyToAddress(s.userKey.PublicKey)
+
+	seq := newTestChain(t, s.seqKey, user)
+	for i := 0; i < n; i++ {
+		if err := seq.pool.Add(transfer(t, s.userKey, uint64(i), int64(i+1))); err != nil {
+			t.Fatal(err)
+		}
+		block, err := seq.seq.ProduceBlock()
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.blocks = append(s.blocks, block)
+	}
+
+	s.chain = newTestChain(t, nil, user)
+	s.follower = NewFollower(s.chain.seq, s.chain.exec, s.chain.state, testChainID, crypto.PubkeyToAddress(s.seqKey.PublicKey))
+	return s
+}
+
+// modified returns a copy of block with edit applied to its header and
+// txs, sealed by key on chainID.
+func modified(t *testing.T, block *core.Block, key *ecdsa.PrivateKey, chainID *big.Int, edit func(h *core.Header, txs []*core.Transaction) []*core.Transaction) *core.Block {
+	t.Helper()
+	h := *block.Header
+	txs := append([]*core.Transaction{}, block.Transactions...)
+	if edit != nil {
+		txs = edit(&h, txs)
+	}
+	if err := h.Seal(key, chainID); err != nil {
+		t.Fatal(err)
+	}
+	return core.NewBlock(&h, txs)
+}
+
+func TestFollowerRejectsInvalidBlocks(t *testing.T) {
+	otherKey, _ := crypto.GenerateKey()
+	for _, c := range []struct {
+		name     string
+		imported int // Valid blocks imported first
+		block    func(t *testing.T, s *followerSetup) *core.Block
+		want     error
+	}{
+		{"unsealed", 0, func(t *testing.T, s *followerSetup) *core.Block {
+			h := *s.blocks[0].Header
+			h.Signature = nil
+			return core.NewBlock(&h, s.blocks[0].Transactions)
+		}, core.ErrInvalidSeal},
+		{"sealed by another key", 0, func(t *testing.T, s *followerSetup) *core.Block {
+			return modified(t, s.blocks[0], otherKey, testChainID, nil)
+		}, core.ErrInvalidSeal},
+		{"sealed for another chain", 0, func(t *testing.T, s *followerSetup) *core.Block {
+			return modified(t, s.blocks[0], s.seqKey, big.NewInt(1), nil)
+		}, core.ErrInvalidSeal},
+		{"coinbase is not the sequencer", 0, func(t *testing.T, s *followerSetup) *core.Block {
+			return modified(t, s.blocks[0], s.seqKey, testChainID, func(h *core.Header, txs []*core.Transaction) []*core.Transaction {
+				h.Coinbase = crypto.PubkeyToAddress(otherKey.PublicKey)
+				return txs
+			})
+		}, core.ErrInvalidSeal},
+		{"parent mismatch", 1, func(t *testing.T, s *followerSetup) *core.Block {
+			return modified(t, s.blocks[1], s.seqKey, testChainID, func(h *core.Header, txs []*core.Transaction) []*core.Transaction {
+				h.ParentHash = common.Hash{1}
+				return txs
+			})
+		}, ErrInvalidBlock},
+		{"tx root mismatch", 0, func(t *testing.T, s *followerSetup) *core.Block {
+			return modified(t, s.blocks[0], s.seqKey, testChainID, func(h *core.Header, txs []*core.Transaction) []*core.Transaction {
+				return append(txs, transfer(t, s.userKey, 1, 1))
+			})
+		}, ErrInvalidBlock},
+		{"state root mismatch", 1, func(t *testing.T, s *followerSetup) *core.Block {
+			return modified(t, s.blocks[1], s.seqKey, testChainID, func(h *core.Header, txs []*core.Transaction) []*core.Transaction {
+				h.Root = common.Hash{1}
+				return txs
+			})
+		}, ErrInvalidBlock},
+		{"failing tx after a valid one", 1, func(t *testing.T, s *followerSetup) *core.Block {
+			return modified(t, s.blocks[1], s.seqKey, testChainID, func(h *core.Header, txs []*core.Transaction) []*core.Transaction {
+				txs = append(txs, transfer(t, s.userKey, 9, 1))
+				h.TxRoot = core.TxRoot(txs)
+				return txs
+			})
+		}, ErrInvalidBlock},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			s := newFollowerSetup(t, 2)
+			for _, b := range s.blocks[:c.imported] {
+				if err := s.follower.ImportBlock(b); err != nil {
+					t.Fatal(err)
+				}
+			}
+			user := crypto.PubkeyToAddress(s.userKey.PublicKey)
+			root, _ := s.chain.state.Commit(true)
+			balance, nonce := s.chain.state.GetBalanceLYR(user), s.chain.state.GetNonce(user)
+
+			if err := s.follower.ImportBlock(c.block(t, s)); !errors.Is(err, c.want) {
+				t.Fatalf("import: %v, want %v", err, c.want)
+			}
+
+			// Nothing of the rejected block is left behind
+			if height := s.chain.seq.CurrentHeight(); height != uint64(c.imported)+1 {
+				t.Fatalf("head moved to #%d", height-1)
+			}
+			if got, _ := s.chain.state.Commit(true); got != root {
+				t.Fatalf("state root %s after a rejected block, want %s", got.Hex(), root.Hex())
+			}
+			if s.chain.state.GetBalanceLYR(user).Cmp(balance) != 0 || s.chain.state.GetNonce(user) != nonce {
+				t.Fatal("rejected block changed the sender account")
+			}
+			if stats := s.follower.GetStats(); stats["rejected"] != uint64(1) || stats["waiting"] != 0 {
+				t.Fatalf("stats %v after one rejected block", stats)
+			}
+		})
+	}
+}
+
+// Blocks ahead of the head wait for their parent, up to maxFutureBlocks.
+func TestFollowerFutureBlocks(t *testing.T) {
+	s := newFollowerSetup(t, 3)
+	for _, b := range []*core.Block{s.blocks[2], s.blocks[1]} {
+		if err := s.follower.ImportBlock(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if height := s.chain.seq.CurrentHeight(); height != 1 {
+		t.Fatalf("imported up to #%d without block #1", height-1)
+	}
+
+	far := func(number uint64) *core.Block {
+		return modified(t, s.blocks[0], s.seqKey, testChainID, func(h *core.Header, txs []*core.Transaction) []*core.Transaction {
+			h.Number = number
+			return txs
+		})
+	}
+	if err := s.follower.ImportBlock(far(1 + maxFutureBlocks)); !errors.Is(err, ErrFutureBlock) {
+		t.Fatalf("block %d ahead: %v", maxFutureBlocks, err)
+	}
+	if err := s.follower.ImportBlock(far(maxFutureBlocks)); err != nil {
+		t.Fatalf("block %d ahead: %v", maxFutureBlocks-1, err)
+	}
+
+	if err := s.follower.ImportBlock(s.blocks[0]); err != nil {
+		t.Fatal(err)
+	}
+	if height := s.chain.seq.CurrentHeight(); height != 4 {
+		t.Fatalf("head is #%d, want the queued blocks imported up to #3", height-1)
+	}
+	if head := s.chain.seq.GetBlock(3); head.Header.Hash() != s.blocks[2].Header.Hash() {
+		t.Fatal("follower head differs from the sequencer's")
+	}
+
+	if err := s.follower.ImportBlock(s.blocks[1]); !errors.Is(err, ErrKnownBlock) {
+		t.Fatalf("reimport: %v", err)
+	}
+	conflicting := modified(t, s.blocks[1], s.seqKey, testChainID, func(h *core.Header, txs []*core.Transaction) []*core.Transaction {
+		h.Time++
+		return txs
+	})
+	if err := s.follower.ImportBlock(conflicting); !errors.Is(err, ErrConflictingBlock) {
+		t.Fatalf("conflicting block: %v", err)
+	}
+}
+
+// A queued block that fails is dropped without failing the block that
+// released it.
+func TestFollowerQueuedBlockFails(t *testing.T) {
+	s := newFollowerSetup(t, 2)
+	bad := modified(t, s.blocks[1], s.seqKey, testChainID, func(h *core.Header, txs []*core.Transaction) []*core.Transaction {
+		h.Root = common.Hash{1}
+		return txs
+	})
+	if err := s.follower.ImportBlock(bad); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.follower.ImportBlock(s.blocks[0]); err != nil {
+		t.Fatalf("import of the parent of a bad block: %v", err)
+	}
+	stats := s.follower.GetStats()
+	if stats["head"] != uint64(1) || stats["imported"] != uint64(1) || stats["rejected"] != uint64(1) || stats["waiting"] != 0 {
+		t.Fatalf("stats %v, want block #1 imported and #2 rejected", stats)
+	}
+
+	if err := s.follower.ImportBlock(s.blocks[1]); err != nil {
+		t.Fatalf("import of the valid block #2: %v", err)
+	}
+}
@@ -1,32 +1,67 @@
 package consensus
 
 import (
+	"crypto/ecdsa"
+	"encoding/binary"
 	"fmt"
+	"math/big"
 	"time"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/lyrion-l2/lyrion-node/internal/core"
 	"github.com/lyrion-l2/lyrion-node/internal/execution"
 	"github.com/lyrion-l2/lyrion-node/internal/mempool"
 	"github.com/lyrion-l2/lyrion-node/internal/state"
 )
 
+// snapshotState is a state that can stage changes on a snapshot
+// (state.BadgerStateDB).
+type snapshotState interface {
+	Snapshot() *state.BadgerStateDB
+}
+
+// revertOnError runs fn on a snapshot of the state and only applies its
+// changes if it succeeds, so a failed tx or block leaves no partial changes
+// behind. States without snapshots are changed in place.
+func revertOnError(st state.StateDB, exec *execution.Executor, fn func(st state.StateDB, exec *execution.Executor) error) error {
+	ss, ok := st.(snapshotState)
+	if !ok {
+		return fn(st, exec)
+	}
+	snap := ss.Snapshot()
+	if err := fn(snap, exec.WithState(snap)); err != nil {
+		return err
+	}
+	return snap.Flush()
+}
+
 // Sequencer is the single-node block producer.
 type Sequencer struct {
 	state    state.StateDB
 	mempool  *mempool.Mempool
 	executor *execution.Executor
 	
+	chainID            *big.Int
 	currentBlockNumber uint64
 	coinbase           common.Address
+	key                *ecdsa.PrivateKey // Sequencer key, loaded from the keystore
+	
+	// L1 deposits waiting for inclusion, executed ahead of user txs
+	deposits []*core.Transaction
+	
+	// Latest FTSO price update waiting for inclusion, executed after deposits
+	priceUpdate *core.Transaction
 	
 	// In-memory cache for fast access (backed by DB)
 	blockCache map[uint64]*core.Block
 	mu     sync.RWMutex
 }
 
-func NewSequencer(st state.StateDB, mp *mempool.Mempool, exec *execution.Executor, coinbase common.Address) *Sequencer {
+// NewSequencer creates the block producer. key may be nil on nodes that only
+// import blocks (e.g. verifiers).
+func NewSequencer(st state.StateDB, mp *mempool.Mempool, exec *execution.Executor, chainID *big.Int, key *ecdsa.PrivateKey) *Sequencer {
 	// Load existing block height from DB
 	storedHeight := st.GetBlockHeight()
 	startHeight := uint64(1)
@@ -40,10 +75,14 @@ func NewSequencer(st state.StateDB, mp *mempool.Mempool, exec *execution.Executo
 		state:              st,
 		mempool:            mp,
 		executor:           exec,
+		chainID:            chainID,
 		currentBlockNumber: startHeight,
-		coinbase:           coinbase,
+		key:                key,
 		blockCache:         make(map[uint64]*core.Block),
 	}
+	if key != nil {
+		seq.coinbase = crypto.PubkeyToAddress(key.PublicKey)
+	}
 	
 	// Pre-load recent blocks into cache
 	for i := uint64(1); i <= storedHeight && i <= 100; i++ {
@@ -52,9 +91,50 @@ func NewSequencer(st state.StateDB, mp *mempool.Mempool, exec *execution.Executo
 		}
 	}
 	
+	// Index blocks stored before the hash index existed
+	var indexed uint64
+	if data := st.GetMeta(blockHashIndexedKey); len(data) == 8 {
+		indexed = binary.BigEndian.Uint64(data)
+	}
+	for i := indexed + 1; i <= storedHeight; i++ {
+		if block := st.GetBlock(i); block != nil {
+			seq.indexBlock(block)
+		}
+	}
+	
 	return seq
 }
 
+// Metadata keys of the block hash index
+const (
+	blockHashPrefix     = "blockhash-"
+	blockHashIndexedKey = "blockhash-indexed-v2" // Highest block indexed (v2: full header hash)
+)
+
+// indexBlock records the block's number under its hash.
+func (s *Sequencer) indexBlock(block *core.Block) {
+	var number [8]byte
+	binary.BigEndian.PutUint64(number[:], block.Header.Number)
+	if err := s.state.SetMeta(blockHashPrefix+block.Header.Hash().Hex(), number[:]); err != nil {
+		fmt.Printf("⚠️ Failed to index block #%d: %v\n", block.Header.Number, err)
+		return
+	}
+	s.state.SetMeta(blockHashIndexedKey, number[:])
+}
+
+// GetBlockByHash returns a block by header hash (nil if unknown)
+func (s *Sequencer) GetBlockByHash(hash common.Hash) *core.Block {
+	data := s.state.GetMeta(blockHashPrefix + hash.Hex())
+	if len(data) != 8 {
+		return nil
+	}
+	block := s.GetBlock(binary.BigEndian.Uint64(data))
+	if block == nil || block.Header.Hash() != hash {
+		return nil
+	}
+	return block
+}
+
 // CurrentHeight returns the next block number to be mined
 func (s *Sequencer) CurrentHeight() uint64 {
 	s.mu.RLock()
@@ -104,27 +184,108 @@ func (s *Sequencer) GetLatestBlocks(n int) []*core.Block {
 	return blocks
 }
 
-// ProduceBlock creates a new block from mempool transactions.
+// AddDeposit queues an L1 deposit for inclusion in the next block.
+// Deposits already queued (same bridge nonce) are ignored.
+func (s *Sequencer) AddDeposit(d *core.Deposit) error {
+	tx, err := core.NewDepositTx(d)
+	if err != nil {
+		return err
+	}
+	
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, queued := range s.deposits {
+		if queued.Nonce == d.Nonce {
+			return nil
+		}
+	}
+	s.deposits = append(s.deposits, tx)
+	return nil
+}
+
+// PendingDeposits returns the number of deposits waiting for inclusion
+func (s *Sequencer) PendingDeposits() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.deposits)
+}
+
+// AddPriceUpdate queues FTSO prices for inclusion in the next block. It
+// replaces an update that is still queued.
+func (s *Sequencer) AddPriceUpdate(u *core.PriceUpdate) error {
+	tx, err := core.NewPriceUpdateTx(u)
+	if err != nil {
+		return err
+	}
+	
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.priceUpdate = tx
+	return nil
+}
+
+// HasPriceUpdate reports whether a price update waits for inclusion
+func (s *Sequencer) HasPriceUpdate() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.priceUpdate != nil
+}
+
+// ProduceBlock creates a new block from queued deposits, price updates and
+// mempool transactions.
 func (s *Sequencer) ProduceBlock() (*core.Block, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	// 1. Fetch pending txs
 	pending := s.mempool.Peek(100)
-	if len(pending) == 0 {
+	deposits := s.deposits
+	priceUpdate := s.priceUpdate
+	if len(pending) == 0 && len(deposits) == 0 && priceUpdate == nil {
 		return nil, fmt.Errorf("no transactions in mempool")
 	}
+	s.deposits = nil
+	s.priceUpdate = nil
 
 	validTxs := make([]*core.Transaction, 0)
 	
-	// 2. Execute Transactions
-	for _, tx := range pending {
-		if tx.From == nil {
-			fmt.Println("⚠️ Skipping tx with no sender")
+	// Intent deadlines are checked against the block time
+	blockTime := uint64(time.Now().Unix())
+	s.executor.SetBlockTime(blockTime)
+	
+	// Deposits first: they only credit balances and may fund the txs below
+	for _, tx := range deposits {
+		if err := s.executor.ExecuteDeposit(tx); err != nil {
+			fmt.Printf("⚠️ Skipping deposit: %v\n", err)
+			continue
+		}
+		validTxs = append(validTxs, tx)
+	}
+	
+	// Then prices, ahead of the user txs of the block
+	if priceUpdate != nil {
+		if err := s.executor.ExecutePriceUpdate(priceUpdate); err != nil {
+			fmt.Printf("⚠️ Skipping price update: %v\n", err)
+		} else {
+			validTxs = append(validTxs, priceUpdate)
+		}
+	}
+	
+	// 2. Recover senders in one parallel pass (mostly cache hits, the
+	// mempool already verified them)
+	senders, sigErrs := core.RecoverSenders(pending, s.chainID)
+	
+	// 3. Execute Transactions
+	for i, tx := range pending {
+		if sigErrs[i] != nil {
+			fmt.Printf("⚠️ Skipping tx with invalid signature: %v\n", sigErrs[i])
 			continue
 		}
 		
-		err := s.executor.ExecuteTransaction(tx, *tx.From)
+		// A failed tx is left out of the block, so it must not change state
+		err := revertOnError(s.state, s.executor, func(_ state.StateDB, exec *execution.Executor) error {
+			return exec.ExecuteTransaction(tx, senders[i])
+		})
 		if err != nil {
 			fmt.Printf("⚠️ Tx Failed: %v\n", err)
 			continue
@@ -138,7 +299,7 @@ func (s *Sequencer) ProduceBlock() (*core.Block, error) {
 		return nil, fmt.Errorf("all pending transactions failed execution")
 	}
 
-	// 3. Create Block
+	// 4. Create Block
 	var parentHash common.Hash
 	if s.currentBlockNumber > 1 {
 		if parent := s.blockCache[s.currentBlockNumber-1]; parent != nil {
@@ -151,33 +312,64 @@ func (s *Sequencer) ProduceBlock() (*core.Block, error) {
 	header := &core.Header{
 		ParentHash: parentHash,
 		Number:     s.currentBlockNumber,
-		Time:       uint64(time.Now().Unix()),
+		Time:       blockTime,
 		Coinbase:   s.coinbase,
 		GasUsed:    21000 * uint64(len(validTxs)),
 	}
 	
-	// 4. Update State (Commit)
+	// 5. Update State (Commit)
 	stateRoot, err := s.state.Commit(true)
 	if err != nil {
 		fmt.Printf("⚠️ State Commit Failed: %v\n", err)
 	}
 	header.Root = stateRoot
+	header.TxRoot = core.TxRoot(validTxs)
+	
+	// Seal the header so followers can check it came from us
+	if s.key != nil {
+		if err := header.Seal(s.key, s.chainID); err != nil {
+			fmt.Printf("⚠️ Failed to seal block: %v\n", err)
+		}
+	}
 	
 	block := core.NewBlock(header, validTxs)
 	
-	// 5. Store Block (Persist to DB)
+	// 6. Store Block (Persist to DB)
 	if err := s.state.SetBlock(s.currentBlockNumber, block); err != nil {
 		fmt.Printf("⚠️ Failed to persist block: %v\n", err)
 	}
 	s.blockCache[s.currentBlockNumber] = block
+	s.indexBlock(block)
 	
 	// Update block height in DB
 	s.state.SetBlockHeight(s.currentBlockNumber)
 	
-	// 6. Cleanup Mempool
+	// 7. Cleanup Mempool
 	s.mempool.Pop(len(pending))
 	
 	s.currentBlockNumber++
 	
 	return block, nil
 }
+
+// InsertBlock appends a block that was not produced here (e.g. derived from
+// L1). Its transactions must already have been executed against the state.
+func (s *Sequencer) InsertBlock(block *core.Block) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	
+	if block.Header.Number != s.currentBlockNumber {
+		return fmt.Errorf("block #%d is not next (expected #%d)", block.Header.Number, s.currentBlockNumber)
+	}
+	if err := s.state.SetBlock(block.Header.Number, block); err != nil {
+		return fmt.Errorf("failed to persist block: %v", err)
+	}
+	s.blockCache[block.Header.Number] = block
+	s.indexBlock(block)
+	s.state.SetBlockHeight(block.Header.Number)
+	s.currentBlockNumber++
+	
+	// Its txs no longer need to wait in our pool
+	s.mempool.Remove(block.Transactions)
+	return nil
+}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// SystemAddress is the sender of system transactions (L1 deposits, ...).
+// It has no private key, so no user transaction can claim it.
+var SystemAddress = common.HexToAddress("0xfffffffffffffffffffffffffffffffffffffffe")
+
+var ErrInvalidDeposit = errors.New("invalid deposit")
+
+// Deposit is an L1 -> L2 transfer observed in a LyrionBridge DepositInitiated
+// event. It credits Amount to Recipient's FLR balance on L2.
+type Deposit struct {
+	Nonce         uint64         `json:"nonce"` // Bridge deposit nonce, unique per deposit
+	Sender        common.Address `json:"sender"`
+	Recipient     common.Address `json:"recipient"`
+	Amount        *big.Int       `json:"amount"`
+	L1TxHash      common.Hash    `json:"l1TxHash"`
+	L1BlockNumber uint64         `json:"l1BlockNumber"`
+}
+
+// NewDepositTx wraps a deposit in an unsigned TxTypeDeposit system transaction.
+// The deposit is RLP-encoded in Data, so the tx hash is unique per deposit.
+func NewDepositTx(d *Deposit) (*Transaction, error) {
+	data, err := rlp.EncodeToBytes(d)
+	if err != nil {
+		return nil, err
+	}
+	from, to := SystemAddress, d.Recipient
+	return &Transaction{
+		Type:  TxTypeDeposit,
+		Nonce: d.Nonce,
+		From:  &from,
+		To:    &to,
+		Value: new(big.Int).Set(d.Amount),
+		Data:  data,
+	}, nil
+}
+
+// DecodeDeposit decodes the Data of a TxTypeDeposit transaction.
+func DecodeDeposit(data []byte) (*Deposit, error) {
+	d := new(Deposit)
+	if err := rlp.DecodeBytes(data, d); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDeposit, err)
+	}
+	return d, nil
+}
+
+// DepositProcessedKey is the storage slot (under SystemAddress) marking
+// deposit nonce as credited. It makes deposits idempotent.
+func DepositProcessedKey(nonce uint64) common.Hash {
+	return crypto.Keccak256Hash([]byte("deposit"), new(big.Int).SetUint64(nonce).Bytes())
+}
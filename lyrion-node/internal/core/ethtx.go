@@ -0,0 +1,142 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// RouterAddress is the system address that every non-transfer Lyrion
+// operation (swap, liquidity, ...) is sent to in Ethereum encoding.
+// The first calldata byte carries the operation type (Transaction.Type), the
+// rest is the operation payload (Transaction.Data). This keeps the operation
+// type covered by a standard Ethereum signature.
+var RouterAddress = common.HexToAddress("0x00000000000000000000000000000000004c5952") // "LYR"
+
+var (
+	ErrUnsupportedTxType = errors.New("unsupported transaction envelope type")
+	ErrInvalidRouterCall = errors.New("invalid router calldata")
+)
+
+// EthTx returns the go-ethereum form of the transaction. This is the exact
+// envelope that is signed and hashed, so Hash, SigningHash and Sender match
+// go-ethereum byte for byte.
+func (tx *Transaction) EthTx() *ethtypes.Transaction {
+	to, data := tx.To, tx.Data
+	if tx.Type != TxTypeTransfer {
+		router := RouterAddress
+		to = &router
+		data = append([]byte{tx.Type}, tx.Data...)
+	}
+
+	switch tx.TxType {
+	case ethtypes.AccessListTxType:
+		return ethtypes.NewTx(&ethtypes.AccessListTx{
+			ChainID:    tx.ChainID,
+			Nonce:      tx.Nonce,
+			GasPrice:   tx.GasPrice,
+			Gas:        tx.Gas,
+			To:         to,
+			Value:      tx.Value,
+			Data:       data,
+			AccessList: tx.AccessList,
+			V:          tx.V,
+			R:          tx.R,
+			S:          tx.S,
+		})
+	case ethtypes.DynamicFeeTxType:
+		return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			ChainID:    tx.ChainID,
+			Nonce:      tx.Nonce,
+			GasTipCap:  tx.GasTipCap,
+			GasFeeCap:  tx.GasFeeCap,
+			Gas:        tx.Gas,
+			To:         to,
+			Value:      tx.Value,
+			Data:       data,
+			AccessList: tx.AccessList,
+			V:          tx.V,
+			R:          tx.R,
+			S:          tx.S,
+		})
+	default:
+		return ethtypes.NewTx(&ethtypes.LegacyTx{
+			Nonce:    tx.Nonce,
+			GasPrice: tx.GasPrice,
+			Gas:      tx.Gas,
+			To:       to,
+			Value:    tx.Value,
+			Data:     data,
+			V:        tx.V,
+			R:        tx.R,
+			S:        tx.S,
+		})
+	}
+}
+
+// NewTransactionFromEth converts a go-ethereum transaction (e.g. from
+// eth_sendRawTransaction) into a Lyrion transaction, keeping its signature.
+// The sender is not recovered here; use Sender or the mempool for that.
+func NewTransactionFromEth(etx *ethtypes.Transaction) (*Transaction, error) {
+	switch etx.Type() {
+	case ethtypes.LegacyTxType, ethtypes.AccessListTxType, ethtypes.DynamicFeeTxType:
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedTxType, etx.Type())
+	}
+
+	v, r, s := etx.RawSignatureValues()
+	tx := &Transaction{
+		TxType:   etx.Type(),
+		Type:     TxTypeTransfer,
+		Nonce:    etx.Nonce(),
+		To:       etx.To(),
+		Value:    etx.Value(),
+		Gas:      etx.Gas(),
+		GasPrice: etx.GasPrice(),
+		Data:     etx.Data(),
+		V:        v,
+		R:        r,
+		S:        s,
+	}
+	if etx.Type() != ethtypes.LegacyTxType {
+		tx.ChainID = etx.ChainId()
+		tx.GasTipCap = etx.GasTipCap()
+		tx.GasFeeCap = etx.GasFeeCap()
+		tx.AccessList = etx.AccessList()
+	}
+
+	// Calls to the router carry the operation type in the first byte
+	if tx.To != nil && *tx.To == RouterAddress {
+		if len(tx.Data) == 0 || !IsRouterOp(tx.Data[0]) {
+			return nil, ErrInvalidRouterCall
+		}
+		tx.Type = tx.Data[0]
+		tx.Data = tx.Data[1:]
+	}
+	return tx, nil
+}
+
+// IsRouterOp reports whether a user tx can carry the operation type t
+// through the router. Transfers are plain Ethereum txs, deposits and price
+// updates are system txs, and other types are unknown to the executor.
+func IsRouterOp(t uint8) bool {
+	switch t {
+	case TxTypeSwap, TxTypeAddLiquidity, TxTypeIntent, TxTypeWithdrawal:
+		return true
+	}
+	return false
+}
+
+// withChainID returns tx with the chain ID filled in for typed envelopes,
+// which commit to it in their signing payload.
+func withChainID(tx *Transaction, chainID *big.Int) *Transaction {
+	if tx.TxType == ethtypes.LegacyTxType || tx.ChainID != nil {
+		return tx
+	}
+	cpy := *tx
+	cpy.ChainID = chainID
+	return &cpy
+}
@@ -0,0 +1,281 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Intent kinds
+const (
+	IntentKindSwap         = 0
+	IntentKindAddLiquidity = 1
+)
+
+// EIP-712 domain of the Lyrion DEX. The verifying contract is the router
+// system address, so intents can't be replayed against another deployment.
+const (
+	IntentDomainName    = "Lyrion DEX"
+	IntentDomainVersion = "1"
+)
+
+var (
+	ErrInvalidIntent          = errors.New("invalid intent")
+	ErrInvalidIntentSignature = errors.New("invalid intent signature")
+)
+
+// Intent is a DEX operation signed offline by its owner with EIP-712
+// (eth_signTypedData_v4). Anyone (the sequencer or a relayer) can submit it in
+// a TxTypeIntent transaction and pay the gas; the executor checks the owner's
+// signature, the deadline and the per-owner intent nonce.
+//
+// For swaps AmountOut is the minimum output accepted, for liquidity it is the
+// amount of TokenOut deposited next to AmountIn of TokenIn.
+type Intent struct {
+	Kind      uint8          `json:"kind"`
+	Owner     common.Address `json:"owner"`
+	TokenIn   string         `json:"tokenIn"`
+	TokenOut  string         `json:"tokenOut"`
+	AmountIn  *big.Int       `json:"amountIn"`
+	AmountOut *big.Int       `json:"amountOut"`
+	Deadline  uint64         `json:"deadline"` // Unix time, checked against the block timestamp
+	Nonce     uint64         `json:"nonce"`
+}
+
+// SignedIntent is the payload (Transaction.Data) of a TxTypeIntent transaction.
+type SignedIntent struct {
+	Intent    Intent
+	Signature []byte // 65 bytes [R || S || V], V may be 0/1 or 27/28
+}
+
+type intentJSON struct {
+	Kind      math.HexOrDecimal64   `json:"kind"`
+	Owner     common.Address        `json:"owner"`
+	TokenIn   string                `json:"tokenIn"`
+	TokenOut  string                `json:"tokenOut"`
+	AmountIn  *math.HexOrDecimal256 `json:"amountIn"`
+	AmountOut *math.HexOrDecimal256 `json:"amountOut"`
+	Deadline  math.HexOrDecimal64   `json:"deadline"`
+	Nonce     math.HexOrDecimal64   `json:"nonce"`
+}
+
+// MarshalJSON encodes amounts as hex strings so JavaScript clients keep full precision.
+func (i Intent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(intentJSON{
+		Kind:      math.HexOrDecimal64(i.Kind),
+		Owner:     i.Owner,
+		TokenIn:   i.TokenIn,
+		TokenOut:  i.TokenOut,
+		AmountIn:  (*math.HexOrDecimal256)(i.AmountIn),
+		AmountOut: (*math.HexOrDecimal256)(i.AmountOut),
+		Deadline:  math.HexOrDecimal64(i.Deadline),
+		Nonce:     math.HexOrDecimal64(i.Nonce),
+	})
+}
+
+// UnmarshalJSON accepts numbers as JSON numbers, decimal or hex strings.
+func (i *Intent) UnmarshalJSON(input []byte) error {
+	var dec intentJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if dec.Kind > 0xff {
+		return fmt.Errorf("%w: kind %d", ErrInvalidIntent, dec.Kind)
+	}
+	i.Kind = uint8(dec.Kind)
+	i.Owner = dec.Owner
+	i.TokenIn = dec.TokenIn
+	i.TokenOut = dec.TokenOut
+	i.AmountIn = (*big.Int)(dec.AmountIn)
+	i.AmountOut = (*big.Int)(dec.AmountOut)
+	i.Deadline = uint64(dec.Deadline)
+	i.Nonce = uint64(dec.Nonce)
+	return nil
+}
+
+// Validate checks the intent is well formed (not that it can be executed).
+func (i *Intent) Validate() error {
+	if i.Kind != IntentKindSwap && i.Kind != IntentKindAddLiquidity {
+		return fmt.Errorf("%w: unknown kind %d", ErrInvalidIntent, i.Kind)
+	}
+	if i.AmountIn == nil || i.AmountIn.Sign() <= 0 {
+		return fmt.Errorf("%w: amountIn must be positive", ErrInvalidIntent)
+	}
+	if i.AmountOut == nil || i.AmountOut.Sign() < 0 {
+		return fmt.Errorf("%w: amountOut must not be negative", ErrInvalidIntent)
+	}
+	if i.TokenIn == i.TokenOut {
+		return fmt.Errorf("%w: tokenIn and tokenOut must differ", ErrInvalidIntent)
+	}
+	return nil
+}
+
+// TypedData returns the EIP-712 typed data wallets sign for this intent.
+func (i *Intent) TypedData(chainID *big.Int) apitypes.TypedData {
+	domain := apitypes.TypedDataDomain{
+		Name:              IntentDomainName,
+		Version:           IntentDomainVersion,
+		ChainId:           (*math.HexOrDecimal256)(new(big.Int).Set(chainID)),
+		VerifyingContract: RouterAddress.Hex(),
+	}
+	types := apitypes.Types{
+		"EIP712Domain": {
+			{Name: "name", Type: "string"},
+			{Name: "version", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+			{Name: "verifyingContract", Type: "address"},
+		},
+	}
+
+	amount := func(x *big.Int) *math.HexOrDecimal256 {
+		if x == nil {
+			x = new(big.Int)
+		}
+		return (*math.HexOrDecimal256)(new(big.Int).Set(x))
+	}
+	deadline := (*math.HexOrDecimal256)(new(big.Int).SetUint64(i.Deadline))
+	nonce := (*math.HexOrDecimal256)(new(big.Int).SetUint64(i.Nonce))
+
+	var primaryType string
+	var message apitypes.TypedDataMessage
+	if i.Kind == IntentKindAddLiquidity {
+		primaryType = "AddLiquidity"
+		types[primaryType] = []apitypes.Type{
+			{Name: "owner", Type: "address"},
+			{Name: "tokenA", Type: "string"},
+			{Name: "tokenB", Type: "string"},
+			{Name: "amountA", Type: "uint256"},
+			{Name: "amountB", Type: "uint256"},
+			{Name: "deadline", Type: "uint256"},
+			{Name: "nonce", Type: "uint256"},
+		}
+		message = apitypes.TypedDataMessage{
+			"owner":    i.Owner.Hex(),
+			"tokenA":   i.TokenIn,
+			"tokenB":   i.TokenOut,
+			"amountA":  amount(i.AmountIn),
+			"amountB":  amount(i.AmountOut),
+			"deadline": deadline,
+			"nonce":    nonce,
+		}
+	} else {
+		primaryType = "Swap"
+		types[primaryType] = []apitypes.Type{
+			{Name: "owner", Type: "address"},
+			{Name: "tokenIn", Type: "string"},
+			{Name: "tokenOut", Type: "string"},
+			{Name: "amountIn", Type: "uint256"},
+			{Name: "minAmountOut", Type: "uint256"},
+			{Name: "deadline", Type: "uint256"},
+			{Name: "nonce", Type: "uint256"},
+		}
+		message = apitypes.TypedDataMessage{
+			"owner":        i.Owner.Hex(),
+			"tokenIn":      i.TokenIn,
+			"tokenOut":     i.TokenOut,
+			"amountIn":     amount(i.AmountIn),
+			"minAmountOut": amount(i.AmountOut),
+			"deadline":     deadline,
+			"nonce":        nonce,
+		}
+	}
+
+	return apitypes.TypedData{
+		Types:       types,
+		PrimaryType: primaryType,
+		Domain:      domain,
+		Message:     message,
+	}
+}
+
+// SigningHash returns the EIP-712 digest keccak256("\x19\x01" || domainSeparator || hashStruct(intent)).
+func (i *Intent) SigningHash(chainID *big.Int) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(i.TypedData(chainID))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("%w: %v", ErrInvalidIntent, err)
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// SignIntent signs the intent with the owner's key, like eth_signTypedData_v4 would.
+func SignIntent(intent *Intent, chainID *big.Int, prv *ecdsa.PrivateKey) (*SignedIntent, error) {
+	hash, err := intent.SigningHash(chainID)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(hash[:], prv)
+	if err != nil {
+		return nil, err
+	}
+	sig[64] += 27
+	return &SignedIntent{Intent: *intent, Signature: sig}, nil
+}
+
+// Signer recovers the address that signed the intent.
+// Malleable (high-s) signatures are rejected.
+func (si *SignedIntent) Signer(chainID *big.Int) (common.Address, error) {
+	if len(si.Signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("%w: bad length %d", ErrInvalidIntentSignature, len(si.Signature))
+	}
+	sig := common.CopyBytes(si.Signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64])
+	if !crypto.ValidateSignatureValues(sig[64], r, s, true) {
+		return common.Address{}, ErrInvalidIntentSignature
+	}
+
+	hash, err := si.Intent.SigningHash(chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	pub, err := crypto.SigToPub(hash[:], sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrInvalidIntentSignature, err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// Verify checks the intent is well formed and signed by its owner.
+func (si *SignedIntent) Verify(chainID *big.Int) error {
+	if err := si.Intent.Validate(); err != nil {
+		return err
+	}
+	signer, err := si.Signer(chainID)
+	if err != nil {
+		return err
+	}
+	if signer != si.Intent.Owner {
+		return fmt.Errorf("%w: signed by %s, owner is %s", ErrInvalidIntentSignature, signer.Hex(), si.Intent.Owner.Hex())
+	}
+	return nil
+}
+
+// EncodeSignedIntent RLP-encodes a signed intent for Transaction.Data.
+func EncodeSignedIntent(si *SignedIntent) ([]byte, error) {
+	return rlp.EncodeToBytes(si)
+}
+
+// DecodeSignedIntent decodes the Data of a TxTypeIntent transaction.
+func DecodeSignedIntent(data []byte) (*SignedIntent, error) {
+	si := new(SignedIntent)
+	if err := rlp.DecodeBytes(data, si); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIntent, err)
+	}
+	return si, nil
+}
+
+// IntentNonceKey is the storage slot (under RouterAddress) holding the next
+// intent nonce of owner. Each nonce can be used once, in order.
+func IntentNonceKey(owner common.Address) common.Hash {
+	return crypto.Keccak256Hash([]byte("intent-nonce"), owner.Bytes())
+}
@@ -0,0 +1,154 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// walletTypedData is what a wallet receives in eth_signTypedData_v4 for an
+// intent, written out by hand.
+const walletTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Swap": [
+			{"name": "owner", "type": "address"},
+			{"name": "tokenIn", "type": "string"},
+			{"name": "tokenOut", "type": "string"},
+			{"name": "amountIn", "type": "uint256"},
+			{"name": "minAmountOut", "type": "uint256"},
+			{"name": "deadline", "type": "uint256"},
+			{"name": "nonce", "type": "uint256"}
+		],
+		"AddLiquidity": [
+			{"name": "owner", "type": "address"},
+			{"name": "tokenA", "type": "string"},
+			{"name": "tokenB", "type": "string"},
+			{"name": "amountA", "type": "uint256"},
+			{"name": "amountB", "type": "uint256"},
+			{"name": "deadline", "type": "uint256"},
+			{"name": "nonce", "type": "uint256"}
+		]
+	},
+	"primaryType": %q,
+	"domain": {"name": "Lyrion DEX", "version": "1", "chainId": "42069", "verifyingContract": %q},
+	"message": %s
+}`
+
+// eip712Digest computes the digest of an intent from the EIP-712 rules
+// directly: keccak256("\x19\x01" || domainSeparator || hashStruct(message)).
+func eip712Digest(i *Intent, chainID *big.Int) common.Hash {
+	word := func(x *big.Int) []byte { return common.LeftPadBytes(x.Bytes(), 32) }
+	str := func(s string) []byte { return crypto.Keccak256([]byte(s)) }
+
+	domain := crypto.Keccak256(
+		str("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"),
+		str("Lyrion DEX"), str("1"), word(chainID), common.LeftPadBytes(RouterAddress.Bytes(), 32),
+	)
+	typeString := "Swap(address owner,string tokenIn,string tokenOut,uint256 amountIn,uint256 minAmountOut,uint256 deadline,uint256 nonce)"
+	if i.Kind == IntentKindAddLiquidity {
+		typeString = "AddLiquidity(address owner,string tokenA,string tokenB,uint256 amountA,uint256 amountB,uint256 deadline,uint256 nonce)"
+	}
+	message := crypto.Keccak256(
+		str(typeString), common.LeftPadBytes(i.Owner.Bytes(), 32), str(i.TokenIn), str(i.TokenOut),
+		word(i.AmountIn), word(i.AmountOut), word(new(big.Int).SetUint64(i.Deadline)), word(new(big.Int).SetUint64(i.Nonce)),
+	)
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domain, message)
+}
+
+func TestIntentSigningHash(t *testing.T) {
+	owner := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	for _, i := range []*Intent{
+		{Kind: IntentKindSwap, Owner: owner, TokenIn: "LYR", TokenOut: "FLR", AmountIn: big.NewInt(1e18), AmountOut: big.NewInt(19e17), Deadline: 1700000000, Nonce: 3},
+		{Kind: IntentKindAddLiquidity, Owner: owner, TokenIn: "FLR", TokenOut: "LYR", AmountIn: big.NewInt(2e18), AmountOut: big.NewInt(1e18), Deadline: 1700000000, Nonce: 0},
+	} {
+		hash, err := i.SigningHash(testChainID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := eip712Digest(i, testChainID); hash != want {
+			t.Fatalf("kind %d: digest %s, EIP-712 gives %s", i.Kind, hash.Hex(), want.Hex())
+		}
+
+		// The same intent as a wallet gets it, hashed by go-ethereum
+		primary, message := "Swap", fmt.Sprintf(`{"owner": %q, "tokenIn": %q, "tokenOut": %q, "amountIn": "%s", "minAmountOut": "%s", "deadline": "%d", "nonce": "%d"}`,
+			i.Owner.Hex(), i.TokenIn, i.TokenOut, i.AmountIn, i.AmountOut, i.Deadline, i.Nonce)
+		if i.Kind == IntentKindAddLiquidity {
+			primary, message = "AddLiquidity", fmt.Sprintf(`{"owner": %q, "tokenA": %q, "tokenB": %q, "amountA": "%s", "amountB": "%s", "deadline": "%d", "nonce": "%d"}`,
+				i.Owner.Hex(), i.TokenIn, i.TokenOut, i.AmountIn, i.AmountOut, i.Deadline, i.Nonce)
+		}
+		var typed apitypes.TypedData
+		if err := json.Unmarshal([]byte(fmt.Sprintf(walletTypedData, primary, RouterAddress.Hex(), message)), &typed); err != nil {
+			t.Fatal(err)
+		}
+		wallet, _, err := apitypes.TypedDataAndHash(typed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if common.BytesToHash(wallet) != hash {
+			t.Fatalf("kind %d: digest %s, wallet typed data gives %x", i.Kind, hash.Hex(), wallet)
+		}
+
+		// Bound to the chain
+		if other, _ := i.SigningHash(big.NewInt(1)); other == hash {
+			t.Fatalf("kind %d: same digest on another chain", i.Kind)
+		}
+	}
+}
+
+func TestSignedIntentVerify(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	intent := &Intent{Kind: IntentKindSwap, Owner: crypto.PubkeyToAddress(key.PublicKey), TokenIn: "LYR", TokenOut: "FLR", AmountIn: big.NewInt(5), AmountOut: big.NewInt(0), Deadline: 100}
+
+	signed, err := SignIntent(intent, testChainID, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := signed.Verify(testChainID); err != nil {
+		t.Fatal(err)
+	}
+	// Both V conventions recover the owner
+	signed.Signature[64] -= 27
+	if err := signed.Verify(testChainID); err != nil {
+		t.Fatalf("signature with V 0/1: %v", err)
+	}
+
+	if err := signed.Verify(big.NewInt(1)); !errors.Is(err, ErrInvalidIntentSignature) {
+		t.Fatalf("intent verified on another chain: %v", err)
+	}
+	forged, _ := SignIntent(intent, testChainID, other)
+	if err := forged.Verify(testChainID); !errors.Is(err, ErrInvalidIntentSignature) {
+		t.Fatalf("intent signed by another key: %v", err)
+	}
+	changed := *signed
+	changed.Intent.AmountOut = big.NewInt(-1)
+	if err := changed.Verify(testChainID); !errors.Is(err, ErrInvalidIntent) {
+		t.Fatalf("malformed intent: %v", err)
+	}
+	changed.Intent.AmountOut = big.NewInt(1)
+	if err := changed.Verify(testChainID); !errors.Is(err, ErrInvalidIntentSignature) {
+		t.Fatalf("intent changed after signing: %v", err)
+	}
+
+	// High-s signatures are malleable copies and are refused
+	highS := common.CopyBytes(signed.Signature)
+	s := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(highS[32:64]))
+	copy(highS[32:64], common.LeftPadBytes(s.Bytes(), 32))
+	highS[64] ^= 1
+	malleable := &SignedIntent{Intent: signed.Intent, Signature: highS}
+	if err := malleable.Verify(testChainID); !errors.Is(err, ErrInvalidIntentSignature) {
+		t.Fatalf("high-s signature: %v", err)
+	}
+}
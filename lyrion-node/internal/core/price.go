@@ -0,0 +1,127 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// PriceDecimals is the fixed-point precision of L2 prices: a price of
+// 10^18 is 1 USD.
+const PriceDecimals = 18
+
+// maxPriceSymbol bounds feed symbols, which end up in state keys
+const maxPriceSymbol = 16
+
+var ErrInvalidPriceUpdate = errors.New("invalid price update")
+
+// PriceFeed is the USD price of one asset.
+type PriceFeed struct {
+	Symbol string   `json:"symbol"` // Asset symbol, e.g. "FLR" for the FTSO feed "FLR/USD"
+	Price  *big.Int `json:"price"`  // USD price with PriceDecimals decimals
+}
+
+// PriceUpdate is a set of FTSO prices read from L1. The sequencer posts it
+// to L2 state in a TxTypePriceUpdate system transaction; anyone can check it
+// by reading the FTSO at L1BlockNumber.
+type PriceUpdate struct {
+	Timestamp     uint64       `json:"timestamp"`     // FTSO voting round timestamp
+	L1BlockNumber uint64       `json:"l1BlockNumber"` // L1 block the prices were read at
+	Prices        []*PriceFeed `json:"prices"`
+}
+
+// NewPriceUpdateTx wraps a price update in an unsigned TxTypePriceUpdate
+// system transaction. The update is RLP-encoded in Data.
+func NewPriceUpdateTx(u *PriceUpdate) (*Transaction, error) {
+	data, err := rlp.EncodeToBytes(u)
+	if err != nil {
+		return nil, err
+	}
+	from := SystemAddress
+	return &Transaction{
+		Type:  TxTypePriceUpdate,
+		Nonce: u.Timestamp,
+		From:  &from,
+		To:    &from,
+		Value: new(big.Int),
+		Data:  data,
+	}, nil
+}
+
+// DecodePriceUpdate decodes and validates the Data of a TxTypePriceUpdate
+// transaction.
+func DecodePriceUpdate(data []byte) (*PriceUpdate, error) {
+	u := new(PriceUpdate)
+	if err := rlp.DecodeBytes(data, u); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPriceUpdate, err)
+	}
+	if len(u.Prices) == 0 {
+		return nil, fmt.Errorf("%w: no prices", ErrInvalidPriceUpdate)
+	}
+	for _, p := range u.Prices {
+		if p == nil || !ValidPriceSymbol(p.Symbol) {
+			return nil, fmt.Errorf("%w: bad symbol", ErrInvalidPriceUpdate)
+		}
+		if p.Price == nil || p.Price.Sign() <= 0 || p.Price.BitLen() > 256 {
+			return nil, fmt.Errorf("%w: %s has no price", ErrInvalidPriceUpdate, p.Symbol)
+		}
+	}
+	return u, nil
+}
+
+// ValidPriceSymbol reports whether symbol can name a price feed: 1 to 16
+// upper case letters or digits.
+func ValidPriceSymbol(symbol string) bool {
+	if len(symbol) == 0 || len(symbol) > maxPriceSymbol {
+		return false
+	}
+	for _, c := range symbol {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// FtsoFeedID returns the FTSOv2 feed ID of symbol's USD price: the crypto
+// category byte 0x01 followed by "SYMBOL/USD", zero-padded to 21 bytes.
+func FtsoFeedID(symbol string) [21]byte {
+	var id [21]byte
+	id[0] = 0x01
+	copy(id[1:], strings.ToUpper(symbol)+"/USD")
+	return id
+}
+
+// PriceKey is the storage slot (under SystemAddress) holding symbol's USD
+// price.
+func PriceKey(symbol string) common.Hash {
+	return crypto.Keccak256Hash([]byte("price"), []byte(symbol))
+}
+
+// PriceTimeKey is the storage slot (under SystemAddress) holding the FTSO
+// timestamp of symbol's price.
+func PriceTimeKey(symbol string) common.Hash {
+	return crypto.Keccak256Hash([]byte("price-time"), []byte(symbol))
+}
+
+// PoolPriceWindow is the averaging window, in seconds, of the pool price
+// kept in state (see PoolPriceKey).
+const PoolPriceWindow = 30 * 60
+
+// PoolPriceKey is the storage slot (under SystemAddress) holding the
+// time-weighted average price of pair: reserve1 per reserve0, with
+// PriceDecimals decimals.
+func PoolPriceKey(pair string) common.Hash {
+	return crypto.Keccak256Hash([]byte("pool-price"), []byte(pair))
+}
+
+// PoolPriceTimeKey is the storage slot (under SystemAddress) holding the
+// block time of the last update of pair's average price.
+func PoolPriceTimeKey(pair string) common.Hash {
+	return crypto.Keccak256Hash([]byte("pool-price-time"), []byte(pair))
+}
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"math/big"
+	"runtime"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// minParallelRecover is the batch size below which RecoverSenders stays
+// serial; for a handful of txs the goroutine overhead outweighs the gain.
+const minParallelRecover = 16
+
+// senderCache memoizes the recovered sender of a transaction for one chain
+// ID. It is keyed by the tx hash too: struct copies (signing, withChainID)
+// carry the cache along, and a copy with other fields or another signature
+// must not inherit it.
+type senderCache struct {
+	chainID *big.Int
+	hash    common.Hash
+	from    common.Address
+}
+
+// cachedSender returns the cached sender of the tx with the given hash for
+// chainID, if any.
+func (tx *Transaction) cachedSender(chainID *big.Int, hash common.Hash) (common.Address, bool) {
+	sc, _ := tx.sender.Load().(*senderCache)
+	if sc == nil || sc.hash != hash || sc.chainID.Cmp(chainID) != 0 {
+		return common.Address{}, false
+	}
+	return sc.from, true
+}
+
+// RecoverSenders recovers the senders of txs, spreading the ECDSA work over
+// GOMAXPROCS workers. Senders and errors are returned in tx order (errs[i] is
+// nil when txs[i] is valid), and each recovered sender is cached on its
+// transaction so later Sender calls are free.
+func RecoverSenders(txs []*Transaction, chainID *big.Int) ([]common.Address, []error) {
+	signer := NewEIP155Signer(chainID)
+	senders := make([]common.Address, len(txs))
+	errs := make([]error, len(txs))
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	if len(txs) < minParallelRecover || workers <= 1 {
+		for i, tx := range txs {
+			senders[i], errs[i] = signer.Sender(tx)
+		}
+		return senders, errs
+	}
+
+	// Workers pull indexes from a shared channel, so a few slow txs
+	// don't leave the other workers idle
+	var wg sync.WaitGroup
+	next := make(chan int, len(txs))
+	for i := range txs {
+		next <- i
+	}
+	close(next)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				senders[i], errs[i] = signer.Sender(txs[i])
+			}
+		}()
+	}
+	wg.Wait()
+	return senders, errs
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// signedEthTxs returns n EIP-1559 txs signed by different keys.
+func signedEthTxs(tb testing.TB, n int) []*ethtypes.Transaction {
+	signer := ethtypes.LatestSignerForChainID(testChainID)
+	to := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	etxs := make([]*ethtypes.Transaction, n)
+	for i := range etxs {
+		key, _ := crypto.GenerateKey()
+		etx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+			ChainID: testChainID, Nonce: uint64(i), GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 21000, To: &to, Value: big.NewInt(1),
+		})
+		if err != nil {
+			tb.Fatal(err)
+		}
+		etxs[i] = etx
+	}
+	return etxs
+}
+
+// freshTxs converts etxs into txs without a cached sender.
+func freshTxs(tb testing.TB, etxs []*ethtypes.Transaction) []*Transaction {
+	txs := make([]*Transaction, len(etxs))
+	for i, etx := range etxs {
+		tx, err := NewTransactionFromEth(etx)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		txs[i] = tx
+	}
+	return txs
+}
+
+func TestRecoverSenders(t *testing.T) {
+	etxs := signedEthTxs(t, 3*minParallelRecover)
+	txs := freshTxs(t, etxs)
+	txs[5].V = new(big.Int).Add(txs[5].V, big.NewInt(9)) // Invalid recovery ID
+
+	senders, errs := RecoverSenders(txs, testChainID)
+	for i, etx := range etxs {
+		if i == 5 {
+			if errs[i] == nil {
+				t.Errorf("tx %d: broken signature accepted", i)
+			}
+			continue
+		}
+		want, _ := ethtypes.Sender(ethtypes.LatestSignerForChainID(testChainID), etx)
+		if errs[i] != nil || senders[i] != want {
+			t.Errorf("tx %d: got %s, %v, want %s", i, senders[i].Hex(), errs[i], want.Hex())
+		}
+	}
+}
+
+// A copy of a tx with another signature or chain ID must not reuse the
+// sender cached on the original.
+func TestSenderCacheFollowsCopies(t *testing.T) {
+	txs := freshTxs(t, signedEthTxs(t, 2))
+	sender, err := RecoverSender(txs[0], testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := RecoverSender(txs[1], testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resigned := *txs[0]
+	resigned.Nonce, resigned.V, resigned.R, resigned.S = txs[1].Nonce, txs[1].V, txs[1].R, txs[1].S
+	if got, err := RecoverSender(&resigned, testChainID); err != nil || got != other {
+		t.Fatalf("copy with another signature: got %s, %v, want %s", got.Hex(), err, other.Hex())
+	}
+
+	otherChain := *txs[0]
+	otherChain.ChainID = big.NewInt(1)
+	if got, err := RecoverSender(&otherChain, big.NewInt(1)); err == nil && got == sender {
+		t.Fatal("copy for another chain reused the cached sender")
+	}
+}
+
+// BenchmarkRecoverSenders compares RecoverSenders with recovering the same
+// txs one by one.
+func BenchmarkRecoverSenders(b *testing.B) {
+	etxs := signedEthTxs(b, 512)
+	signer := NewEIP155Signer(testChainID)
+
+	b.Run("serial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			txs := freshTxs(b, etxs)
+			b.StartTimer()
+			for _, tx := range txs {
+				if _, err := signer.Sender(tx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			txs := freshTxs(b, etxs)
+			b.StartTimer()
+			if _, errs := RecoverSenders(txs, testChainID); errs[0] != nil {
+				b.Fatal(errs[0])
+			}
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var ErrInvalidSeal = errors.New("invalid block seal")
+
+// SealHash is the hash the sequencer signs: every header field but the
+// signature, bound to the chain ID so a seal can't be replayed on another
+// network.
+func (h *Header) SealHash(chainID *big.Int) common.Hash {
+	data, _ := rlp.EncodeToBytes([]interface{}{
+		chainID,
+		h.ParentHash,
+		h.Root,
+		h.TxRoot,
+		h.ReceiptRoot,
+		h.Number,
+		h.Time,
+		h.Coinbase,
+		h.Extra,
+		h.GasUsed,
+		h.GasLimit,
+	})
+	return crypto.Keccak256Hash(data)
+}
+
+// Seal signs the header with the sequencer key and records the signature.
+func (h *Header) Seal(key *ecdsa.PrivateKey, chainID *big.Int) error {
+	hash := h.SealHash(chainID)
+	sig, err := crypto.Sign(hash[:], key)
+	if err != nil {
+		return err
+	}
+	h.Signature = sig
+	return nil
+}
+
+// SealSigner recovers the address that sealed the header.
+func (h *Header) SealSigner(chainID *big.Int) (common.Address, error) {
+	if len(h.Signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("%w: missing signature", ErrInvalidSeal)
+	}
+	hash := h.SealHash(chainID)
+	pub, err := crypto.SigToPub(hash[:], h.Signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrInvalidSeal, err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// TxRoot commits to a block's transactions in order: keccak256 over their
+// hashes.
+func TxRoot(txs []*Transaction) common.Hash {
+	hasher := crypto.NewKeccakState()
+	for _, tx := range txs {
+		hash := tx.Hash()
+		hasher.Write(hash[:])
+	}
+	var root common.Hash
+	hasher.Read(root[:])
+	return root
+}
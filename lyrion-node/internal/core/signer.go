@@ -5,22 +5,28 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/rlp"
 )
 
 var (
 	ErrInvalidSignature    = errors.New("invalid transaction signature")
 	ErrInvalidChainID      = errors.New("invalid chain id for signer")
 	ErrTransactionNotSigned = errors.New("transaction not signed")
+	ErrUnprotectedTx       = errors.New("unprotected (pre-EIP-155) transaction")
 )
 
-// Signer encapsulates transaction signature handling
+// Signer encapsulates transaction signature handling.
+// It delegates to go-ethereum's latest signer for the chain, so it accepts
+// EIP-155 legacy, EIP-2930 and EIP-1559 transactions and produces signatures
+// go-ethereum accepts. Pre-EIP-155 legacy txs commit to no chain ID and could
+// be replayed from any other chain, so they are rejected.
 type Signer struct {
-	chainID    *big.Int
-	chainIDMul *big.Int // chainID * 2
+	chainID *big.Int
+	signer  ethtypes.Signer
 }
 
 // NewEIP155Signer returns a signer for the given chain ID
@@ -29,23 +35,20 @@ func NewEIP155Signer(chainID *big.Int) *Signer {
 		chainID = big.NewInt(1)
 	}
 	return &Signer{
-		chainID:    chainID,
-		chainIDMul: new(big.Int).Mul(chainID, big.NewInt(2)),
+		chainID: chainID,
+		signer:  ethtypes.LatestSignerForChainID(chainID),
 	}
 }
 
-// SigningHash returns the hash to be signed for a transaction
+// ChainID returns the chain ID the signer is bound to
+func (s *Signer) ChainID() *big.Int {
+	return s.chainID
+}
+
+// SigningHash returns the hash to be signed for a transaction.
+// For legacy txs this is keccak256(rlp([nonce, gasPrice, gas, to, value, data, chainID, 0, 0])).
 func (s *Signer) SigningHash(tx *Transaction) common.Hash {
-	return rlpHash([]interface{}{
-		tx.Type,
-		tx.Nonce,
-		tx.GasPrice,
-		tx.Gas,
-		tx.To,
-		tx.Value,
-		tx.Data,
-		s.chainID, uint(0), uint(0), // EIP-155: chainID, 0, 0
-	})
+	return s.signer.Hash(withChainID(tx, s.chainID).EthTx())
 }
 
 // Sign signs a transaction with a private key
@@ -58,88 +61,46 @@ func (s *Signer) Sign(tx *Transaction, prv *ecdsa.PrivateKey) (*Transaction, err
 	return s.WithSignature(tx, sig)
 }
 
-// WithSignature creates a new signed transaction from an existing tx and signature
+// WithSignature creates a new signed transaction from an existing tx and a
+// 65-byte [R || S || V] signature with V in {0, 1}
 func (s *Signer) WithSignature(tx *Transaction, sig []byte) (*Transaction, error) {
-	if len(sig) != 65 {
+	if len(sig) != crypto.SignatureLength {
 		return nil, ErrInvalidSignature
 	}
-
-	r := new(big.Int).SetBytes(sig[:32])
-	sVal := new(big.Int).SetBytes(sig[32:64])
-	v := new(big.Int).SetBytes([]byte{sig[64] + 27})
-
-	// EIP-155: v = chainId * 2 + 35 + recovery_id
-	v.Add(v, s.chainIDMul)
-	v.Add(v, big.NewInt(8)) // 35 - 27 = 8
-
-	signedTx := &Transaction{
-		Type:     tx.Type,
-		Nonce:    tx.Nonce,
-		GasPrice: tx.GasPrice,
-		Gas:      tx.Gas,
-		To:       tx.To,
-		Value:    tx.Value,
-		Data:     tx.Data,
-		V:        v,
-		R:        r,
-		S:        sVal,
-		From:     tx.From,
+	tx = withChainID(tx, s.chainID)
+	r, sVal, v, err := s.signer.SignatureValues(tx.EthTx(), sig)
+	if err != nil {
+		return nil, err
 	}
 
-	return signedTx, nil
+	signedTx := *tx
+	signedTx.V, signedTx.R, signedTx.S = v, r, sVal
+	signedTx.sender = atomic.Value{}
+	return &signedTx, nil
 }
 
-// Sender derives the sender address from the signature
+// Sender derives the sender address from the signature.
+// The result is cached on the transaction.
 func (s *Signer) Sender(tx *Transaction) (common.Address, error) {
-	// If From is already set and no signature, return From
-	if tx.From != nil && tx.R == nil {
-		return *tx.From, nil
-	}
-
 	if tx.V == nil || tx.R == nil || tx.S == nil {
 		return common.Address{}, ErrTransactionNotSigned
 	}
-
-	// Derive recovery ID
-	V := tx.V.Uint64()
-	
-	// Handle both legacy (V = 27 or 28) and EIP-155 (V = chainId * 2 + 35 or 36)
-	var recoveryID byte
-	if V >= 35 {
-		// EIP-155
-		chainIDCheck := new(big.Int).Sub(tx.V, big.NewInt(35))
-		chainIDCheck.Div(chainIDCheck, big.NewInt(2))
-		if chainIDCheck.Cmp(s.chainID) != 0 {
-			return common.Address{}, ErrInvalidChainID
-		}
-		recoveryID = byte(V - 35 - s.chainIDMul.Uint64())
-	} else if V == 27 || V == 28 {
-		// Legacy
-		recoveryID = byte(V - 27)
-	} else {
-		return common.Address{}, ErrInvalidSignature
+	etx := tx.EthTx()
+	hash := etx.Hash()
+	if addr, ok := tx.cachedSender(s.chainID, hash); ok {
+		return addr, nil
 	}
-
-	// Compute the signing hash
-	hash := s.SigningHash(tx)
-
-	// Construct signature bytes
-	sig := make([]byte, 65)
-	copy(sig[0:32], tx.R.Bytes())
-	copy(sig[32:64], tx.S.Bytes())
-	sig[64] = recoveryID
-
-	// Recover public key
-	pub, err := crypto.Ecrecover(hash[:], sig)
-	if err != nil {
-		return common.Address{}, err
+	if !etx.Protected() {
+		return common.Address{}, ErrUnprotectedTx
 	}
-	if len(pub) == 0 || pub[0] != 4 {
-		return common.Address{}, ErrInvalidSignature
+	addr, err := ethtypes.Sender(s.signer, etx)
+	if err != nil {
+		if errors.Is(err, ethtypes.ErrInvalidChainId) {
+			return common.Address{}, ErrInvalidChainID
+		}
+		return common.Address{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
 	}
-
-	// Convert to address
-	addr := common.BytesToAddress(crypto.Keccak256(pub[1:])[12:])
+	tx.sender.Store(&senderCache{chainID: s.chainID, hash: hash, from: addr})
 	return addr, nil
 }
 
@@ -157,16 +118,6 @@ func (s *Signer) VerifySignature(tx *Transaction) (bool, error) {
 	return recoveredAddr == *tx.From, nil
 }
 
-// rlpHash computes the RLP-encoded Keccak256 hash
-func rlpHash(x interface{}) common.Hash {
-	data, err := rlp.EncodeToBytes(x)
-	if err != nil {
-		// Fallback to a simple hash if RLP fails
-		return common.Hash{}
-	}
-	return common.BytesToHash(crypto.Keccak256(data))
-}
-
 // SignTx is a convenience function to sign a transaction
 func SignTx(tx *Transaction, chainID *big.Int, prv *ecdsa.PrivateKey) (*Transaction, error) {
 	signer := NewEIP155Signer(chainID)
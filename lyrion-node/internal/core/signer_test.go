@@ -0,0 +1,142 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var testChainID = big.NewInt(42069)
+
+// ethTxs returns one unsigned tx of every supported envelope.
+func ethTxs(chainID *big.Int) map[string]ethtypes.TxData {
+	to := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	return map[string]ethtypes.TxData{
+		"legacy": &ethtypes.LegacyTx{
+			Nonce: 3, GasPrice: big.NewInt(1e9), Gas: 21000, To: &to, Value: big.NewInt(1e18),
+		},
+		"accessList": &ethtypes.AccessListTx{
+			ChainID: chainID, Nonce: 4, GasPrice: big.NewInt(1e9), Gas: 30000, To: &to, Value: big.NewInt(5),
+			AccessList: ethtypes.AccessList{{Address: to, StorageKeys: []common.Hash{{1}}}},
+		},
+		"dynamicFee": &ethtypes.DynamicFeeTx{
+			ChainID: chainID, Nonce: 5, GasTipCap: big.NewInt(1e9), GasFeeCap: big.NewInt(2e9), Gas: 21000, To: &to, Value: big.NewInt(7),
+		},
+	}
+}
+
+// Txs signed by go-ethereum recover to the same sender and hash here.
+func TestSenderMatchesGoEthereum(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	for name, data := range ethTxs(testChainID) {
+		etx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(testChainID), data)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		tx, err := NewTransactionFromEth(etx)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if tx.Hash() != etx.Hash() {
+			t.Errorf("%s: hash %s, go-ethereum %s", name, tx.Hash().Hex(), etx.Hash().Hex())
+		}
+		sender, err := RecoverSender(tx, testChainID)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if sender != from {
+			t.Errorf("%s: sender %s, want %s", name, sender.Hex(), from.Hex())
+		}
+	}
+}
+
+// Txs signed here are accepted by go-ethereum.
+func TestSignAcceptedByGoEthereum(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	for name, data := range ethTxs(testChainID) {
+		tx, err := NewTransactionFromEth(ethtypes.NewTx(data))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		signed, err := SignTx(tx, testChainID, key)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		etx := signed.EthTx()
+		if !etx.Protected() {
+			t.Errorf("%s: signature not replay protected", name)
+		}
+		sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(testChainID), etx)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if sender != from {
+			t.Errorf("%s: go-ethereum recovered %s, want %s", name, sender.Hex(), from.Hex())
+		}
+		if signed.Hash() != etx.Hash() {
+			t.Errorf("%s: hash mismatch", name)
+		}
+	}
+}
+
+func TestSenderRejectsOtherChains(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	for name, data := range ethTxs(big.NewInt(1)) {
+		etx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(big.NewInt(1)), data)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		tx, err := NewTransactionFromEth(etx)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if _, err := RecoverSender(tx, testChainID); !errors.Is(err, ErrInvalidChainID) {
+			t.Errorf("%s: got %v, want %v", name, err, ErrInvalidChainID)
+		}
+	}
+}
+
+// Pre-EIP-155 txs commit to no chain and could be replayed from any.
+func TestSenderRejectsUnprotected(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	etx, err := ethtypes.SignNewTx(key, ethtypes.HomesteadSigner{}, ethTxs(nil)["legacy"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := NewTransactionFromEth(etx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RecoverSender(tx, testChainID); !errors.Is(err, ErrUnprotectedTx) {
+		t.Fatalf("got %v, want %v", err, ErrUnprotectedTx)
+	}
+}
+
+func TestRouterCallTypes(t *testing.T) {
+	router := RouterAddress
+	for op := 0; op < 256; op++ {
+		etx := ethtypes.NewTx(&ethtypes.LegacyTx{Gas: 21000, GasPrice: big.NewInt(1), To: &router, Value: new(big.Int), Data: []byte{byte(op), 1, 2}})
+		tx, err := NewTransactionFromEth(etx)
+		if !IsRouterOp(uint8(op)) {
+			if !errors.Is(err, ErrInvalidRouterCall) {
+				t.Errorf("type %d: got %v, want %v", op, err, ErrInvalidRouterCall)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("type %d: %v", op, err)
+		}
+		if tx.Type != uint8(op) || len(tx.Data) != 2 {
+			t.Errorf("type %d: decoded type %d data %x", op, tx.Type, tx.Data)
+		}
+		if tx.EthTx().Hash() != etx.Hash() {
+			t.Errorf("type %d: envelope not preserved", op)
+		}
+	}
+}
@@ -1,11 +1,13 @@
 package core
 
 import (
-	"fmt"
 	"math/big"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 // Block represents a complete block in the LYRION chain.
@@ -26,6 +28,7 @@ type Header struct {
 	Extra       []byte         `json:"extraData"`
 	GasUsed     uint64         `json:"gasUsed"`
 	GasLimit    uint64         `json:"gasLimit"`
+	Signature   []byte         `json:"signature,omitempty"` // Sequencer seal over SealHash (see Seal)
 }
 
 // Transaction Types
@@ -34,6 +37,10 @@ const (
 	TxTypeSwap         = 1 // Swap Token A -> Token B
 	TxTypeAddLiquidity = 2 // Add Liquidity to Pool
 	TxTypeRemoveLiquidity = 3
+	TxTypeIntent       = 4 // Relayed EIP-712 signed DEX intent (see Intent)
+	TxTypeDeposit      = 5 // L1 -> L2 deposit, system tx added by the sequencer (see Deposit)
+	TxTypeWithdrawal   = 6 // L2 -> L1 withdrawal: burns Value FLR, Data = L1 recipient (optional)
+	TxTypePriceUpdate  = 7 // FTSO prices read on L1, system tx added by the sequencer (see PriceUpdate)
 )
 
 // Pool represents a liquidity pool in state.
@@ -46,16 +53,30 @@ type Pool struct {
 
 // Transaction represents a LYRION transaction.
 // It supports standard EVM fields but logic will be handled by our custom or standard EVM.
+// Type is the Lyrion operation; TxType is the Ethereum envelope it is signed in
+// (legacy, EIP-2930 or EIP-1559), see EthTx.
 type Transaction struct {
 	Type     uint8           `json:"type"`
+	TxType   uint8           `json:"txType"`
 	Nonce    uint64          `json:"nonce"`
-	From     *common.Address `json:"from"` // DEV ONLY: Distinct from signature recovery
+	From     *common.Address `json:"from"` // Recovered sender, set once the signature is verified
 	To       *common.Address `json:"to"` // nil for contract creation
 	Value    *big.Int        `json:"value"`
 	Gas      uint64          `json:"gas"`
 	GasPrice *big.Int        `json:"gasPrice"`
 	Data     []byte          `json:"data"` // Call data for DeFi ops
-	V, R, S  *big.Int        `json:"-"`    // Signature values
+	
+	// Typed envelopes only (EIP-2930 / EIP-1559)
+	ChainID    *big.Int            `json:"chainId,omitempty"`
+	GasTipCap  *big.Int            `json:"maxPriorityFeePerGas,omitempty"`
+	GasFeeCap  *big.Int            `json:"maxFeePerGas,omitempty"`
+	AccessList ethtypes.AccessList `json:"accessList,omitempty"`
+	
+	V        *big.Int        `json:"v,omitempty"` // Signature values
+	R        *big.Int        `json:"r,omitempty"`
+	S        *big.Int        `json:"s,omitempty"`
+	
+	sender atomic.Value // *senderCache, set on first successful recovery
 }
 
 // NewBlock creates a new Block.
@@ -66,32 +87,38 @@ func NewBlock(header *Header, txs []*Transaction) *Block {
 	}
 }
 
-// Hash computes the Keccak256 hash of the header.
+// Hash computes the Keccak256 hash of the RLP-encoded header, the block's
+// identity. It covers every field but the signature, so blocks derived from
+// L1 batch data (which carries no seals) hash the same as the sealed ones.
 func (h *Header) Hash() common.Hash {
-    return common.BytesToHash(crypto.Keccak256([]byte(fmt.Sprintf("%v%v%d%d", h.ParentHash, h.Root, h.Number, h.Time))))
+	data, _ := rlp.EncodeToBytes([]interface{}{
+		h.ParentHash,
+		h.Root,
+		h.TxRoot,
+		h.ReceiptRoot,
+		h.Number,
+		h.Time,
+		h.Coinbase,
+		h.Extra,
+		h.GasUsed,
+		h.GasLimit,
+	})
+	return crypto.Keccak256Hash(data)
 }
 
-// Hash computes the Keccak256 hash of the transaction.
+// Hash returns the Ethereum transaction hash (same as go-ethereum's).
 func (tx *Transaction) Hash() common.Hash {
-	return rlpHash(tx)
+	return tx.EthTx().Hash()
 }
 
 
 
 // Sender returns the address derived from the signature (V, R, S).
-// Uses EIP-155 signature recovery.
+// Uses EIP-155 signature recovery. Unsigned transactions are rejected; the
+// From field is never trusted on its own.
 func (tx *Transaction) Sender(chainID *big.Int) (common.Address, error) {
-	// If From is already set and no signature, return From (dev mode)
-	if tx.From != nil && tx.R == nil {
-		return *tx.From, nil
-	}
-	
-	// If no signature at all but From is set, allow it (backwards compat for testing)
-	if tx.R == nil && tx.S == nil && tx.V == nil {
-		if tx.From != nil {
-			return *tx.From, nil
-		}
-		return common.Address{}, fmt.Errorf("transaction not signed")
+	if tx.V == nil || tx.R == nil || tx.S == nil {
+		return common.Address{}, ErrTransactionNotSigned
 	}
 	
 	// Use the EIP-155 signer for proper recovery
@@ -0,0 +1,23 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var ErrInvalidWithdrawal = errors.New("invalid withdrawal")
+
+// WithdrawalRecipient returns the L1 address a TxTypeWithdrawal pays out to:
+// the 20-byte address in Data, or the sender if Data is empty.
+func WithdrawalRecipient(tx *Transaction, from common.Address) (common.Address, error) {
+	switch len(tx.Data) {
+	case 0:
+		return from, nil
+	case common.AddressLength:
+		return common.BytesToAddress(tx.Data), nil
+	default:
+		return common.Address{}, fmt.Errorf("%w: data must be empty or a 20-byte L1 address", ErrInvalidWithdrawal)
+	}
+}
@@ -16,16 +16,36 @@ var (
 	ErrPoolExists             = errors.New("pool already exists")
 	ErrSlippage               = errors.New("insufficient output amount")
 	ErrInsufficientLiquidity  = errors.New("insufficient liquidity")
+	ErrUnsupportedPair        = errors.New("unsupported token pair")
+	ErrIntentExpired          = errors.New("intent deadline passed")
+	ErrInvalidIntentNonce     = errors.New("invalid intent nonce")
+	ErrDepositProcessed       = errors.New("deposit already processed")
+	ErrStalePrice             = errors.New("price update is not newer than the stored prices")
 )
 
 // Executor handles transaction execution against the state.
 type Executor struct {
-	state state.StateDB
+	state     state.StateDB
+	chainID   *big.Int // EIP-712 domain chain ID for intents
+	blockTime uint64   // Timestamp of the block being built, for intent deadlines
 }
 
 // NewExecutor creates a new transaction executor.
-func NewExecutor(state state.StateDB) *Executor {
-	return &Executor{state: state}
+func NewExecutor(state state.StateDB, chainID *big.Int) *Executor {
+	return &Executor{state: state, chainID: chainID}
+}
+
+// WithState returns a copy of the executor that runs on st, e.g. a snapshot
+// of its state.
+func (e *Executor) WithState(st state.StateDB) *Executor {
+	c := *e
+	c.state = st
+	return &c
+}
+
+// SetBlockTime sets the timestamp of the block the next transactions go into.
+func (e *Executor) SetBlockTime(t uint64) {
+	e.blockTime = t
 }
 
 // ExecuteTransaction applies a transaction to the state.
@@ -45,6 +65,14 @@ func (e *Executor) ExecuteTransaction(tx *core.Transaction, from common.Address)
 		err = e.executeAddLiquidity(tx, from)
 	case core.TxTypeSwap:
 		err = e.executeSwap(tx, from)
+	case core.TxTypeIntent:
+		err = e.executeIntent(tx, from)
+	case core.TxTypeWithdrawal:
+		err = e.executeWithdrawal(tx, from)
+	case core.TxTypeDeposit:
+		return fmt.Errorf("deposits are system transactions, use ExecuteDeposit")
+	case core.TxTypePriceUpdate:
+		return fmt.Errorf("price updates are system transactions, use ExecutePriceUpdate")
 	default:
 		return fmt.Errorf("unknown transaction type: %d", tx.Type)
 	}
@@ -77,21 +105,10 @@ func (e *Executor) executeTransfer(tx *core.Transaction, from common.Address) er
 	
 	// 2. Gas Logic (Always paid in LYR)
 	// Only deduct gas if paying from LYR balance, OR check LYR balance separately
-	gasPrice := tx.GasPrice
-	if gasPrice == nil {
-		gasPrice = big.NewInt(1000000000) // 1 Gwei
-	}
-	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas), gasPrice)
-	
-	// Check Gas Balance (LYR)
-	lyrBalance := e.state.GetBalanceLYR(from)
-	if lyrBalance.Cmp(gasCost) < 0 {
-		return fmt.Errorf("insufficient LYR for gas")
+	if err := e.chargeGas(tx, from); err != nil {
+		return err
 	}
 	
-	// Deduct Gas
-	e.state.SetBalanceLYR(from, new(big.Int).Sub(lyrBalance, gasCost))
-	
 	// 3. Check Transfer Balance
 	// If transferring LYR, careful not to double count gas if we just deducted it?
 	// Actually, we fetched 'balance' BEFORE gas deduction.
@@ -156,37 +173,63 @@ func (e *Executor) executeAddLiquidity(tx *core.Transaction, from common.Address
 	// Note: In a real AMM, we would require specific router calldata encoding.
 	// Here we interpret raw data as the secondary token amount for simplicity in this custom L2.
 
+	return e.addLiquidity(from, amountLYR, amountFLR)
+}
+
+// addLiquidity moves amountLYR and amountFLR from owner into the LYR-FLR pool.
+func (e *Executor) addLiquidity(owner common.Address, amountLYR, amountFLR *big.Int) error {
 	// Check Balances
-	balLYR := e.state.GetBalanceLYR(from)
-	balFLR := e.state.GetBalanceFLR(from)
+	balLYR := e.state.GetBalanceLYR(owner)
+	balFLR := e.state.GetBalanceFLR(owner)
 	
 	if balLYR.Cmp(amountLYR) < 0 || balFLR.Cmp(amountFLR) < 0 {
 		return ErrInsufficientBalance
 	}
 
 	// Deduct User
-	e.state.SetBalanceLYR(from, new(big.Int).Sub(balLYR, amountLYR))
-	e.state.SetBalanceFLR(from, new(big.Int).Sub(balFLR, amountFLR))
+	e.state.SetBalanceLYR(owner, new(big.Int).Sub(balLYR, amountLYR))
+	e.state.SetBalanceFLR(owner, new(big.Int).Sub(balFLR, amountFLR))
 
 	// Update Pool
 	pool := e.state.GetPool("LYR-FLR")
+	e.updatePoolPrice("LYR-FLR", pool)
 	pool.Reserve0.Add(pool.Reserve0, amountLYR)
 	pool.Reserve1.Add(pool.Reserve1, amountFLR)
 	// Mint LP tokens here (sqrt(x*y)) - simplified to x+y for now or just x
 	pool.TotalSupply.Add(pool.TotalSupply, amountLYR) 
 
 	e.state.SetPool("LYR-FLR", pool)
+	e.updatePoolPrice("LYR-FLR", pool) // Starts the average of a new pool
 	return nil
 }
 
 // executeSwap swaps LYR for FLR.
-// Future improvement: Support FLR -> LYR via flag or multiple pools.
+// FLR -> LYR is only available through intents for now.
 func (e *Executor) executeSwap(tx *core.Transaction, from common.Address) error {
-	amountIn := tx.Value // LYR in
+	return e.swap(from, "LYR", "FLR", tx.Value, nil)
+}
+
+// swap trades amountIn of tokenIn for tokenOut in the LYR-FLR pool.
+// A non-nil minOut fails the swap if the output would be lower.
+func (e *Executor) swap(owner common.Address, tokenIn, tokenOut string, amountIn, minOut *big.Int) error {
+	var getIn, getOut func(common.Address) *big.Int
+	var setIn, setOut func(common.Address, *big.Int)
+	var lyrIn bool
+	switch {
+	case tokenIn == "LYR" && tokenOut == "FLR":
+		lyrIn = true
+		getIn, setIn = e.state.GetBalanceLYR, e.state.SetBalanceLYR
+		getOut, setOut = e.state.GetBalanceFLR, e.state.SetBalanceFLR
+	case tokenIn == "FLR" && tokenOut == "LYR":
+		getIn, setIn = e.state.GetBalanceFLR, e.state.SetBalanceFLR
+		getOut, setOut = e.state.GetBalanceLYR, e.state.SetBalanceLYR
+	default:
+		return fmt.Errorf("%w: %s -> %s", ErrUnsupportedPair, tokenIn, tokenOut)
+	}
 	
 	// Check Balance
-	balLYR := e.state.GetBalanceLYR(from)
-	if balLYR.Cmp(amountIn) < 0 {
+	balIn := getIn(owner)
+	if balIn.Cmp(amountIn) < 0 {
 		return ErrInsufficientBalance
 	}
 	
@@ -194,34 +237,260 @@ func (e *Executor) executeSwap(tx *core.Transaction, from common.Address) error
 	if pool.Reserve0.Cmp(big.NewInt(0)) == 0 {
 		return ErrInsufficientLiquidity
 	}
+	e.updatePoolPrice("LYR-FLR", pool)
 
 	// Constant Product: x * y = k
 	// (x + dx)(y - dy) = k
 	// dy = y - ( xy / (x + dx) )
 	// dy = (y * dx) / (x + dx)
 	
-	// x = Reserve0 (LYR), y = Reserve1 (FLR)
-	numerator := new(big.Int).Mul(pool.Reserve1, amountIn) // y * dx
-	denominator := new(big.Int).Add(pool.Reserve0, amountIn) // x + dx
+	// x = reserve of tokenIn, y = reserve of tokenOut
+	reserveIn, reserveOut := pool.Reserve0, pool.Reserve1 // LYR, FLR
+	if !lyrIn {
+		reserveIn, reserveOut = pool.Reserve1, pool.Reserve0
+	}
+	numerator := new(big.Int).Mul(reserveOut, amountIn) // y * dx
+	denominator := new(big.Int).Add(reserveIn, amountIn) // x + dx
 	amountOut := new(big.Int).Div(numerator, denominator)
 	
 	if amountOut.Cmp(big.NewInt(0)) == 0 {
 		return ErrSlippage
 	}
+	if minOut != nil && amountOut.Cmp(minOut) < 0 {
+		return fmt.Errorf("%w: got %s, want at least %s", ErrSlippage, amountOut, minOut)
+	}
 
 	// Update User
-	e.state.SetBalanceLYR(from, new(big.Int).Sub(balLYR, amountIn))
-	currentFLR := e.state.GetBalanceFLR(from)
-	e.state.SetBalanceFLR(from, new(big.Int).Add(currentFLR, amountOut))
+	setIn(owner, new(big.Int).Sub(balIn, amountIn))
+	setOut(owner, new(big.Int).Add(getOut(owner), amountOut))
 	
 	// Update Pool
-	pool.Reserve0.Add(pool.Reserve0, amountIn)
-	pool.Reserve1.Sub(pool.Reserve1, amountOut)
+	reserveIn.Add(reserveIn, amountIn)
+	reserveOut.Sub(reserveOut, amountOut)
 	e.state.SetPool("LYR-FLR", pool)
 	
 	return nil
 }
 
+// executeIntent runs an EIP-712 signed intent submitted by a relayer.
+// The relayer (from) pays the gas; the intent owner's balances are used for
+// the trade. Replay protection: the domain binds the chain ID and router,
+// each owner nonce is usable once, and the deadline bounds its lifetime.
+func (e *Executor) executeIntent(tx *core.Transaction, from common.Address) error {
+	signed, err := core.DecodeSignedIntent(tx.Data)
+	if err != nil {
+		return err
+	}
+	if err := signed.Verify(e.chainID); err != nil {
+		return err
+	}
+	intent := &signed.Intent
+	
+	if e.blockTime > intent.Deadline {
+		return fmt.Errorf("%w: deadline %d, block time %d", ErrIntentExpired, intent.Deadline, e.blockTime)
+	}
+	
+	nonceKey := core.IntentNonceKey(intent.Owner)
+	expected := e.state.GetState(core.RouterAddress, nonceKey).Big().Uint64()
+	if intent.Nonce != expected {
+		return fmt.Errorf("%w: expected %d, got %d", ErrInvalidIntentNonce, expected, intent.Nonce)
+	}
+	
+	// Gas is charged before the trade so a relayer that is also the owner
+	// can't spend its gas in the swap; the charge is refunded if the trade
+	// fails, leaving the state as it was.
+	relayerLYR := e.state.GetBalanceLYR(from)
+	if err := e.chargeGas(tx, from); err != nil {
+		return err
+	}
+	
+	switch intent.Kind {
+	case core.IntentKindSwap:
+		err = e.swap(intent.Owner, intent.TokenIn, intent.TokenOut, intent.AmountIn, intent.AmountOut)
+	case core.IntentKindAddLiquidity:
+		switch {
+		case intent.TokenIn == "LYR" && intent.TokenOut == "FLR":
+			err = e.addLiquidity(intent.Owner, intent.AmountIn, intent.AmountOut)
+		case intent.TokenIn == "FLR" && intent.TokenOut == "LYR":
+			err = e.addLiquidity(intent.Owner, intent.AmountOut, intent.AmountIn)
+		default:
+			err = fmt.Errorf("%w: %s-%s", ErrUnsupportedPair, intent.TokenIn, intent.TokenOut)
+		}
+	}
+	if err != nil {
+		e.state.SetBalanceLYR(from, relayerLYR)
+		return err
+	}
+	
+	e.state.SetState(core.RouterAddress, nonceKey, common.BigToHash(new(big.Int).SetUint64(expected+1)))
+	return nil
+}
+
+// gasCost returns the LYR cost of tx (gas * gasPrice, 1 Gwei by default).
+// An unset gas price is encoded as zero, so zero gets the default too:
+// otherwise a tx re-executed from its encoding would pay less.
+func gasCost(tx *core.Transaction) *big.Int {
+	gasPrice := tx.GasPrice
+	if gasPrice == nil || gasPrice.Sign() == 0 {
+		gasPrice = big.NewInt(1000000000) // 1 Gwei
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas), gasPrice)
+}
+
+// chargeGas deducts the gas cost of tx from the payer's LYR balance.
+func (e *Executor) chargeGas(tx *core.Transaction, payer common.Address) error {
+	cost := gasCost(tx)
+	
+	// Check Gas Balance (LYR)
+	lyrBalance := e.state.GetBalanceLYR(payer)
+	if lyrBalance.Cmp(cost) < 0 {
+		return fmt.Errorf("insufficient LYR for gas")
+	}
+	
+	// Deduct Gas
+	e.state.SetBalanceLYR(payer, new(big.Int).Sub(lyrBalance, cost))
+	return nil
+}
+
+// executeWithdrawal burns Value FLR from the sender. The withdrawal is
+// claimed on L1 (LyrionBridge.withdrawFromL2) with a Merkle proof against the
+// root of the settlement batch that includes this tx.
+func (e *Executor) executeWithdrawal(tx *core.Transaction, from common.Address) error {
+	if _, err := core.WithdrawalRecipient(tx, from); err != nil {
+		return err
+	}
+	if tx.Value == nil || tx.Value.Sign() <= 0 {
+		return fmt.Errorf("withdrawal amount must be positive")
+	}
+	
+	balFLR := e.state.GetBalanceFLR(from)
+	if balFLR.Cmp(tx.Value) < 0 {
+		return ErrInsufficientBalance
+	}
+	if e.state.GetBalanceLYR(from).Cmp(gasCost(tx)) < 0 {
+		return fmt.Errorf("insufficient LYR for gas")
+	}
+	
+	e.state.SetBalanceFLR(from, new(big.Int).Sub(balFLR, tx.Value))
+	return e.chargeGas(tx, from)
+}
+
+// ExecuteDeposit credits an L1 deposit (TxTypeDeposit system tx) to the
+// recipient's FLR balance. Each bridge deposit nonce is credited only once,
+// so re-delivered deposits (restarts, L1 reorgs) are rejected.
+func (e *Executor) ExecuteDeposit(tx *core.Transaction) error {
+	if tx.Type != core.TxTypeDeposit {
+		return fmt.Errorf("not a deposit transaction: type %d", tx.Type)
+	}
+	d, err := core.DecodeDeposit(tx.Data)
+	if err != nil {
+		return err
+	}
+	if tx.To == nil || *tx.To != d.Recipient || tx.Value == nil || tx.Value.Cmp(d.Amount) != 0 || tx.Nonce != d.Nonce {
+		return fmt.Errorf("%w: tx fields do not match deposit %d", core.ErrInvalidDeposit, d.Nonce)
+	}
+	
+	key := core.DepositProcessedKey(d.Nonce)
+	if e.state.GetState(core.SystemAddress, key) != (common.Hash{}) {
+		return fmt.Errorf("%w: nonce %d", ErrDepositProcessed, d.Nonce)
+	}
+	
+	balance := e.state.GetBalanceFLR(d.Recipient)
+	e.state.SetBalanceFLR(d.Recipient, new(big.Int).Add(balance, d.Amount))
+	e.state.SetState(core.SystemAddress, key, common.BigToHash(common.Big1))
+	return nil
+}
+
+// ExecutePriceUpdate stores the FTSO prices of a TxTypePriceUpdate system
+// tx. Prices only move forward: a feed is updated when the update is newer
+// than its stored price, and an update that changes nothing is rejected.
+func (e *Executor) ExecutePriceUpdate(tx *core.Transaction) error {
+	if tx.Type != core.TxTypePriceUpdate {
+		return fmt.Errorf("not a price update transaction: type %d", tx.Type)
+	}
+	u, err := core.DecodePriceUpdate(tx.Data)
+	if err != nil {
+		return err
+	}
+	if tx.Nonce != u.Timestamp {
+		return fmt.Errorf("%w: tx fields do not match the update", core.ErrInvalidPriceUpdate)
+	}
+	
+	updated := 0
+	for _, p := range u.Prices {
+		timeKey := core.PriceTimeKey(p.Symbol)
+		if e.state.GetState(core.SystemAddress, timeKey).Big().Uint64() >= u.Timestamp {
+			continue
+		}
+		e.state.SetState(core.SystemAddress, core.PriceKey(p.Symbol), common.BigToHash(p.Price))
+		e.state.SetState(core.SystemAddress, timeKey, common.BigToHash(new(big.Int).SetUint64(u.Timestamp)))
+		updated++
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w: timestamp %d", ErrStalePrice, u.Timestamp)
+	}
+	return nil
+}
+
+// GetPrice returns symbol's USD price (core.PriceDecimals decimals) and
+// its FTSO timestamp, or nil if no price was posted.
+func GetPrice(st state.StateDB, symbol string) (*big.Int, uint64) {
+	price := st.GetState(core.SystemAddress, core.PriceKey(symbol)).Big()
+	if price.Sign() == 0 {
+		return nil, 0
+	}
+	return price, st.GetState(core.SystemAddress, core.PriceTimeKey(symbol)).Big().Uint64()
+}
+
+// updatePoolPrice moves the average price of pair towards the spot price
+// the pool held since the last update, weighted by the time elapsed (capped
+// at core.PoolPriceWindow). It runs before the reserves change, so a price
+// only counts once it has held across blocks: moving the pool and moving it
+// back within a block leaves the average as it was.
+func (e *Executor) updatePoolPrice(pair string, pool *core.Pool) {
+	if pool.Reserve0.Sign() == 0 || pool.Reserve1.Sign() == 0 {
+		return
+	}
+	spot := new(big.Int).Mul(pool.Reserve1, priceUnit)
+	spot.Quo(spot, pool.Reserve0)
+	
+	priceKey, timeKey := core.PoolPriceKey(pair), core.PoolPriceTimeKey(pair)
+	avg := e.state.GetState(core.SystemAddress, priceKey).Big()
+	last := e.state.GetState(core.SystemAddress, timeKey).Big().Uint64()
+	if avg.Sign() == 0 {
+		avg = spot
+	} else {
+		if e.blockTime <= last {
+			return
+		}
+		elapsed := e.blockTime - last
+		if elapsed > core.PoolPriceWindow {
+			elapsed = core.PoolPriceWindow
+		}
+		// avg += (spot - avg) * elapsed / window
+		delta := new(big.Int).Sub(spot, avg)
+		delta.Mul(delta, new(big.Int).SetUint64(elapsed))
+		delta.Quo(delta, big.NewInt(core.PoolPriceWindow))
+		avg.Add(avg, delta)
+	}
+	e.state.SetState(core.SystemAddress, priceKey, common.BigToHash(avg))
+	e.state.SetState(core.SystemAddress, timeKey, common.BigToHash(new(big.Int).SetUint64(e.blockTime)))
+}
+
+// priceUnit is 1 in core.PriceDecimals fixed point
+var priceUnit = new(big.Int).Exp(big.NewInt(10), big.NewInt(core.PriceDecimals), nil)
+
+// GetPoolPrice returns the time-weighted average price of pair (reserve1
+// per reserve0, core.PriceDecimals decimals) and the block time it was last
+// updated at, or nil if the pool never had liquidity.
+func GetPoolPrice(st state.StateDB, pair string) (*big.Int, uint64) {
+	price := st.GetState(core.SystemAddress, core.PoolPriceKey(pair)).Big()
+	if price.Sign() == 0 {
+		return nil, 0
+	}
+	return price, st.GetState(core.SystemAddress, core.PoolPriceTimeKey(pair)).Big().Uint64()
+}
+
 // Mint is a dev helper to add tokens to an account (Genesis/Faucet).
 func (e *Executor) Mint(addr common.Address, amountLYR *big.Int, amountFLR *big.Int) {
 	if amountLYR != nil {
@@ -0,0 +1,74 @@
+package execution
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+// The pool price average ignores a swap that is undone within the block
+// and follows a price that holds for the whole window.
+func TestPoolPrice(t *testing.T) {
+	st, err := state.NewInMemoryBadgerStateDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer st.Close()
+	e := NewExecutor(st, big.NewInt(42069))
+	lp, trader := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	ether := big.NewInt(params.Ether)
+	e.Mint(lp, new(big.Int).Mul(big.NewInt(1000), ether), new(big.Int).Mul(big.NewInt(2000), ether))
+	e.Mint(trader, new(big.Int).Mul(big.NewInt(10000), ether), nil)
+
+	// 2 FLR per LYR
+	e.SetBlockTime(100)
+	if err := e.addLiquidity(lp, new(big.Int).Mul(big.NewInt(1000), ether), new(big.Int).Mul(big.NewInt(2000), ether)); err != nil {
+		t.Fatal(err)
+	}
+	want := new(big.Int).Mul(big.NewInt(2), ether)
+	if price, _ := GetPoolPrice(st, "LYR-FLR"); price == nil || price.Cmp(want) != 0 {
+		t.Fatalf("pool price %v after the first deposit, want %s", price, want)
+	}
+
+	// Dump LYR and buy it back in the same block
+	e.SetBlockTime(200)
+	before := st.GetBalanceFLR(trader)
+	if err := e.swap(trader, "LYR", "FLR", new(big.Int).Mul(big.NewInt(9000), ether), nil); err != nil {
+		t.Fatal(err)
+	}
+	if spot := st.GetPool("LYR-FLR"); new(big.Int).Quo(spot.Reserve1, spot.Reserve0).Sign() != 0 {
+		t.Fatal("swap did not move the spot price below 1")
+	}
+	got := new(big.Int).Sub(st.GetBalanceFLR(trader), before)
+	if err := e.swap(trader, "FLR", "LYR", got, nil); err != nil {
+		t.Fatal(err)
+	}
+	e.SetBlockTime(210)
+	if err := e.swap(trader, "LYR", "FLR", ether, nil); err != nil {
+		t.Fatal(err)
+	}
+	price, updated := GetPoolPrice(st, "LYR-FLR")
+	if diff := new(big.Int).Sub(price, want); diff.CmpAbs(big.NewInt(1e9)) > 0 || updated != 210 {
+		t.Fatalf("pool price %s at %d after a swap undone in its block, want %s at 210", price, updated, want)
+	}
+
+	// A price that held for the whole window replaces the average
+	e.SetBlockTime(300)
+	if err := e.swap(trader, "LYR", "FLR", new(big.Int).Mul(big.NewInt(1000), ether), nil); err != nil {
+		t.Fatal(err)
+	}
+	pool := st.GetPool("LYR-FLR")
+	spot := new(big.Int).Mul(pool.Reserve1, ether)
+	spot.Quo(spot, pool.Reserve0)
+	e.SetBlockTime(300 + core.PoolPriceWindow)
+	if err := e.addLiquidity(lp, big.NewInt(0), big.NewInt(0)); err != nil {
+		t.Fatal(err)
+	}
+	if price, _ := GetPoolPrice(st, "LYR-FLR"); price.Cmp(spot) != 0 {
+		t.Fatalf("pool price %s after a full window, want %s", price, spot)
+	}
+}
@@ -0,0 +1,97 @@
+package execution
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+// A relayed intent runs once, before its deadline, and only with the
+// owner's signature. A refused intent leaves the state untouched.
+func TestExecuteIntent(t *testing.T) {
+	st, err := state.NewInMemoryBadgerStateDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer st.Close()
+	chainID := big.NewInt(42069)
+	e := NewExecutor(st, chainID)
+	ether := big.NewInt(params.Ether)
+	lp, relayer := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	e.Mint(lp, new(big.Int).Mul(big.NewInt(1000), ether), new(big.Int).Mul(big.NewInt(2000), ether))
+	e.Mint(relayer, ether, nil)
+	e.Mint(owner, new(big.Int).Mul(big.NewInt(10), ether), nil)
+	e.SetBlockTime(100)
+	if err := e.addLiquidity(lp, new(big.Int).Mul(big.NewInt(1000), ether), new(big.Int).Mul(big.NewInt(2000), ether)); err != nil {
+		t.Fatal(err)
+	}
+
+	relay := func(intent core.Intent, signer *ecdsa.PrivateKey) error {
+		signed, err := core.SignIntent(&intent, chainID, signer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := core.EncodeSignedIntent(signed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := &core.Transaction{Type: core.TxTypeIntent, Nonce: st.GetNonce(relayer), Data: data, Gas: 100000}
+		return e.ExecuteTransaction(tx, relayer)
+	}
+	intentNonce := func() uint64 {
+		return st.GetState(core.RouterAddress, core.IntentNonceKey(owner)).Big().Uint64()
+	}
+	swap := core.Intent{Kind: core.IntentKindSwap, Owner: owner, TokenIn: "LYR", TokenOut: "FLR", AmountIn: ether, AmountOut: big.NewInt(0), Deadline: 150}
+
+	if err := relay(swap, key); err != nil {
+		t.Fatal(err)
+	}
+	if intentNonce() != 1 || st.GetBalanceFLR(owner).Sign() == 0 {
+		t.Fatalf("intent nonce %d and %s FLR after a swap", intentNonce(), st.GetBalanceFLR(owner))
+	}
+
+	expired := swap
+	expired.Nonce, expired.Deadline = 1, 99
+	forged := swap
+	forged.Nonce = 1
+	for _, tc := range []struct {
+		name   string
+		intent core.Intent
+		signer *ecdsa.PrivateKey
+		want   error
+	}{
+		{"replayed nonce", swap, key, ErrInvalidIntentNonce},
+		{"future nonce", core.Intent{Kind: core.IntentKindSwap, Owner: owner, TokenIn: "LYR", TokenOut: "FLR", AmountIn: ether, AmountOut: big.NewInt(0), Deadline: 150, Nonce: 2}, key, ErrInvalidIntentNonce},
+		{"expired deadline", expired, key, ErrIntentExpired},
+		{"signed by another key", forged, other, core.ErrInvalidIntentSignature},
+	} {
+		lyr, flr := st.GetBalanceLYR(owner), st.GetBalanceFLR(owner)
+		relayerLYR, relayerNonce := st.GetBalanceLYR(relayer), st.GetNonce(relayer)
+		if err := relay(tc.intent, tc.signer); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: got %v, want %v", tc.name, err, tc.want)
+		}
+		if st.GetBalanceLYR(owner).Cmp(lyr) != 0 || st.GetBalanceFLR(owner).Cmp(flr) != 0 || intentNonce() != 1 {
+			t.Fatalf("%s: owner state changed by a refused intent", tc.name)
+		}
+		if st.GetBalanceLYR(relayer).Cmp(relayerLYR) != 0 || st.GetNonce(relayer) != relayerNonce {
+			t.Fatalf("%s: relayer charged for a refused intent", tc.name)
+		}
+	}
+
+	// The deadline is inclusive
+	e.SetBlockTime(150)
+	swap.Nonce = 1
+	if err := relay(swap, key); err != nil {
+		t.Fatalf("intent at its deadline: %v", err)
+	}
+}
@@ -2,6 +2,8 @@ package mempool
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
 	"sync"
 	
 	"github.com/ethereum/go-ethereum/common"
@@ -9,7 +11,10 @@ import (
 )
 
 var (
-	ErrTxExists = errors.New("transaction already in mempool")
+	ErrTxExists       = errors.New("transaction already in mempool")
+	ErrInvalidSender  = errors.New("invalid transaction signature")
+	ErrSenderMismatch = errors.New("signature does not match from address")
+	ErrSystemTx       = errors.New("system transactions can't be submitted to the mempool")
 )
 
 // Mempool manages pending transactions.
@@ -17,26 +22,123 @@ type Mempool struct {
 	mu   sync.RWMutex
 	txs  map[common.Hash]*core.Transaction // Fast lookup
 	queue []*core.Transaction              // FIFO for now, PriorityQueue later
+	
+	chainID *big.Int // Chain ID used to verify transaction signatures
+	
+	onAdd func(*core.Transaction) // Called for every admitted tx (e.g. P2P gossip)
+	
+	ingest     chan ingestReq // Txs waiting in Ingest, admitted in batches
+	ingestOnce sync.Once
+}
+
+// maxIngestBatch bounds the txs Ingest admits with one AddBatch call
+const maxIngestBatch = 256
+
+// ingestReq is a tx waiting in Ingest for its batch
+type ingestReq struct {
+	tx   *core.Transaction
+	done chan error
 }
 
-func NewMempool() *Mempool {
+func NewMempool(chainID *big.Int) *Mempool {
 	return &Mempool{
-		txs:   make(map[common.Hash]*core.Transaction),
-		queue: make([]*core.Transaction, 0),
+		txs:     make(map[common.Hash]*core.Transaction),
+		queue:   make([]*core.Transaction, 0),
+		chainID: chainID,
 	}
 }
 
-// Add adds a transaction to the pool.
+// SetAddHandler sets a callback run for every tx admitted to the pool, after
+// it is queued. Set it before txs are added.
+func (mp *Mempool) SetAddHandler(handler func(*core.Transaction)) {
+	mp.onAdd = handler
+}
+
+// Add verifies the transaction signature and adds it to the pool.
+// The recovered sender replaces whatever the caller put in From.
 func (mp *Mempool) Add(tx *core.Transaction) error {
-	mp.mu.Lock()
-	defer mp.mu.Unlock()
-	
-	// TODO: Calculate Hash properly. For now we do a dummy hash check?
-	// In real impl, we'd hash the RLP of the tx.
-	// Since we don't have Hash() on Tx yet, we'll skip the map check for this exact step
-	// or implement a quick ID.
+	sender, err := tx.Sender(mp.chainID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSender, err)
+	}
+	return mp.add(tx, sender)
+}
+
+// AddBatch admits many transactions at once, recovering their senders in
+// parallel. It returns one error per transaction (nil if admitted).
+func (mp *Mempool) AddBatch(txs []*core.Transaction) []error {
+	senders, errs := core.RecoverSenders(txs, mp.chainID)
+	for i, tx := range txs {
+		if errs[i] != nil {
+			errs[i] = fmt.Errorf("%w: %v", ErrInvalidSender, errs[i])
+			continue
+		}
+		errs[i] = mp.add(tx, senders[i])
+	}
+	return errs
+}
+
+// Ingest admits a transaction like Add, but txs submitted concurrently (P2P
+// validators run in parallel) are collected and admitted together with
+// AddBatch, so their senders are recovered in parallel. It blocks until the
+// tx is admitted or refused.
+func (mp *Mempool) Ingest(tx *core.Transaction) error {
+	mp.ingestOnce.Do(func() {
+		mp.ingest = make(chan ingestReq, maxIngestBatch)
+		go mp.ingestLoop()
+	})
+	done := make(chan error, 1)
+	mp.ingest <- ingestReq{tx: tx, done: done}
+	return <-done
+}
+
+// ingestLoop admits the waiting txs in batches: whatever queued up while the
+// previous batch was verified goes into the next one.
+func (mp *Mempool) ingestLoop() {
+	for req := range mp.ingest {
+		batch := []ingestReq{req}
+	collect:
+		for len(batch) < maxIngestBatch {
+			select {
+			case req := <-mp.ingest:
+				batch = append(batch, req)
+			default:
+				break collect
+			}
+		}
+		
+		txs := make([]*core.Transaction, len(batch))
+		for i, req := range batch {
+			txs[i] = req.tx
+		}
+		for i, err := range mp.AddBatch(txs) {
+			batch[i].done <- err
+		}
+	}
+}
+
+func (mp *Mempool) add(tx *core.Transaction, sender common.Address) error {
+	if tx.Type == core.TxTypeDeposit || tx.Type == core.TxTypePriceUpdate {
+		return ErrSystemTx
+	}
+	if tx.From != nil && *tx.From != sender {
+		return ErrSenderMismatch
+	}
+	tx.From = &sender
 	
+	hash := tx.Hash()
+	mp.mu.Lock()
+	if _, ok := mp.txs[hash]; ok {
+		mp.mu.Unlock()
+		return ErrTxExists
+	}
+	mp.txs[hash] = tx
 	mp.queue = append(mp.queue, tx)
+	mp.mu.Unlock()
+	
+	if mp.onAdd != nil {
+		mp.onAdd(tx)
+	}
 	return nil
 }
 
@@ -59,8 +161,36 @@ func (mp *Mempool) Pop(k int) {
 	if k > len(mp.queue) {
 		k = len(mp.queue)
 	}
+	for _, tx := range mp.queue[:k] {
+		delete(mp.txs, tx.Hash())
+	}
 	mp.queue = mp.queue[k:]
-	// TODO: Clean map
+}
+
+// Remove drops the given txs from the pool, e.g. once a block imported from
+// the sequencer included them.
+func (mp *Mempool) Remove(txs []*core.Transaction) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	
+	removed := 0
+	for _, tx := range txs {
+		hash := tx.Hash()
+		if _, ok := mp.txs[hash]; ok {
+			delete(mp.txs, hash)
+			removed++
+		}
+	}
+	if removed == 0 {
+		return
+	}
+	queue := make([]*core.Transaction, 0, len(mp.queue)-removed)
+	for _, tx := range mp.queue {
+		if _, ok := mp.txs[tx.Hash()]; ok {
+			queue = append(queue, tx)
+		}
+	}
+	mp.queue = queue
 }
 
 // Len returns the count of pending txs.
@@ -69,3 +199,18 @@ func (mp *Mempool) Len() int {
 	defer mp.mu.RUnlock()
 	return len(mp.queue)
 }
+
+// PendingCount returns the number of queued txs sent by addr, so callers can
+// pick the next nonce when they have several txs in flight.
+func (mp *Mempool) PendingCount(addr common.Address) uint64 {
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+	
+	var n uint64
+	for _, tx := range mp.queue {
+		if tx.From != nil && *tx.From == addr {
+			n++
+		}
+	}
+	return n
+}
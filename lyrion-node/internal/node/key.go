@@ -0,0 +1,61 @@
+package node
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// NodeKeyFile holds the node's libp2p identity in the data directory. Its
+// peer ID is part of the node's multiaddrs, so bootnodes keep the addresses
+// they publish across restarts.
+const NodeKeyFile = "p2p-key"
+
+// LoadNodeKey reads the node key at path, generating and saving an Ed25519
+// key on first start. An empty path gives a fresh key every start.
+func LoadNodeKey(path string) (crypto.PrivKey, error) {
+	if path == "" {
+		key, _, err := crypto.GenerateEd25519Key(rand.Reader)
+		return key, err
+	}
+	data, err := os.ReadFile(path)
+	if err == nil {
+		key, err := crypto.UnmarshalPrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node key %s: %v", path, err)
+		}
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	key, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	data, err = crypto.MarshalPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return nil, err
+	}
+	if id, err := peer.IDFromPrivateKey(key); err == nil {
+		log.Printf("🔑 Generated P2P node key %s (peer ID %s)", path, id)
+	}
+	return key, nil
+}
@@ -0,0 +1,84 @@
+package node
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+func TestLoadNodeKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", NodeKeyFile)
+	key, err := LoadNodeKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Fatalf("key file not saved private: %v", err)
+	}
+	again, err := LoadNodeKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !key.Equals(again) {
+		t.Fatal("node key changed across loads")
+	}
+
+	// Without a path every load is a new identity
+	a, _ := LoadNodeKey("")
+	b, _ := LoadNodeKey("")
+	if a == nil || a.Equals(b) {
+		t.Fatal("ephemeral keys are not fresh")
+	}
+
+	if err := os.WriteFile(path, []byte("not a key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadNodeKey(path); err == nil {
+		t.Fatal("loaded a corrupt node key")
+	}
+
+	// A node keeps its identity across restarts with a data directory
+	dir := t.TempDir()
+	ids := make([]peer.ID, 2)
+	for i := range ids {
+		n, err := NewP2PNode(&P2PConfig{ChainID: testChainID, GenesisHash: testGenesis, DataDir: dir})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = n.host.ID()
+		n.Close()
+	}
+	if ids[0] != ids[1] {
+		t.Fatalf("peer ID changed across restarts: %s, %s", ids[0], ids[1])
+	}
+}
+
+func TestParsePeers(t *testing.T) {
+	id := newPeerID(t)
+	tcp := "/ip4/10.0.0.1/tcp/30303/p2p/" + id.String()
+	quic := "/ip4/10.0.0.1/udp/30303/quic-v1/p2p/" + id.String()
+
+	infos, err := ParsePeers([]string{tcp, quic}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 1 || infos[0].ID != id || len(infos[0].Addrs) != 2 {
+		t.Fatalf("parsed %v, want one peer with both addresses", infos)
+	}
+
+	// Bare peer IDs are fine for trusted peers, static ones need an address
+	infos, err = ParsePeers([]string{id.String()}, true)
+	if err != nil || len(infos) != 1 || infos[0].ID != id || len(infos[0].Addrs) != 0 {
+		t.Fatalf("trusted peer ID parsed as %v (%v)", infos, err)
+	}
+	if _, err := ParsePeers([]string{id.String()}, false); err == nil {
+		t.Fatal("static peer without an address accepted")
+	}
+	for _, bad := range []string{"/ip4/10.0.0.1/tcp/30303", "not a peer"} {
+		if _, err := ParsePeers([]string{bad}, true); err == nil {
+			t.Fatalf("parsed %q", bad)
+		}
+	}
+}
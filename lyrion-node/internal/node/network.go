@@ -0,0 +1,95 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/multiformats/go-multistream"
+)
+
+// ErrWrongNetwork is returned by the status handshake with a peer of another
+// chain or genesis.
+var ErrWrongNetwork = errors.New("peer is on another network")
+
+// Network identifies a Lyrion chain on the P2P network. Protocol IDs, topic
+// names and the discovery namespace are all derived from it, so a devnet, a
+// testnet and mainnet sharing a LAN or a DHT never mix: peers of another
+// network don't speak our protocols or find us, and the status handshake
+// drops those that connect anyway.
+type Network struct {
+	ChainID uint64
+	Genesis common.Hash
+}
+
+// prefix is the namespace of the network, e.g. /lyrion/1337/8f3a0c21d4e5b6a7.
+func (nw Network) prefix() string {
+	return fmt.Sprintf("/lyrion/%d/%x", nw.ChainID, nw.Genesis[:8])
+}
+
+// Protocol returns the ID of a request/response protocol, e.g.
+// /lyrion/1337/8f3a0c21d4e5b6a7/status/1.0.0.
+func (nw Network) Protocol(name string) protocol.ID {
+	return protocol.ID(fmt.Sprintf("%s/%s/%s", nw.prefix(), name, ProtocolVersion))
+}
+
+// Topic returns the versioned gossip topic of a message kind, e.g.
+// /lyrion/1337/8f3a0c21d4e5b6a7/blocks/2/rlp_snappy.
+func (nw Network) Topic(name string) string {
+	return fmt.Sprintf("%s/%s/%d/%s", nw.prefix(), name, WireVersion, WireEncoding)
+}
+
+// DHTPrefix is the protocol prefix of the network's DHT.
+func (nw Network) DHTPrefix() protocol.ID {
+	return protocol.ID(nw.prefix())
+}
+
+// DiscoveryTag is the rendezvous string of the network for DHT and mDNS
+// discovery. mDNS doesn't allow slashes, e.g. lyrion-1337-8f3a0c21d4e5b6a7.
+func (nw Network) DiscoveryTag() string {
+	return fmt.Sprintf("%s-%d-%x", DiscoveryTag, nw.ChainID, nw.Genesis[:8])
+}
+
+func (nw Network) String() string {
+	return nw.prefix()
+}
+
+// checkStatus returns ErrWrongNetwork if a peer's status is for another
+// network.
+func (nw Network) checkStatus(status *Status) error {
+	if status.ChainID != nw.ChainID {
+		return fmt.Errorf("%w: chain ID %d, expected %d", ErrWrongNetwork, status.ChainID, nw.ChainID)
+	}
+	if status.GenesisHash != nw.Genesis {
+		return fmt.Errorf("%w: genesis %s, expected %s", ErrWrongNetwork, status.GenesisHash.Hex(), nw.Genesis.Hex())
+	}
+	return nil
+}
+
+// handshake exchanges status with a newly connected peer and disconnects it
+// if it is on another network or doesn't speak the status protocol of ours
+// (an older node, or one of another chain). A peer that is just slow to
+// answer is kept.
+func (n *P2PNode) handshake(p peer.ID) {
+	status, err := n.RequestStatus(n.ctx, p)
+	if err == nil {
+		err = n.network.checkStatus(status)
+	} else {
+		var unsupported multistream.ErrNotSupported[protocol.ID]
+		if !errors.As(err, &unsupported) {
+			if n.host.Network().Connectedness(p) == network.Connected {
+				log.Printf("⚠️ P2P: Status handshake with %s failed: %v", shortID(p), err)
+			}
+			return
+		}
+		err = fmt.Errorf("%w: status protocol not supported", ErrWrongNetwork)
+	}
+	if err != nil {
+		log.Printf("🚫 P2P: Disconnecting %s: %v", shortID(p), err)
+		n.host.Network().ClosePeer(p)
+	}
+}
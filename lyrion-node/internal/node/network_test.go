@@ -0,0 +1,58 @@
+package node
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Networks of different chains or geneses share no protocol, topic, DHT or
+// discovery namespace.
+func TestNetworkNamespaces(t *testing.T) {
+	base := Network{ChainID: testChainID, Genesis: testGenesis}
+	names := func(nw Network) []string {
+		return []string{
+			string(nw.Protocol(StatusProtocol)),
+			string(nw.Protocol(BlocksByRangeProtocol)),
+			nw.Topic(BlockTopic),
+			nw.Topic(TxTopic),
+			string(nw.DHTPrefix()),
+			nw.DiscoveryTag(),
+		}
+	}
+	want := names(base)
+	if again := names(Network{ChainID: testChainID, Genesis: testGenesis}); !slices.Equal(again, want) {
+		t.Fatal("names of the same network differ")
+	}
+
+	for _, other := range []Network{
+		{ChainID: testChainID + 1, Genesis: testGenesis},
+		{ChainID: testChainID, Genesis: crypto.Keccak256Hash([]byte("another genesis"))},
+	} {
+		got := names(other)
+		for i := range want {
+			if got[i] == want[i] {
+				t.Errorf("%s and %s share %s", base, other, got[i])
+			}
+		}
+		status := &Status{ChainID: other.ChainID, GenesisHash: other.Genesis}
+		if err := base.checkStatus(status); !errors.Is(err, ErrWrongNetwork) {
+			t.Errorf("status of %s accepted by %s: %v", other, base, err)
+		}
+	}
+	if err := base.checkStatus(&Status{ChainID: testChainID, GenesisHash: testGenesis, Head: 5}); err != nil {
+		t.Fatalf("status of the same network: %v", err)
+	}
+}
+
+// Nodes of different networks can't exchange status.
+func TestNetworkIsolation(t *testing.T) {
+	a := newTestNode(t, nil)
+	b := newTestNode(t, &P2PConfig{ChainID: testChainID + 1, GenesisHash: testGenesis})
+	connect(t, b, a)
+	waitFor(t, "the node of another chain to be disconnected", func() bool {
+		return a.PeerCount() == 0 && b.PeerCount() == 0
+	})
+}
@@ -2,28 +2,31 @@ package node
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
+	"path/filepath"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/libp2p/go-libp2p"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
 	"github.com/libp2p/go-libp2p/p2p/discovery/mdns"
 	drouting "github.com/libp2p/go-libp2p/p2p/discovery/routing"
 	"github.com/lyrion-l2/lyrion-node/internal/core"
 )
 
 const (
-	// P2P Protocol IDs
-	ProtocolID      = "/lyrion/1.0.0"
-	BlockTopic      = "/lyrion/blocks"
-	TxTopic         = "/lyrion/txs"
-	DiscoveryTag    = "lyrion-p2p"
+	// P2P protocol and topic names, namespaced by network (see network.go)
+	ProtocolVersion = "1.0.0"
+	BlockTopic      = "blocks" // Joined as network.Topic(BlockTopic)
+	TxTopic         = "txs"
+	DiscoveryTag    = "lyrion"
 	
 	// Timeouts
 	DiscoveryInterval = 10 * time.Second
@@ -41,49 +44,116 @@ type P2PNode struct {
 	
 	// Callbacks for received data
 	onBlock        func(*core.Block)
-	onTransaction  func(*core.Transaction)
+	onTransaction  func(*core.Transaction) error // Admits the tx, only admitted txs are relayed
+	txSeen         *seenCache                   // Txs already gossiped or received
 	
 	// Peer tracking
 	peers          map[peer.ID]bool
 	peersMu        sync.RWMutex
+	disconnects    uint64
+	scores         map[peer.ID]float64 // Last GossipSub scores
+	
+	// Spam protection (see peers.go)
+	bans           *BanList
+	strikes        strikes
+	blockLimiter   *peerLimiter
+	txLimiter      *peerLimiter
+	requestLimiter *peerLimiter
+	
+	// Configured peers: static ones are kept connected, trusted ones are
+	// never banned or trimmed
+	static         []peer.AddrInfo
+	trusted        map[peer.ID]bool
+	
+	// Chain served to peers by the sync protocols (see protocol.go)
+	chain          ChainReader
+	chainMu        sync.RWMutex
+	network        Network        // Chain ID and genesis, every protocol ID and topic derives from it
+	sequencer      common.Address // Gossiped blocks must be sealed by it
 	
 	ctx            context.Context
 	cancel         context.CancelFunc
 }
 
-// BlockMessage represents a block broadcast over P2P
+// BlockMessage represents a block broadcast over P2P (encoding in wire.go).
+// The sending peer is known from pubsub, it isn't part of the message.
 type BlockMessage struct {
-	Block     *core.Block `json:"block"`
-	Sender    string      `json:"sender"`
-	Timestamp int64       `json:"timestamp"`
-}
-
-// TxMessage represents a transaction broadcast over P2P
-type TxMessage struct {
-	Tx        *core.Transaction `json:"tx"`
-	Sender    string            `json:"sender"`
-	Timestamp int64             `json:"timestamp"`
+	ChainID uint64
+	Block   *core.Block
 }
 
 // Config for P2P node
 type P2PConfig struct {
 	ListenPort     int
-	BootstrapPeers []string
+	BootstrapPeers []string // Multiaddrs dialled at startup to join the network
+	StaticPeers    []string // Multiaddrs kept connected, redialled when they drop
+	TrustedPeers   []string // Multiaddrs or peer IDs never banned or trimmed
 	EnableMDNS     bool // Local network discovery
+	ChainID        uint64
+	GenesisHash    common.Hash // With ChainID, isolates the network (see network.go)
+	Sequencer      common.Address // Gossiped blocks must be sealed by it
+	DataDir        string // Node key and ban list location (empty: neither is persisted)
+	MinPeers       int    // Connection manager watermarks (0: defaults)
+	MaxPeers       int
 }
 
 // NewP2PNode creates a new P2P network node
 func NewP2PNode(cfg *P2PConfig) (*P2PNode, error) {
+	keyPath, banPath := "", ""
+	if cfg.DataDir != "" {
+		keyPath = filepath.Join(cfg.DataDir, NodeKeyFile)
+		banPath = filepath.Join(cfg.DataDir, BanListFile)
+	}
+	key, err := LoadNodeKey(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load node key: %w", err)
+	}
+	bans, err := LoadBanList(banPath)
+	if err != nil {
+		return nil, err
+	}
+	
+	static, err := ParsePeers(cfg.StaticPeers, false)
+	if err != nil {
+		return nil, fmt.Errorf("static peers: %w", err)
+	}
+	trustedInfos, err := ParsePeers(cfg.TrustedPeers, true)
+	if err != nil {
+		return nil, fmt.Errorf("trusted peers: %w", err)
+	}
+	trusted := make(map[peer.ID]bool, len(trustedInfos))
+	for _, info := range trustedInfos {
+		trusted[info.ID] = true
+	}
+	
+	// Keep between MinPeers and MaxPeers connections
+	minPeers, maxPeers := cfg.MinPeers, cfg.MaxPeers
+	if minPeers <= 0 {
+		minPeers = DefaultMinPeers
+	}
+	if maxPeers <= minPeers {
+		maxPeers = max(DefaultMaxPeers, 2*minPeers)
+	}
+	cm, err := connmgr.NewConnManager(minPeers, maxPeers, connmgr.WithGracePeriod(time.Minute))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection manager: %w", err)
+	}
+	
+	nw := Network{ChainID: cfg.ChainID, Genesis: cfg.GenesisHash}
+	
 	ctx, cancel := context.WithCancel(context.Background())
 	
 	// Create libp2p host
 	opts := []libp2p.Option{
+		libp2p.Identity(key), // Stable peer ID across restarts
 		libp2p.ListenAddrStrings(
 			fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", cfg.ListenPort),
 			fmt.Sprintf("/ip4/0.0.0.0/udp/%d/quic-v1", cfg.ListenPort),
 		),
 		libp2p.EnableNATService(),
 		libp2p.EnableRelay(),
+		libp2p.ConnectionManager(cm),
+		libp2p.ConnectionGater(&banGater{bans: bans, trusted: trusted}), // Banned peers can't connect
 	}
 	
 	h, err := libp2p.New(opts...)
@@ -96,9 +166,10 @@ func NewP2PNode(cfg *P2PConfig) (*P2PNode, error) {
 	for _, addr := range h.Addrs() {
 		log.Printf("   Listening on: %s/p2p/%s", addr.String(), h.ID().String())
 	}
+	log.Printf("   Network: %s", nw)
 	
-	// Create DHT for peer discovery
-	kdht, err := dht.New(ctx, h, dht.Mode(dht.ModeServer))
+	// Create DHT for peer discovery, private to the network
+	kdht, err := dht.New(ctx, h, dht.Mode(dht.ModeServer), dht.ProtocolPrefix(nw.DHTPrefix()))
 	if err != nil {
 		h.Close()
 		cancel()
@@ -112,23 +183,77 @@ func NewP2PNode(cfg *P2PConfig) (*P2PNode, error) {
 		return nil, fmt.Errorf("failed to bootstrap DHT: %w", err)
 	}
 	
-	// Create pubsub for message propagation
-	ps, err := pubsub.NewGossipSub(ctx, h)
+	node := &P2PNode{
+		host:           h,
+		dht:            kdht,
+		peers:          make(map[peer.ID]bool),
+		scores:         make(map[peer.ID]float64),
+		bans:           bans,
+		strikes:        strikes{counts: make(map[peer.ID][]time.Time)},
+		blockLimiter:   newPeerLimiter(blockRateLimit, blockRateBurst),
+		txLimiter:      newPeerLimiter(txRateLimit, txRateBurst),
+		requestLimiter: newPeerLimiter(requestRateLimit, requestRateBurst),
+		txSeen:         newSeenCache(txSeenCacheSize),
+		network:        nw,
+		sequencer:      cfg.Sequencer,
+		static:         static,
+		trusted:        trusted,
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+	node.trackPeers()
+	
+	// The connection manager never trims static and trusted peers
+	for _, info := range static {
+		h.Peerstore().AddAddrs(info.ID, info.Addrs, peerstore.PermanentAddrTTL)
+		cm.Protect(info.ID, "static")
+	}
+	for _, info := range trustedInfos {
+		h.Peerstore().AddAddrs(info.ID, info.Addrs, peerstore.PermanentAddrTTL)
+		cm.Protect(info.ID, "trusted")
+	}
+	
+	// Create pubsub for message propagation. Message IDs are content
+	// hashes, so the same tx published by several nodes is only relayed once.
+	// Peers are scored on what they deliver (see score.go).
+	ps, err := pubsub.NewGossipSub(ctx, h,
+		pubsub.WithMaxMessageSize(MaxGossipSize),
+		pubsub.WithMessageIdFn(messageID),
+		pubsub.WithPeerScore(peerScoreParams(nw.Topic(BlockTopic), nw.Topic(TxTopic), node.appScore), peerScoreThresholds),
+		pubsub.WithPeerScoreInspect(node.inspectScores, 10*time.Second),
+	)
 	if err != nil {
 		h.Close()
 		cancel()
 		return nil, fmt.Errorf("failed to create pubsub: %w", err)
 	}
+	node.pubsub = ps
+	
+	// Drop blocks not sealed by the sequencer and txs the node doesn't admit
+	// before they are relayed
+	if err := ps.RegisterTopicValidator(nw.Topic(BlockTopic), node.validateBlock); err != nil {
+		h.Close()
+		cancel()
+		return nil, fmt.Errorf("failed to register block validator: %w", err)
+	}
+	if err := ps.RegisterTopicValidator(nw.Topic(TxTopic), node.validateTx); err != nil {
+		h.Close()
+		cancel()
+		return nil, fmt.Errorf("failed to register tx validator: %w", err)
+	}
+	if cfg.Sequencer == (common.Address{}) {
+		log.Printf("⚠️ No sequencer address: gossiped blocks are ignored")
+	}
 	
 	// Join topics
-	blockTopic, err := ps.Join(BlockTopic)
+	node.blockTopic, err = ps.Join(nw.Topic(BlockTopic))
 	if err != nil {
 		h.Close()
 		cancel()
 		return nil, fmt.Errorf("failed to join block topic: %w", err)
 	}
 	
-	txTopic, err := ps.Join(TxTopic)
+	node.txTopic, err = ps.Join(nw.Topic(TxTopic))
 	if err != nil {
 		h.Close()
 		cancel()
@@ -136,32 +261,21 @@ func NewP2PNode(cfg *P2PConfig) (*P2PNode, error) {
 	}
 	
 	// Subscribe to topics
-	blockSub, err := blockTopic.Subscribe()
+	node.blockSub, err = node.blockTopic.Subscribe()
 	if err != nil {
 		h.Close()
 		cancel()
 		return nil, fmt.Errorf("failed to subscribe to blocks: %w", err)
 	}
 	
-	txSub, err := txTopic.Subscribe()
+	node.txSub, err = node.txTopic.Subscribe()
 	if err != nil {
 		h.Close()
 		cancel()
 		return nil, fmt.Errorf("failed to subscribe to txs: %w", err)
 	}
 	
-	node := &P2PNode{
-		host:       h,
-		dht:        kdht,
-		pubsub:     ps,
-		blockTopic: blockTopic,
-		txTopic:    txTopic,
-		blockSub:   blockSub,
-		txSub:      txSub,
-		peers:      make(map[peer.ID]bool),
-		ctx:        ctx,
-		cancel:     cancel,
-	}
+	node.registerProtocols()
 	
 	// Connect to bootstrap peers
 	for _, peerAddr := range cfg.BootstrapPeers {
@@ -177,6 +291,7 @@ func NewP2PNode(cfg *P2PConfig) (*P2PNode, error) {
 	
 	// Start discovery routine
 	go node.discoverPeers()
+	go node.maintainStaticPeers()
 	
 	return node, nil
 }
@@ -186,8 +301,9 @@ func (n *P2PNode) SetBlockHandler(handler func(*core.Block)) {
 	n.onBlock = handler
 }
 
-// SetTxHandler sets the callback for received transactions
-func (n *P2PNode) SetTxHandler(handler func(*core.Transaction)) {
+// SetTxHandler sets the callback for received transactions. It runs while
+// the tx is validated: txs it returns an error for are not relayed.
+func (n *P2PNode) SetTxHandler(handler func(*core.Transaction) error) {
 	n.onTransaction = handler
 }
 
@@ -200,13 +316,7 @@ func (n *P2PNode) Start() {
 
 // BroadcastBlock broadcasts a new block to the network
 func (n *P2PNode) BroadcastBlock(block *core.Block) error {
-	msg := BlockMessage{
-		Block:     block,
-		Sender:    n.host.ID().String(),
-		Timestamp: time.Now().Unix(),
-	}
-	
-	data, err := json.Marshal(msg)
+	data, err := EncodeBlockMessage(n.network.ChainID, block)
 	if err != nil {
 		return fmt.Errorf("failed to marshal block: %w", err)
 	}
@@ -219,15 +329,14 @@ func (n *P2PNode) BroadcastBlock(block *core.Block) error {
 	return nil
 }
 
-// BroadcastTransaction broadcasts a transaction to the network
+// BroadcastTransaction broadcasts a transaction to the network. Txs already
+// gossiped or received from a peer are skipped.
 func (n *P2PNode) BroadcastTransaction(tx *core.Transaction) error {
-	msg := TxMessage{
-		Tx:        tx,
-		Sender:    n.host.ID().String(),
-		Timestamp: time.Now().Unix(),
+	if !n.txSeen.add(tx.Hash()) {
+		return nil
 	}
 	
-	data, err := json.Marshal(msg)
+	data, err := EncodeTxMessage(tx)
 	if err != nil {
 		return fmt.Errorf("failed to marshal tx: %w", err)
 	}
@@ -256,9 +365,9 @@ func (n *P2PNode) handleBlocks() {
 			continue
 		}
 		
-		var blockMsg BlockMessage
-		if err := json.Unmarshal(msg.Data, &blockMsg); err != nil {
-			log.Printf("Failed to unmarshal block message: %v", err)
+		// Decoded and checked by validateBlock
+		blockMsg, ok := msg.ValidatorData.(*BlockMessage)
+		if !ok {
 			continue
 		}
 		
@@ -270,30 +379,15 @@ func (n *P2PNode) handleBlocks() {
 	}
 }
 
-// handleTransactions processes incoming transaction messages
+// handleTransactions drains the tx subscription. Received txs were already
+// handed to the tx handler by validateTx, before being relayed.
 func (n *P2PNode) handleTransactions() {
 	for {
-		msg, err := n.txSub.Next(n.ctx)
-		if err != nil {
+		if _, err := n.txSub.Next(n.ctx); err != nil {
 			if n.ctx.Err() != nil {
 				return
 			}
 			log.Printf("Error receiving tx: %v", err)
-			continue
-		}
-		
-		if msg.ReceivedFrom == n.host.ID() {
-			continue
-		}
-		
-		var txMsg TxMessage
-		if err := json.Unmarshal(msg.Data, &txMsg); err != nil {
-			log.Printf("Failed to unmarshal tx message: %v", err)
-			continue
-		}
-		
-		if n.onTransaction != nil {
-			n.onTransaction(txMsg.Tx)
 		}
 	}
 }
@@ -320,6 +414,7 @@ func (n *P2PNode) connectToPeer(addrStr string) error {
 // discoverPeers continuously discovers new peers
 func (n *P2PNode) discoverPeers() {
 	routingDiscovery := drouting.NewRoutingDiscovery(n.dht)
+	tag := n.network.DiscoveryTag()
 	
 	ticker := time.NewTicker(DiscoveryInterval)
 	defer ticker.Stop()
@@ -330,13 +425,13 @@ func (n *P2PNode) discoverPeers() {
 			return
 		case <-ticker.C:
 			// Advertise ourselves
-			_, err := routingDiscovery.Advertise(n.ctx, DiscoveryTag)
+			_, err := routingDiscovery.Advertise(n.ctx, tag)
 			if err != nil {
 				continue
 			}
 			
 			// Find peers
-			peerChan, err := routingDiscovery.FindPeers(n.ctx, DiscoveryTag)
+			peerChan, err := routingDiscovery.FindPeers(n.ctx, tag)
 			if err != nil {
 				continue
 			}
@@ -365,7 +460,7 @@ func (n *P2PNode) discoverPeers() {
 
 // setupMDNS sets up local network discovery
 func (n *P2PNode) setupMDNS() {
-	s := mdns.NewMdnsService(n.host, DiscoveryTag, &mdnsNotifee{node: n})
+	s := mdns.NewMdnsService(n.host, n.network.DiscoveryTag(), &mdnsNotifee{node: n})
 	if err := s.Start(); err != nil {
 		log.Printf("⚠️ mDNS setup failed: %v", err)
 	} else {
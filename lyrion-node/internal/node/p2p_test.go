@@ -0,0 +1,119 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+// Helpers for the tests that run P2P nodes in process, connected over
+// loopback.
+
+const testChainID = 42069
+
+var testGenesis = crypto.Keccak256Hash([]byte("lyrion test genesis"))
+
+// newTestNode starts a P2P node on a random port. cfg may be nil for the
+// test network's defaults.
+func newTestNode(t *testing.T, cfg *P2PConfig) *P2PNode {
+	t.Helper()
+	if cfg == nil {
+		cfg = &P2PConfig{ChainID: testChainID, GenesisHash: testGenesis}
+	}
+	n, err := NewP2PNode(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { n.Close() })
+	return n
+}
+
+// connect dials b from a.
+func connect(t *testing.T, a, b *P2PNode) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := a.host.Connect(ctx, peer.AddrInfo{ID: b.host.ID(), Addrs: b.host.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitFor polls cond until it holds or the timeout passes.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// testChain is an in-memory ChainReader. Blocks can be appended with add,
+// which is an importBlock for the SyncManager.
+type testChain struct {
+	mu     sync.Mutex
+	blocks []*core.Block // blocks[i] is block #i+1
+}
+
+// newTestChain returns a chain of n linked blocks.
+func newTestChain(n int) *testChain {
+	c := &testChain{}
+	var parent common.Hash
+	for i := 1; i <= n; i++ {
+		block := core.NewBlock(&core.Header{ParentHash: parent, Number: uint64(i), Time: uint64(1000 + i)}, nil)
+		c.blocks = append(c.blocks, block)
+		parent = block.Header.Hash()
+	}
+	return c
+}
+
+func (c *testChain) CurrentHeight() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return uint64(len(c.blocks)) + 1
+}
+
+func (c *testChain) GetBlock(number uint64) *core.Block {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if number == 0 || number > uint64(len(c.blocks)) {
+		return nil
+	}
+	return c.blocks[number-1]
+}
+
+func (c *testChain) GetBlockByHash(hash common.Hash) *core.Block {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, block := range c.blocks {
+		if block.Header.Hash() == hash {
+			return block
+		}
+	}
+	return nil
+}
+
+func (c *testChain) add(block *core.Block) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	number := block.Header.Number
+	switch {
+	case number <= uint64(len(c.blocks)):
+		return nil
+	case number != uint64(len(c.blocks))+1:
+		return fmt.Errorf("block #%d is not next", number)
+	case number > 1 && block.Header.ParentHash != c.blocks[number-2].Header.Hash():
+		return fmt.Errorf("block #%d has the wrong parent", number)
+	}
+	c.blocks = append(c.blocks, block)
+	return nil
+}
@@ -0,0 +1,461 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"golang.org/x/time/rate"
+)
+
+const (
+	DefaultMinPeers = 32 // Connection manager low watermark
+	DefaultMaxPeers = 64 // Connection manager high watermark: trimmed back to the low one
+
+	BanListFile        = "p2p-bans.json"
+	DefaultBanDuration = 24 * time.Hour
+
+	// A peer is banned after this many invalid messages or requests within
+	// strikeWindow
+	maxStrikes   = 10
+	strikeWindow = time.Hour
+
+	StaticPeerInterval = 15 * time.Second // How often dropped static peers are redialled
+)
+
+// Per-peer message rate limits. Messages over the limit are dropped without
+// penalty: a busy but honest peer relays many txs.
+var (
+	blockRateLimit   = rate.Limit(10) // Blocks per second (burst blockRateBurst)
+	blockRateBurst   = 50
+	txRateLimit      = rate.Limit(100)
+	txRateBurst      = 500
+	requestRateLimit = rate.Limit(10) // Sync protocol requests
+	requestRateBurst = 40
+)
+
+// Ban is an entry of the ban list.
+type Ban struct {
+	Until  time.Time `json:"until"`
+	Reason string    `json:"reason"`
+}
+
+// BanList holds the banned peers. It is saved to a JSON file on every change
+// so bans survive restarts; the file can be edited while the node is down.
+type BanList struct {
+	path string // Empty: not persisted
+	bans map[peer.ID]Ban
+	mu   sync.RWMutex
+}
+
+// LoadBanList reads the ban list at path (a missing file is an empty list).
+func LoadBanList(path string) (*BanList, error) {
+	b := &BanList{path: path, bans: make(map[peer.ID]Ban)}
+	if path == "" {
+		return b, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return b, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var stored map[string]Ban
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("invalid ban list %s: %v", path, err)
+	}
+	for id, ban := range stored {
+		p, err := peer.Decode(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer ID %q in ban list %s: %v", id, path, err)
+		}
+		if time.Now().Before(ban.Until) {
+			b.bans[p] = ban
+		}
+	}
+	return b, nil
+}
+
+// Ban bans p for d.
+func (b *BanList) Ban(p peer.ID, d time.Duration, reason string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bans[p] = Ban{Until: time.Now().Add(d), Reason: reason}
+	return b.save()
+}
+
+// Unban lifts the ban of p.
+func (b *BanList) Unban(p peer.ID) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.bans[p]; !ok {
+		return nil
+	}
+	delete(b.bans, p)
+	return b.save()
+}
+
+// IsBanned reports whether p is currently banned.
+func (b *BanList) IsBanned(p peer.ID) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	ban, ok := b.bans[p]
+	return ok && time.Now().Before(ban.Until)
+}
+
+// List returns the active bans by peer ID.
+func (b *BanList) List() map[string]Ban {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	list := make(map[string]Ban, len(b.bans))
+	for p, ban := range b.bans {
+		if time.Now().Before(ban.Until) {
+			list[p.String()] = ban
+		}
+	}
+	return list
+}
+
+// save writes the active bans; the caller holds the lock.
+func (b *BanList) save() error {
+	if b.path == "" {
+		return nil
+	}
+	stored := make(map[string]Ban, len(b.bans))
+	for p, ban := range b.bans {
+		if time.Now().Before(ban.Until) {
+			stored[p.String()] = ban
+		}
+	}
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(b.path), 0700); err != nil {
+		return err
+	}
+	tmp := b.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, b.path)
+}
+
+// banGater refuses connections to and from banned peers. Trusted peers are
+// never refused.
+type banGater struct {
+	bans    *BanList
+	trusted map[peer.ID]bool
+}
+
+func (g *banGater) allow(p peer.ID) bool {
+	return g.trusted[p] || !g.bans.IsBanned(p)
+}
+
+func (g *banGater) InterceptPeerDial(p peer.ID) bool {
+	return g.allow(p)
+}
+
+func (g *banGater) InterceptAddrDial(p peer.ID, _ multiaddr.Multiaddr) bool {
+	return g.allow(p)
+}
+
+func (g *banGater) InterceptAccept(network.ConnMultiaddrs) bool {
+	return true // Peer ID not known yet, checked in InterceptSecured
+}
+
+func (g *banGater) InterceptSecured(_ network.Direction, p peer.ID, _ network.ConnMultiaddrs) bool {
+	return g.allow(p)
+}
+
+func (g *banGater) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
+
+// peerLimiter is a token bucket per peer.
+type peerLimiter struct {
+	limit    rate.Limit
+	burst    int
+	limiters map[peer.ID]*rate.Limiter
+	mu       sync.Mutex
+}
+
+func newPeerLimiter(limit rate.Limit, burst int) *peerLimiter {
+	return &peerLimiter{limit: limit, burst: burst, limiters: make(map[peer.ID]*rate.Limiter)}
+}
+
+// allow takes a token from p's bucket.
+func (l *peerLimiter) allow(p peer.ID) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	lim, ok := l.limiters[p]
+	if !ok {
+		lim = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[p] = lim
+	}
+	return lim.Allow()
+}
+
+func (l *peerLimiter) forget(p peer.ID) {
+	l.mu.Lock()
+	delete(l.limiters, p)
+	l.mu.Unlock()
+}
+
+// strikes counts the misbehaviour of peers within strikeWindow.
+type strikes struct {
+	counts map[peer.ID][]time.Time
+	mu     sync.Mutex
+}
+
+// add records a strike and returns how many p has in the window.
+func (s *strikes) add(p peer.ID) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cutoff := time.Now().Add(-strikeWindow)
+	recent := s.counts[p][:0]
+	for _, t := range s.counts[p] {
+		if t.After(cutoff) {
+			recent = append(recent, t)
+		}
+	}
+	recent = append(recent, time.Now())
+	s.counts[p] = recent
+	return len(recent)
+}
+
+// trim drops the peers whose strikes are all older than the window. Strikes
+// outlive the connection (a peer can't clear them by reconnecting), so they
+// are trimmed by age rather than on disconnect.
+func (s *strikes) trim() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cutoff := time.Now().Add(-strikeWindow)
+	for p, times := range s.counts {
+		if len(times) == 0 || !times[len(times)-1].After(cutoff) {
+			delete(s.counts, p)
+		}
+	}
+}
+
+func (s *strikes) forget(p peer.ID) {
+	s.mu.Lock()
+	delete(s.counts, p)
+	s.mu.Unlock()
+}
+
+// count returns the strikes of p within the window.
+func (s *strikes) count(p peer.ID) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cutoff := time.Now().Add(-strikeWindow)
+	n := 0
+	for _, t := range s.counts[p] {
+		if t.After(cutoff) {
+			n++
+		}
+	}
+	return n
+}
+
+// penalize records a strike against p and bans it once it reaches
+// maxStrikes. Trusted peers are never banned.
+func (n *P2PNode) penalize(p peer.ID, reason string) {
+	if n.strikes.add(p) < maxStrikes || n.trusted[p] {
+		return
+	}
+	if err := n.BanPeer(p, DefaultBanDuration, reason); err != nil {
+		log.Printf("⚠️ P2P: Failed to save ban of %s: %v", shortID(p), err)
+	}
+}
+
+// appScore is the application part of a peer's GossipSub score: each
+// strike (invalid message or request) costs 10.
+func (n *P2PNode) appScore(p peer.ID) float64 {
+	return -10 * float64(n.strikes.count(p))
+}
+
+// inspectScores records the GossipSub scores and bans the peers that got
+// graylisted, so they can't simply wait for their score to decay.
+func (n *P2PNode) inspectScores(scores map[peer.ID]float64) {
+	n.peersMu.Lock()
+	n.scores = maps.Clone(scores) // Trimmed on disconnect while we iterate scores
+	n.peersMu.Unlock()
+
+	for p, score := range scores {
+		if score <= peerScoreThresholds.GraylistThreshold && !n.trusted[p] && !n.bans.IsBanned(p) {
+			go func(p peer.ID, score float64) {
+				if err := n.BanPeer(p, DefaultBanDuration, fmt.Sprintf("gossip score %.0f", score)); err != nil {
+					log.Printf("⚠️ P2P: Failed to save ban of %s: %v", shortID(p), err)
+				}
+			}(p, score)
+		}
+	}
+}
+
+// BanPeer disconnects p and refuses its connections for d. The ban is
+// saved to the ban list.
+func (n *P2PNode) BanPeer(p peer.ID, d time.Duration, reason string) error {
+	err := n.bans.Ban(p, d, reason)
+	n.host.Network().ClosePeer(p)
+	log.Printf("🚫 P2P: Banned peer %s for %s: %s", shortID(p), d, reason)
+	return err
+}
+
+// UnbanPeer lifts the ban of p.
+func (n *P2PNode) UnbanPeer(p peer.ID) error {
+	n.strikes.forget(p)
+	return n.bans.Unban(p)
+}
+
+// trackPeers keeps the peer set in line with the open connections. New
+// peers go through the status handshake, which drops those of another
+// network.
+func (n *P2PNode) trackPeers() {
+	n.host.Network().Notify(&network.NotifyBundle{
+		ConnectedF: func(_ network.Network, c network.Conn) {
+			p := c.RemotePeer()
+			n.peersMu.Lock()
+			known := n.peers[p]
+			n.peers[p] = true
+			n.peersMu.Unlock()
+			if !known {
+				go n.handshake(p)
+			}
+		},
+		DisconnectedF: func(net network.Network, c network.Conn) {
+			p := c.RemotePeer()
+			if net.Connectedness(p) == network.Connected {
+				return // Another connection is still open
+			}
+			n.peersMu.Lock()
+			_, known := n.peers[p]
+			delete(n.peers, p)
+			delete(n.scores, p)
+			if known {
+				n.disconnects++
+			}
+			n.peersMu.Unlock()
+			n.blockLimiter.forget(p)
+			n.txLimiter.forget(p)
+			n.requestLimiter.forget(p)
+			n.strikes.trim()
+			if known {
+				log.Printf("👋 Peer disconnected: %s", shortID(p))
+			}
+		},
+	})
+}
+
+// ParsePeers parses peer multiaddrs (with their /p2p/ peer ID), merging the
+// addresses of the same peer. If allowIDs is set, bare peer IDs are accepted
+// too: trusted peers don't need an address.
+func ParsePeers(list []string, allowIDs bool) ([]peer.AddrInfo, error) {
+	var infos []peer.AddrInfo
+	index := make(map[peer.ID]int)
+	for _, s := range list {
+		var info peer.AddrInfo
+		if id, err := peer.Decode(s); err == nil && allowIDs {
+			info.ID = id
+		} else {
+			addr, err := peer.AddrInfoFromString(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid peer address %q: %v", s, err)
+			}
+			info = *addr
+		}
+		if i, ok := index[info.ID]; ok {
+			infos[i].Addrs = append(infos[i].Addrs, info.Addrs...)
+			continue
+		}
+		index[info.ID] = len(infos)
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (n *P2PNode) isStatic(p peer.ID) bool {
+	for _, info := range n.static {
+		if info.ID == p {
+			return true
+		}
+	}
+	return false
+}
+
+// maintainStaticPeers keeps the node connected to its static peers, redialling
+// the ones that dropped every StaticPeerInterval. Redials skip the dial
+// backoff, so a static peer is back within one interval of restarting.
+func (n *P2PNode) maintainStaticPeers() {
+	if len(n.static) == 0 {
+		return
+	}
+	ticker := time.NewTicker(StaticPeerInterval)
+	defer ticker.Stop()
+
+	down := make(map[peer.ID]bool)
+	for {
+		for _, info := range n.static {
+			if n.host.Network().Connectedness(info.ID) == network.Connected {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(network.WithForceDirectDial(n.ctx, "static peer"), RequestTimeout)
+			err := n.host.Connect(ctx, info)
+			cancel()
+			switch {
+			case n.ctx.Err() != nil:
+				return
+			case err != nil && !down[info.ID]:
+				log.Printf("⚠️ P2P: Static peer %s unreachable: %v", shortID(info.ID), err)
+				down[info.ID] = true
+			case err == nil:
+				log.Printf("🔁 P2P: Connected to static peer %s", shortID(info.ID))
+				delete(down, info.ID)
+			}
+		}
+
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetStats returns P2P statistics: connected peers with their scores,
+// disconnects and active bans.
+func (n *P2PNode) GetStats() map[string]interface{} {
+	n.peersMu.RLock()
+	defer n.peersMu.RUnlock()
+
+	peers := make([]map[string]interface{}, 0, len(n.peers))
+	for p := range n.peers {
+		peers = append(peers, map[string]interface{}{
+			"id":      p.String(),
+			"score":   n.scores[p],
+			"strikes": n.strikes.count(p),
+			"static":  n.isStatic(p),
+			"trusted": n.trusted[p],
+		})
+	}
+	return map[string]interface{}{
+		"id":          n.host.ID().String(),
+		"addrs":       n.Addrs(),
+		"peerCount":   len(n.peers),
+		"peers":       peers,
+		"disconnects": n.disconnects,
+		"bans":        n.bans.List(),
+	}
+}
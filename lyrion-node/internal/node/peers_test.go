@@ -0,0 +1,195 @@
+package node
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/time/rate"
+)
+
+func newPeerID(t *testing.T) peer.ID {
+	t.Helper()
+	key, _, err := crypto.GenerateEd25519Key(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func TestPeerLimiter(t *testing.T) {
+	l := newPeerLimiter(rate.Limit(0.001), 3)
+	a, b := newPeerID(t), newPeerID(t)
+	for i := 0; i < 3; i++ {
+		if !l.allow(a) {
+			t.Fatalf("message %d within the burst refused", i+1)
+		}
+	}
+	if l.allow(a) {
+		t.Fatal("message over the burst allowed")
+	}
+	if !l.allow(b) {
+		t.Fatal("another peer shares the bucket")
+	}
+	l.forget(a)
+	if !l.allow(a) {
+		t.Fatal("forgotten peer still limited")
+	}
+}
+
+func TestBanListPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), BanListFile)
+	bans, err := LoadBanList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	banned, expired, unbanned := newPeerID(t), newPeerID(t), newPeerID(t)
+	for _, p := range []peer.ID{banned, expired, unbanned} {
+		if err := bans.Ban(p, time.Hour, "spam"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bans.Ban(expired, -time.Second, "old")
+	if err := bans.Unban(unbanned); err != nil {
+		t.Fatal(err)
+	}
+	if !bans.IsBanned(banned) || bans.IsBanned(expired) || bans.IsBanned(unbanned) {
+		t.Fatal("ban list does not reflect the bans")
+	}
+
+	bans, err = LoadBanList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := bans.List()
+	if len(list) != 1 || list[banned.String()].Reason != "spam" || !bans.IsBanned(banned) {
+		t.Fatalf("reloaded bans %v, want only %s", list, banned)
+	}
+
+	// The file is operator-editable, and checked on load
+	if err := os.WriteFile(path, []byte(`{"not-a-peer": {"until": "2100-01-01T00:00:00Z"}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadBanList(path); err == nil {
+		t.Fatal("ban list with an invalid peer ID loaded")
+	}
+
+	// Without a path nothing is written
+	bans, _ = LoadBanList("")
+	if err := bans.Ban(banned, time.Hour, "spam"); err != nil || !bans.IsBanned(banned) {
+		t.Fatalf("in-memory ban: %v", err)
+	}
+}
+
+func TestStrikes(t *testing.T) {
+	var s strikes
+	s.counts = make(map[peer.ID][]time.Time)
+	p, old := newPeerID(t), newPeerID(t)
+	for i := 1; i <= 3; i++ {
+		if n := s.add(p); n != i {
+			t.Fatalf("strike %d counted as %d", i, n)
+		}
+	}
+
+	// Strikes older than the window no longer count, and go on trim
+	s.counts[old] = []time.Time{time.Now().Add(-2 * strikeWindow)}
+	s.counts[p] = append([]time.Time{time.Now().Add(-2 * strikeWindow)}, s.counts[p]...)
+	if s.count(p) != 3 || s.count(old) != 0 {
+		t.Fatalf("%d and %d strikes in the window, want 3 and 0", s.count(p), s.count(old))
+	}
+	if n := s.add(old); n != 1 {
+		t.Fatalf("strike after an expired one counted as %d", n)
+	}
+	s.counts[old] = []time.Time{time.Now().Add(-2 * strikeWindow)}
+	s.trim()
+	if _, ok := s.counts[old]; ok {
+		t.Fatal("expired strikes not trimmed")
+	}
+	if _, ok := s.counts[p]; !ok {
+		t.Fatal("recent strikes trimmed")
+	}
+}
+
+func TestPenalizeBans(t *testing.T) {
+	dir := t.TempDir()
+	trusted := newPeerID(t)
+	n := newTestNode(t, &P2PConfig{ChainID: testChainID, GenesisHash: testGenesis, DataDir: dir, TrustedPeers: []string{trusted.String()}})
+	p := newPeerID(t)
+
+	for i := 1; i < maxStrikes; i++ {
+		n.penalize(p, "invalid block")
+		n.penalize(trusted, "invalid block")
+	}
+	if n.bans.IsBanned(p) {
+		t.Fatalf("banned after %d strikes", maxStrikes-1)
+	}
+	if score := n.appScore(p); score != -10*float64(maxStrikes-1) {
+		t.Fatalf("app score %v after %d strikes", score, maxStrikes-1)
+	}
+	n.penalize(p, "invalid block")
+	n.penalize(trusted, "invalid block")
+	if !n.bans.IsBanned(p) {
+		t.Fatalf("not banned after %d strikes", maxStrikes)
+	}
+	if n.bans.IsBanned(trusted) {
+		t.Fatal("trusted peer banned")
+	}
+
+	// The ban was saved for the next start
+	bans, err := LoadBanList(filepath.Join(dir, BanListFile))
+	if err != nil || !bans.IsBanned(p) {
+		t.Fatalf("ban not persisted (%v)", err)
+	}
+
+	// Unbanning also clears the strikes
+	if err := n.UnbanPeer(p); err != nil {
+		t.Fatal(err)
+	}
+	if n.bans.IsBanned(p) || n.strikes.count(p) != 0 {
+		t.Fatal("unbanned peer keeps its ban or strikes")
+	}
+
+	// Graylisted peers are banned, trusted ones never
+	graylisted := newPeerID(t)
+	n.inspectScores(map[peer.ID]float64{graylisted: peerScoreThresholds.GraylistThreshold, trusted: 2 * peerScoreThresholds.GraylistThreshold, p: -1})
+	waitFor(t, "the graylisted peer to be banned", func() bool { return n.bans.IsBanned(graylisted) })
+	if n.bans.IsBanned(trusted) || n.bans.IsBanned(p) {
+		t.Fatal("trusted or well-scored peer banned on its gossip score")
+	}
+}
+
+// Banned peers can't connect either way, trusted ones always can.
+func TestBanGater(t *testing.T) {
+	a, b := newTestNode(t, nil), newTestNode(t, nil)
+	if err := a.BanPeer(b.host.ID(), time.Hour, "test"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	// Over QUIC the dialer may see the handshake complete before a refuses
+	// the connection, so only check that it doesn't stay up
+	b.host.Connect(ctx, peer.AddrInfo{ID: a.host.ID(), Addrs: a.host.Addrs()})
+	waitFor(t, "the banned peer's connection to drop", func() bool {
+		return b.host.Network().Connectedness(a.host.ID()) != network.Connected
+	})
+	if a.host.Network().Connectedness(b.host.ID()) == network.Connected || a.PeerCount() != 0 {
+		t.Fatal("banned peer connected")
+	}
+	if err := a.host.Connect(ctx, peer.AddrInfo{ID: b.host.ID(), Addrs: b.host.Addrs()}); err == nil {
+		t.Fatal("dialled a banned peer")
+	}
+
+	g := &banGater{bans: a.bans, trusted: map[peer.ID]bool{b.host.ID(): true}}
+	if !g.allow(b.host.ID()) {
+		t.Fatal("trusted peer refused for a ban")
+	}
+}
@@ -0,0 +1,305 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+// Request/response protocols, served on streams with the IDs
+// network.Protocol(name)
+const (
+	StatusProtocol        = "status"
+	BlocksByRangeProtocol = "blocks_by_range"
+	BlockByHashProtocol   = "block_by_hash"
+
+	MaxBlocksPerRequest = 64               // Blocks served per GetBlocksByRange request
+	maxRequestSize      = 1024             // Bytes read from a request
+	maxResponseSize     = 32 * 1024 * 1024 // Bytes read from a response
+	RequestTimeout      = 15 * time.Second
+)
+
+// ErrNoChain is returned by peers that don't serve blocks yet.
+var ErrNoChain = errors.New("peer does not serve blocks")
+
+// ChainReader is the local chain the sync protocols serve blocks from.
+// consensus.Sequencer implements it.
+type ChainReader interface {
+	CurrentHeight() uint64 // Next block number, head is CurrentHeight()-1
+	GetBlock(number uint64) *core.Block
+	GetBlockByHash(hash common.Hash) *core.Block
+}
+
+// Status is exchanged on StatusProtocol so peers can tell whether they are
+// on the same chain and who is ahead.
+type Status struct {
+	ChainID     uint64      `json:"chainId"`
+	GenesisHash common.Hash `json:"genesisHash"`
+	Head        uint64      `json:"head"` // Latest block number, 0 before the first block
+	HeadHash    common.Hash `json:"headHash"`
+}
+
+// BlocksByRangeRequest asks for up to Count blocks starting at Start.
+type BlocksByRangeRequest struct {
+	Start uint64 `json:"start"`
+	Count uint64 `json:"count"`
+}
+
+// BlockByHashRequest asks for the block with the given header hash.
+type BlockByHashRequest struct {
+	Hash common.Hash `json:"hash"`
+}
+
+// BlocksResponse answers block requests. Blocks are in ascending order and
+// stop at the first block the peer doesn't have.
+type BlocksResponse struct {
+	Blocks []*core.Block `json:"blocks"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// SetChain sets the chain served to peers and reported in status
+// exchanges. Until it is set, block requests are refused.
+func (n *P2PNode) SetChain(chain ChainReader) {
+	n.chainMu.Lock()
+	n.chain = chain
+	n.chainMu.Unlock()
+}
+
+func (n *P2PNode) getChain() ChainReader {
+	n.chainMu.RLock()
+	defer n.chainMu.RUnlock()
+	return n.chain
+}
+
+// registerProtocols installs the stream handlers of the sync protocols.
+func (n *P2PNode) registerProtocols() {
+	n.host.SetStreamHandler(n.network.Protocol(StatusProtocol), n.handleStatus)
+	n.host.SetStreamHandler(n.network.Protocol(BlocksByRangeProtocol), n.handleBlocksByRange)
+	n.host.SetStreamHandler(n.network.Protocol(BlockByHashProtocol), n.handleBlockByHash)
+}
+
+// LocalStatus returns the status this node reports to peers.
+func (n *P2PNode) LocalStatus() *Status {
+	status := &Status{ChainID: n.network.ChainID, GenesisHash: n.network.Genesis}
+	if chain := n.getChain(); chain != nil {
+		if height := chain.CurrentHeight(); height > 1 {
+			status.Head = height - 1
+			if head := chain.GetBlock(status.Head); head != nil {
+				status.HeadHash = head.Header.Hash()
+			}
+		}
+	}
+	return status
+}
+
+// handleStatus reads the peer's status and answers with ours. Peers of
+// another network are disconnected.
+func (n *P2PNode) handleStatus(s network.Stream) {
+	defer s.Close()
+	var remote Status
+	if !n.readRequest(s, &remote) {
+		return
+	}
+	if err := n.network.checkStatus(&remote); err != nil {
+		p := s.Conn().RemotePeer()
+		log.Printf("🚫 P2P: Disconnecting %s: %v", shortID(p), err)
+		s.Reset()
+		n.host.Network().ClosePeer(p)
+		return
+	}
+	if err := writeMessage(s, n.LocalStatus()); err != nil {
+		s.Reset()
+	}
+}
+
+func (n *P2PNode) handleBlocksByRange(s network.Stream) {
+	defer s.Close()
+	var req BlocksByRangeRequest
+	if !n.readRequest(s, &req) {
+		return
+	}
+	resp := &BlocksResponse{}
+	chain := n.getChain()
+	switch {
+	case chain == nil:
+		resp.Error = ErrNoChain.Error()
+	case req.Start == 0 || req.Count == 0:
+		resp.Error = "invalid range"
+	default:
+		count := req.Count
+		if count > MaxBlocksPerRequest {
+			count = MaxBlocksPerRequest
+		}
+		for i := req.Start; i < req.Start+count; i++ {
+			block := chain.GetBlock(i)
+			if block == nil {
+				break
+			}
+			resp.Blocks = append(resp.Blocks, block)
+		}
+	}
+	if err := writeMessage(s, resp); err != nil {
+		s.Reset()
+	}
+}
+
+func (n *P2PNode) handleBlockByHash(s network.Stream) {
+	defer s.Close()
+	var req BlockByHashRequest
+	if !n.readRequest(s, &req) {
+		return
+	}
+	resp := &BlocksResponse{}
+	if chain := n.getChain(); chain == nil {
+		resp.Error = ErrNoChain.Error()
+	} else if block := chain.GetBlockByHash(req.Hash); block != nil {
+		resp.Blocks = []*core.Block{block}
+	}
+	if err := writeMessage(s, resp); err != nil {
+		s.Reset()
+	}
+}
+
+// RequestStatus exchanges status with a peer.
+func (n *P2PNode) RequestStatus(ctx context.Context, p peer.ID) (*Status, error) {
+	var status Status
+	if err := n.request(ctx, p, n.network.Protocol(StatusProtocol), n.LocalStatus(), &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// RequestBlocksByRange asks a peer for up to count blocks starting at start.
+// The peer may return fewer, but never blocks outside the range.
+func (n *P2PNode) RequestBlocksByRange(ctx context.Context, p peer.ID, start, count uint64) ([]*core.Block, error) {
+	var resp BlocksResponse
+	if err := n.request(ctx, p, n.network.Protocol(BlocksByRangeProtocol), &BlocksByRangeRequest{Start: start, Count: count}, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("peer %s: %s", shortID(p), resp.Error)
+	}
+	if uint64(len(resp.Blocks)) > count {
+		return nil, fmt.Errorf("peer %s returned %d blocks for %d requested", shortID(p), len(resp.Blocks), count)
+	}
+	for i, block := range resp.Blocks {
+		if block == nil || block.Header == nil || block.Header.Number != start+uint64(i) {
+			return nil, fmt.Errorf("peer %s returned blocks out of range %d+%d", shortID(p), start, count)
+		}
+	}
+	return resp.Blocks, nil
+}
+
+// RequestBlockByHash asks a peer for a block by header hash. It returns nil
+// if the peer doesn't have it.
+func (n *P2PNode) RequestBlockByHash(ctx context.Context, p peer.ID, hash common.Hash) (*core.Block, error) {
+	var resp BlocksResponse
+	if err := n.request(ctx, p, n.network.Protocol(BlockByHashProtocol), &BlockByHashRequest{Hash: hash}, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("peer %s: %s", shortID(p), resp.Error)
+	}
+	if len(resp.Blocks) == 0 {
+		return nil, nil
+	}
+	block := resp.Blocks[0]
+	if len(resp.Blocks) > 1 || block == nil || block.Header == nil || block.Header.Hash() != hash {
+		return nil, fmt.Errorf("peer %s returned the wrong block for %s", shortID(p), hash.Hex())
+	}
+	return block, nil
+}
+
+// request sends req on a new stream and reads the response into resp.
+func (n *P2PNode) request(ctx context.Context, p peer.ID, proto protocol.ID, req, resp interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	s, err := n.host.NewStream(ctx, p, proto)
+	if err != nil {
+		return fmt.Errorf("failed to open %s stream: %w", proto, err)
+	}
+	defer s.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		s.SetDeadline(deadline)
+	}
+
+	if err := writeMessage(s, req); err != nil {
+		s.Reset()
+		return err
+	}
+	if err := s.CloseWrite(); err != nil {
+		s.Reset()
+		return err
+	}
+	data, err := io.ReadAll(io.LimitReader(s, maxResponseSize+1))
+	if err != nil {
+		s.Reset()
+		return fmt.Errorf("failed to read %s response: %w", proto, err)
+	}
+	if len(data) > maxResponseSize {
+		s.Reset()
+		return fmt.Errorf("%s response exceeds %d bytes", proto, maxResponseSize)
+	}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return fmt.Errorf("invalid %s response: %w", proto, err)
+	}
+	return nil
+}
+
+// readRequest reads a bounded request from a stream the remote has
+// half-closed. Requests over the peer's rate limit are refused, invalid ones
+// count against the peer. It resets the stream on failure.
+func (n *P2PNode) readRequest(s network.Stream, v interface{}) bool {
+	p := s.Conn().RemotePeer()
+	if !n.requestLimiter.allow(p) {
+		s.Reset()
+		return false
+	}
+	s.SetDeadline(time.Now().Add(RequestTimeout))
+	data, err := io.ReadAll(io.LimitReader(s, maxRequestSize+1))
+	if err != nil {
+		s.Reset()
+		return false
+	}
+	if len(data) > maxRequestSize {
+		log.Printf("⚠️ P2P: Oversized %s request from %s", s.Protocol(), shortID(p))
+		n.penalize(p, "oversized request")
+		s.Reset()
+		return false
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		n.penalize(p, "invalid request")
+		s.Reset()
+		return false
+	}
+	return true
+}
+
+func writeMessage(s network.Stream, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = s.Write(data)
+	return err
+}
+
+// shortID abbreviates a peer ID for logs.
+func shortID(p peer.ID) string {
+	id := p.String()
+	if len(id) > 16 {
+		return id[:16]
+	}
+	return id
+}
@@ -0,0 +1,188 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+func TestStatusExchange(t *testing.T) {
+	a, b := newTestNode(t, nil), newTestNode(t, nil)
+	chain := newTestChain(5)
+	a.SetChain(chain)
+	connect(t, b, a)
+	ctx := context.Background()
+
+	status, err := b.RequestStatus(ctx, a.host.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.ChainID != testChainID || status.GenesisHash != testGenesis || status.Head != 5 || status.HeadHash != chain.blocks[4].Header.Hash() {
+		t.Fatalf("status %+v, want head #5 %s", status, chain.blocks[4].Header.Hash().Hex())
+	}
+	status, err = a.RequestStatus(ctx, b.host.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Head != 0 || status.HeadHash != (common.Hash{}) {
+		t.Fatalf("status of a node without a chain: %+v", status)
+	}
+
+	// A status of another network gets the peer disconnected
+	var resp Status
+	err = b.request(ctx, a.host.ID(), a.network.Protocol(StatusProtocol), &Status{ChainID: testChainID + 1, GenesisHash: testGenesis}, &resp)
+	if err == nil {
+		t.Fatal("status of another chain was answered")
+	}
+	waitFor(t, "the peer of another chain to be disconnected", func() bool {
+		return a.host.Network().Connectedness(b.host.ID()) != network.Connected
+	})
+}
+
+func TestBlockRequests(t *testing.T) {
+	a, b := newTestNode(t, nil), newTestNode(t, nil)
+	chain := newTestChain(100)
+	a.SetChain(chain)
+	connect(t, b, a)
+	ctx := context.Background()
+	p := a.host.ID()
+
+	for _, c := range []struct {
+		start, count uint64
+		want         int
+	}{
+		{1, 10, 10},
+		{95, 10, 6},                   // Stops at the head
+		{1, 100, MaxBlocksPerRequest}, // Capped per request
+		{101, 5, 0},                   // Past the head
+		{50, 1, 1},
+	} {
+		blocks, err := b.RequestBlocksByRange(ctx, p, c.start, c.count)
+		if err != nil {
+			t.Fatalf("range %d+%d: %v", c.start, c.count, err)
+		}
+		if len(blocks) != c.want {
+			t.Fatalf("range %d+%d: %d blocks, want %d", c.start, c.count, len(blocks), c.want)
+		}
+		for i, block := range blocks {
+			if block.Header.Hash() != chain.blocks[c.start-1+uint64(i)].Header.Hash() {
+				t.Fatalf("range %d+%d: block %d differs", c.start, c.count, i)
+			}
+		}
+	}
+	if _, err := b.RequestBlocksByRange(ctx, p, 0, 10); err == nil || !strings.Contains(err.Error(), "invalid range") {
+		t.Fatalf("range from block 0: %v", err)
+	}
+
+	want := chain.blocks[41]
+	block, err := b.RequestBlockByHash(ctx, p, want.Header.Hash())
+	if err != nil || block == nil || block.Header.Number != 42 {
+		t.Fatalf("block by hash: %v (%v)", block, err)
+	}
+	if block, err := b.RequestBlockByHash(ctx, p, common.Hash{1}); block != nil || err != nil {
+		t.Fatalf("unknown hash: %v (%v)", block, err)
+	}
+
+	// Until a chain is set, block requests are refused
+	if _, err := a.RequestBlocksByRange(ctx, b.host.ID(), 1, 1); err == nil || !strings.Contains(err.Error(), ErrNoChain.Error()) {
+		t.Fatalf("range from a node without a chain: %v", err)
+	}
+}
+
+// shiftedChain serves blocks numbered one above what was asked.
+type shiftedChain struct{ *testChain }
+
+func (c shiftedChain) GetBlock(number uint64) *core.Block {
+	return c.testChain.GetBlock(number + 1)
+}
+
+// Responses with blocks outside the requested range are rejected.
+func TestBlockResponseChecked(t *testing.T) {
+	a, b := newTestNode(t, nil), newTestNode(t, nil)
+	chain := newTestChain(10)
+	a.SetChain(shiftedChain{chain})
+	connect(t, b, a)
+	ctx := context.Background()
+
+	if _, err := b.RequestBlocksByRange(ctx, a.host.ID(), 1, 5); err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Fatalf("shifted blocks: %v", err)
+	}
+}
+
+func TestSyncDownload(t *testing.T) {
+	// Two full peers and one claiming blocks it doesn't serve
+	full := newTestChain(2*syncWindow + 10)
+	var peers []*P2PNode
+	for _, chain := range []ChainReader{full, full, &truncatedChain{testChain: newTestChain(syncChunk), height: full.CurrentHeight()}} {
+		n := newTestNode(t, nil)
+		n.SetChain(chain)
+		peers = append(peers, n)
+	}
+
+	n := newTestNode(t, nil)
+	local := newTestChain(3)
+	n.SetChain(local)
+	for _, p := range peers {
+		connect(t, n, p)
+	}
+	m := NewSyncManager(n, local, local.add)
+	m.sync()
+
+	stats := m.GetStats()
+	if local.CurrentHeight() != full.CurrentHeight() || stats["lastError"] != nil {
+		t.Fatalf("synced to #%d of #%d: %v", local.CurrentHeight()-1, full.CurrentHeight()-1, stats)
+	}
+	if stats["peers"] != 3 || stats["imported"] != uint64(len(full.blocks)-3) || m.Syncing() {
+		t.Fatalf("stats %v after a full sync", stats)
+	}
+	if local.GetBlock(2*syncWindow).Header.Hash() != full.blocks[2*syncWindow-1].Header.Hash() {
+		t.Fatal("synced chain differs from the peers'")
+	}
+}
+
+// truncatedChain reports height but serves only its blocks.
+type truncatedChain struct {
+	*testChain
+	height uint64
+}
+
+func (c *truncatedChain) CurrentHeight() uint64 { return c.height }
+
+func (c *truncatedChain) GetBlock(number uint64) *core.Block {
+	if number == c.height-1 {
+		// The status head needs a hash
+		return core.NewBlock(&core.Header{Number: number}, nil)
+	}
+	return c.testChain.GetBlock(number)
+}
+
+// A failed import stops the sync at the last good block.
+func TestSyncImportFailure(t *testing.T) {
+	p := newTestNode(t, nil)
+	remote := newTestChain(50)
+	p.SetChain(remote)
+
+	n := newTestNode(t, nil)
+	local := newTestChain(0)
+	connect(t, n, p)
+	errBad := errors.New("bad block")
+	m := NewSyncManager(n, local, func(block *core.Block) error {
+		if block.Header.Number == 20 {
+			return errBad
+		}
+		return local.add(block)
+	})
+	m.sync()
+
+	if height := local.CurrentHeight(); height != 20 {
+		t.Fatalf("synced to #%d, want #19", height-1)
+	}
+	if err, _ := m.GetStats()["lastError"].(string); !strings.Contains(err, "block #20") {
+		t.Fatalf("last error %q", err)
+	}
+}
@@ -0,0 +1,72 @@
+package node
+
+import (
+	"net"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Peer score thresholds. A peer that relayed two invalid blocks falls below
+// PublishThreshold, a third one graylists it: its messages are ignored until
+// the penalty decays (about an hour).
+var peerScoreThresholds = &pubsub.PeerScoreThresholds{
+	GossipThreshold:             -100, // No gossip to or from the peer below this
+	PublishThreshold:            -200, // Our own messages are not published to it
+	GraylistThreshold:           -400, // All its messages are ignored
+	AcceptPXThreshold:           10,   // Peer exchange only from well-behaved peers
+	OpportunisticGraftThreshold: 3,
+}
+
+// peerScoreParams scores peers on the block and tx topics: being in the mesh
+// and delivering new messages first earns a little, invalid messages (bad
+// seal, wrong chain, undecodable) cost a lot.
+func peerScoreParams(blockTopic, txTopic string, appScore func(peer.ID) float64) *pubsub.PeerScoreParams {
+	_, loopback, _ := net.ParseCIDR("127.0.0.0/8") // Local devnets run many nodes on one IP
+
+	return &pubsub.PeerScoreParams{
+		Topics: map[string]*pubsub.TopicScoreParams{
+			blockTopic: {
+				TopicWeight:                    0.5,
+				TimeInMeshWeight:               0.01,
+				TimeInMeshQuantum:              time.Second,
+				TimeInMeshCap:                  300,
+				FirstMessageDeliveriesWeight:   1,
+				FirstMessageDeliveriesDecay:    pubsub.ScoreParameterDecay(10 * time.Minute),
+				FirstMessageDeliveriesCap:      50,
+				InvalidMessageDeliveriesWeight: -100,
+				InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+			},
+			txTopic: {
+				TopicWeight:                    0.2,
+				TimeInMeshWeight:               0.01,
+				TimeInMeshQuantum:              time.Second,
+				TimeInMeshCap:                  300,
+				FirstMessageDeliveriesWeight:   0.1,
+				FirstMessageDeliveriesDecay:    pubsub.ScoreParameterDecay(10 * time.Minute),
+				FirstMessageDeliveriesCap:      200,
+				InvalidMessageDeliveriesWeight: -50,
+				InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+			},
+		},
+		TopicScoreCap: 50,
+
+		AppSpecificScore:  appScore,
+		AppSpecificWeight: 1,
+
+		// Sybils on one IP
+		IPColocationFactorWeight:    -10,
+		IPColocationFactorThreshold: 3,
+		IPColocationFactorWhitelist: []*net.IPNet{loopback},
+
+		// Broken promises (IWANT without delivery) and GRAFT spam
+		BehaviourPenaltyWeight:    -10,
+		BehaviourPenaltyThreshold: 6,
+		BehaviourPenaltyDecay:     pubsub.ScoreParameterDecay(10 * time.Minute),
+
+		DecayInterval: time.Second,
+		DecayToZero:   0.01,
+		RetainScore:   10 * time.Minute,
+	}
+}
@@ -0,0 +1,41 @@
+package node
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/crypto"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+)
+
+// txSeenCacheSize bounds how many tx hashes are remembered to stop gossip
+// loops.
+const txSeenCacheSize = 32768
+
+// seenCache remembers recently seen hashes, evicting the oldest.
+type seenCache struct {
+	mu  sync.Mutex
+	lru lru.BasicLRU[common.Hash, struct{}]
+}
+
+func newSeenCache(size int) *seenCache {
+	return &seenCache{lru: lru.NewBasicLRU[common.Hash, struct{}](size)}
+}
+
+// add records hash and reports whether it was new.
+func (c *seenCache) add(hash common.Hash) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lru.Contains(hash) {
+		return false
+	}
+	c.lru.Add(hash, struct{}{})
+	return true
+}
+
+// messageID identifies pubsub messages by content, so identical messages
+// from different publishers are deduplicated.
+func messageID(m *pb.Message) string {
+	return string(crypto.Keccak256(m.Data))
+}
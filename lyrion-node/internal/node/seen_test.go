@@ -0,0 +1,37 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+)
+
+func TestSeenCache(t *testing.T) {
+	c := newSeenCache(2)
+	a, b, d := common.Hash{1}, common.Hash{2}, common.Hash{3}
+	if !c.add(a) || c.add(a) {
+		t.Fatal("first add of a hash not new, or second one new")
+	}
+	c.add(b)
+	c.add(d) // Evicts a, the oldest
+	if !c.add(a) {
+		t.Fatal("evicted hash still seen")
+	}
+	if c.add(d) {
+		t.Fatal("recent hash forgotten")
+	}
+}
+
+// Identical messages from different publishers share an ID.
+func TestMessageID(t *testing.T) {
+	x := &pb.Message{Data: []byte("tx"), From: []byte("peer a"), Seqno: []byte{1}}
+	y := &pb.Message{Data: []byte("tx"), From: []byte("peer b"), Seqno: []byte{2}}
+	z := &pb.Message{Data: []byte("other tx"), From: []byte("peer a"), Seqno: []byte{1}}
+	if messageID(x) != messageID(y) {
+		t.Fatal("same content got different IDs")
+	}
+	if messageID(x) == messageID(z) {
+		t.Fatal("different content got the same ID")
+	}
+}
@@ -0,0 +1,267 @@
+package node
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+const (
+	SyncInterval = 10 * time.Second // How often peers are checked for a longer chain
+	syncChunk    = 32               // Blocks per range request
+	syncWindow   = 8 * syncChunk    // Blocks downloaded before they are imported
+)
+
+// SyncManager catches a lagging node up with its peers: it asks every peer
+// for its status and downloads the missing blocks by range, spreading the
+// requests over all peers ahead of it. Once caught up, new blocks arrive by
+// gossip and the manager only checks again periodically or when Trigger is
+// called (e.g. on a gossip block far ahead of the head).
+type SyncManager struct {
+	node        *P2PNode
+	chain       ChainReader
+	importBlock func(*core.Block) error // Must return nil for known blocks
+
+	trigger chan struct{}
+
+	syncing  bool
+	target   uint64
+	peers    int
+	imported uint64
+	lastErr  error
+	mu       sync.Mutex
+}
+
+// NewSyncManager creates a sync manager importing blocks into chain with
+// importBlock.
+func NewSyncManager(n *P2PNode, chain ChainReader, importBlock func(*core.Block) error) *SyncManager {
+	return &SyncManager{
+		node:        n,
+		chain:       chain,
+		importBlock: importBlock,
+		trigger:     make(chan struct{}, 1),
+	}
+}
+
+// Start begins the sync loop
+func (m *SyncManager) Start() {
+	go func() {
+		ticker := time.NewTicker(SyncInterval)
+		defer ticker.Stop()
+
+		for {
+			m.sync()
+			select {
+			case <-m.node.ctx.Done():
+				return
+			case <-ticker.C:
+			case <-m.trigger:
+			}
+		}
+	}()
+}
+
+// Trigger schedules a sync round.
+func (m *SyncManager) Trigger() {
+	select {
+	case m.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Syncing reports whether the node is catching up. Gossip blocks can be
+// ignored meanwhile: the sync fetches them anyway.
+func (m *SyncManager) Syncing() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.syncing
+}
+
+// peerStatus is a peer on our chain and its head.
+type peerStatus struct {
+	id   peer.ID
+	head uint64
+}
+
+// sync runs one round: find the peers ahead of us and download until the
+// head reaches the best of them.
+func (m *SyncManager) sync() {
+	peers := m.queryPeers()
+	var target uint64
+	for _, p := range peers {
+		if p.head > target {
+			target = p.head
+		}
+	}
+	head := m.head()
+
+	m.mu.Lock()
+	m.peers = len(peers)
+	if target <= head {
+		m.mu.Unlock()
+		return
+	}
+	m.syncing, m.target = true, target
+	m.mu.Unlock()
+
+	log.Printf("🔄 Syncing from #%d to #%d with %d peers", head, target, len(peers))
+	err := m.download(peers, target)
+
+	m.mu.Lock()
+	m.syncing = false
+	m.lastErr = err
+	m.mu.Unlock()
+	if err != nil {
+		log.Printf("⚠️ Sync stopped at #%d: %v", m.head(), err)
+		return
+	}
+	log.Printf("✅ Synced to #%d, following gossip", m.head())
+}
+
+// queryPeers asks all connected peers for their status in parallel and
+// returns those on our chain.
+func (m *SyncManager) queryPeers() []peerStatus {
+	local := m.node.LocalStatus()
+	ids := m.node.host.Network().Peers()
+
+	var (
+		peers []peerStatus
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+	)
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id peer.ID) {
+			defer wg.Done()
+			status, err := m.node.RequestStatus(m.node.ctx, id)
+			if err != nil {
+				return // Not a Lyrion node, or not serving yet
+			}
+			if status.ChainID != local.ChainID || status.GenesisHash != local.GenesisHash {
+				log.Printf("⚠️ Sync: Peer %s is on another chain (chain ID %d, genesis %s)", shortID(id), status.ChainID, status.GenesisHash.Hex())
+				return
+			}
+			mu.Lock()
+			peers = append(peers, peerStatus{id: id, head: status.Head})
+			mu.Unlock()
+		}(id)
+	}
+	wg.Wait()
+	return peers
+}
+
+// download fetches and imports blocks up to target, one window at a time.
+// The chunks of a window are requested from different peers in parallel.
+func (m *SyncManager) download(peers []peerStatus, target uint64) error {
+	for {
+		head := m.head()
+		if head >= target {
+			return nil
+		}
+		end := head + syncWindow
+		if end > target {
+			end = target
+		}
+
+		blocks, err := m.fetchWindow(peers, head+1, end)
+		if err != nil {
+			return err
+		}
+		for _, block := range blocks {
+			if err := m.importBlock(block); err != nil {
+				return fmt.Errorf("block #%d: %w", block.Header.Number, err)
+			}
+			m.mu.Lock()
+			m.imported++
+			m.mu.Unlock()
+		}
+		if m.head() <= head {
+			return fmt.Errorf("no progress past #%d", head)
+		}
+	}
+}
+
+// fetchWindow downloads blocks start..end in chunks, one request per peer at
+// a time. A chunk that fails is retried on the next peer that has it.
+func (m *SyncManager) fetchWindow(peers []peerStatus, start, end uint64) ([]*core.Block, error) {
+	chunks := int((end-start)/syncChunk) + 1
+	results := make([][]*core.Block, chunks)
+	errs := make([]error, chunks)
+
+	sem := make(chan struct{}, len(peers))
+	var wg sync.WaitGroup
+	for i := 0; i < chunks; i++ {
+		from := start + uint64(i)*syncChunk
+		to := from + syncChunk - 1
+		if to > end {
+			to = end
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, from, to uint64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = m.fetchChunk(peers, i, from, to)
+		}(i, from, to)
+	}
+	wg.Wait()
+
+	var blocks []*core.Block
+	for i := range results {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		blocks = append(blocks, results[i]...)
+	}
+	return blocks, nil
+}
+
+// fetchChunk requests blocks from..to, trying the peers that have them in
+// turn starting at peer i.
+func (m *SyncManager) fetchChunk(peers []peerStatus, i int, from, to uint64) ([]*core.Block, error) {
+	count := to - from + 1
+	err := fmt.Errorf("no peer has blocks #%d-#%d", from, to)
+	for j := range peers {
+		p := peers[(i+j)%len(peers)]
+		if p.head < to {
+			continue
+		}
+		blocks, reqErr := m.node.RequestBlocksByRange(m.node.ctx, p.id, from, count)
+		if reqErr == nil && uint64(len(blocks)) == count {
+			return blocks, nil
+		}
+		if reqErr == nil {
+			reqErr = fmt.Errorf("peer %s returned %d of %d blocks", shortID(p.id), len(blocks), count)
+		}
+		err = reqErr
+	}
+	return nil, err
+}
+
+func (m *SyncManager) head() uint64 {
+	if height := m.chain.CurrentHeight(); height > 0 {
+		return height - 1
+	}
+	return 0
+}
+
+// GetStats returns sync statistics
+func (m *SyncManager) GetStats() map[string]interface{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := map[string]interface{}{
+		"syncing":  m.syncing,
+		"target":   m.target,
+		"peers":    m.peers,
+		"imported": m.imported,
+	}
+	if m.lastErr != nil {
+		stats["lastError"] = m.lastErr.Error()
+	}
+	return stats
+}
@@ -0,0 +1,117 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/mempool"
+)
+
+// maxBlockTxs bounds the txs of a gossiped block.
+const maxBlockTxs = 4096
+
+var (
+	ErrMalformedBlock = errors.New("malformed block")
+	ErrWrongChain     = errors.New("block is for another chain")
+	ErrWrongSequencer = errors.New("block not sealed by the sequencer")
+)
+
+// validateBlockMessage decodes a block gossip message and checks that it is
+// well formed, for our chain and sealed by the sequencer. It is cheap: the
+// block's txs are not executed (followers do that on import).
+func validateBlockMessage(data []byte, chainID uint64, sequencer common.Address) (*BlockMessage, error) {
+	msg, err := DecodeBlockMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ChainID != chainID {
+		return nil, fmt.Errorf("%w: chain ID %d, expected %d", ErrWrongChain, msg.ChainID, chainID)
+	}
+	block := msg.Block
+	h := block.Header
+	if h.Number == 0 {
+		return nil, fmt.Errorf("%w: block number 0", ErrMalformedBlock)
+	}
+	if root := core.TxRoot(block.Transactions); h.TxRoot != root {
+		return nil, fmt.Errorf("%w: tx root is %s, header claims %s", ErrMalformedBlock, root.Hex(), h.TxRoot.Hex())
+	}
+
+	signer, err := h.SealSigner(new(big.Int).SetUint64(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrWrongSequencer, err)
+	}
+	if signer != sequencer || h.Coinbase != sequencer {
+		return nil, fmt.Errorf("%w: sealed by %s, expected %s", ErrWrongSequencer, signer.Hex(), sequencer.Hex())
+	}
+	return msg, nil
+}
+
+// validateBlock is the pubsub validator of the block topic. Invalid blocks
+// are rejected, so they are neither delivered nor relayed and GossipSub
+// penalizes the peer that sent them. The decoded message is handed to
+// handleBlocks as the message's ValidatorData.
+func (n *P2PNode) validateBlock(ctx context.Context, from peer.ID, m *pubsub.Message) pubsub.ValidationResult {
+	if n.sequencer == (common.Address{}) {
+		return pubsub.ValidationIgnore // Don't know whose blocks to accept
+	}
+	self := from == n.host.ID()
+	if !self && !n.blockLimiter.allow(from) {
+		return pubsub.ValidationIgnore
+	}
+	msg, err := validateBlockMessage(m.Data, n.network.ChainID, n.sequencer)
+	if err != nil {
+		if !self {
+			log.Printf("🚫 P2P: Rejected block from %s: %v", shortID(from), err)
+			n.penalize(from, "invalid block")
+		}
+		return pubsub.ValidationReject
+	}
+	m.ValidatorData = msg
+	return pubsub.ValidationAccept
+}
+
+// validateTx is the pubsub validator of the tx topic. Txs that don't decode
+// are rejected. Valid ones are handed to the tx handler once (later copies
+// are ignored) and only relayed if it admits them. Txs it refuses for a bad
+// signature, another chain ID or a system type are rejected and count
+// against the sender; anything else (a duplicate, a full pool) is ignored.
+func (n *P2PNode) validateTx(ctx context.Context, from peer.ID, m *pubsub.Message) pubsub.ValidationResult {
+	if from == n.host.ID() {
+		return pubsub.ValidationAccept // Published by BroadcastTransaction
+	}
+	if !n.txLimiter.allow(from) {
+		return pubsub.ValidationIgnore
+	}
+	tx, err := DecodeTxMessage(m.Data)
+	if err != nil {
+		log.Printf("🚫 P2P: Rejected tx from %s: %v", shortID(from), err)
+		n.penalize(from, "malformed tx")
+		return pubsub.ValidationReject
+	}
+	if !n.txSeen.add(tx.Hash()) || n.onTransaction == nil {
+		return pubsub.ValidationIgnore
+	}
+	if err := n.onTransaction(tx); err != nil {
+		if invalidTx(err) {
+			n.penalize(from, "invalid tx")
+			return pubsub.ValidationReject
+		}
+		return pubsub.ValidationIgnore
+	}
+	return pubsub.ValidationAccept
+}
+
+// invalidTx reports whether the tx handler refused a tx that no honest peer
+// would relay.
+func invalidTx(err error) bool {
+	return errors.Is(err, mempool.ErrInvalidSender) ||
+		errors.Is(err, mempool.ErrSenderMismatch) ||
+		errors.Is(err, mempool.ErrSystemTx)
+}
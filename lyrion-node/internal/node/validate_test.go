@@ -0,0 +1,111 @@
+package node
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+// signedTx returns a transfer signed by key for the test chain.
+func signedTx(t *testing.T, key *ecdsa.PrivateKey, nonce uint64) *core.Transaction {
+	t.Helper()
+	to := common.HexToAddress("0xb0b")
+	tx, err := core.SignTx(&core.Transaction{Type: core.TxTypeTransfer, Nonce: nonce, To: &to, Value: big.NewInt(1), Gas: 21000}, big.NewInt(testChainID), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+// sealedBlock returns block #number with a deposit and a user tx, sealed by
+// key for the test chain after edit is applied to its header.
+func sealedBlock(t *testing.T, key *ecdsa.PrivateKey, number uint64, edit func(h *core.Header)) *core.Block {
+	t.Helper()
+	deposit, err := core.NewDepositTx(&core.Deposit{Nonce: 1, Recipient: common.HexToAddress("0xb0b"), Amount: big.NewInt(5)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	txs := []*core.Transaction{deposit, signedTx(t, key, 0)}
+	h := &core.Header{
+		ParentHash: common.Hash{1},
+		Root:       common.Hash{2},
+		TxRoot:     core.TxRoot(txs),
+		Number:     number,
+		Time:       1000,
+		Coinbase:   crypto.PubkeyToAddress(key.PublicKey),
+		GasUsed:    42000,
+	}
+	if edit != nil {
+		edit(h)
+	}
+	if err := h.Seal(key, big.NewInt(testChainID)); err != nil {
+		t.Fatal(err)
+	}
+	return core.NewBlock(h, txs)
+}
+
+func TestValidateBlockMessage(t *testing.T) {
+	seqKey, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	sequencer := crypto.PubkeyToAddress(seqKey.PublicKey)
+
+	encode := func(t *testing.T, chainID uint64, block *core.Block) []byte {
+		data, err := EncodeBlockMessage(chainID, block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	for _, c := range []struct {
+		name string
+		data func(t *testing.T) []byte
+		want error
+	}{
+		{"valid", func(t *testing.T) []byte {
+			return encode(t, testChainID, sealedBlock(t, seqKey, 7, nil))
+		}, nil},
+		{"wrong chain", func(t *testing.T) []byte {
+			return encode(t, testChainID+1, sealedBlock(t, seqKey, 7, nil))
+		}, ErrWrongChain},
+		{"sealed for another chain", func(t *testing.T) []byte {
+			block := sealedBlock(t, seqKey, 7, nil)
+			block.Header.Seal(seqKey, big.NewInt(testChainID+1))
+			return encode(t, testChainID, block)
+		}, ErrWrongSequencer},
+		{"bad tx root", func(t *testing.T) []byte {
+			return encode(t, testChainID, sealedBlock(t, seqKey, 7, func(h *core.Header) { h.TxRoot = common.Hash{3} }))
+		}, ErrMalformedBlock},
+		{"wrong sealer", func(t *testing.T) []byte {
+			return encode(t, testChainID, sealedBlock(t, otherKey, 7, func(h *core.Header) { h.Coinbase = sequencer }))
+		}, ErrWrongSequencer},
+		{"coinbase is not the sequencer", func(t *testing.T) []byte {
+			return encode(t, testChainID, sealedBlock(t, seqKey, 7, func(h *core.Header) { h.Coinbase = common.Address{1} }))
+		}, ErrWrongSequencer},
+		{"unsealed", func(t *testing.T) []byte {
+			block := sealedBlock(t, seqKey, 7, nil)
+			block.Header.Signature = nil
+			return encode(t, testChainID, block)
+		}, ErrWrongSequencer},
+		{"block 0", func(t *testing.T) []byte {
+			return encode(t, testChainID, sealedBlock(t, seqKey, 0, nil))
+		}, ErrMalformedBlock},
+		{"not a block message", func(t *testing.T) []byte {
+			return []byte("not snappy")
+		}, ErrMalformedBlock},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			msg, err := validateBlockMessage(c.data(t), testChainID, sequencer)
+			if !errors.Is(err, c.want) {
+				t.Fatalf("validate: %v, want %v", err, c.want)
+			}
+			if err == nil && (msg.ChainID != testChainID || msg.Block.Header.Number != 7 || len(msg.Block.Transactions) != 2) {
+				t.Fatalf("valid block decoded as %+v", msg)
+			}
+		})
+	}
+}
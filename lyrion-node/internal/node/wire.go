@@ -0,0 +1,182 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/snappy"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+// Gossip messages are RLP-encoded and snappy-compressed. The wire version
+// and encoding are part of the topic names (see Network.Topic), so nodes on
+// an older format simply don't share topics with newer ones: version 1 was
+// JSON on /lyrion/blocks and /lyrion/txs.
+//
+// A block message is rlp([chainID, header, [tx, ...]]), a tx message
+// rlp(tx). Each tx is rlp([kind, payload]): kind 0 is a user tx with its
+// signed Ethereum envelope as payload (MarshalBinary), otherwise kind is
+// the system tx type (deposit, price update) and the payload is tx.Data; the
+// tx is rebuilt from it like in batch channels.
+const (
+	WireVersion  = 2
+	WireEncoding = "rlp_snappy"
+
+	MaxGossipSize       = 1 << 20   // Compressed size of a gossip message
+	maxBlockMessageSize = 8 << 20   // Decoded size of a block message
+	maxTxMessageSize    = 128 << 10 // Decoded size of a tx message
+
+	wireUserTx = 0
+)
+
+var ErrMalformedTx = errors.New("malformed transaction")
+
+type wireTx struct {
+	Kind    uint8
+	Payload []byte
+}
+
+type wireBlock struct {
+	ChainID uint64
+	Header  *core.Header
+	Txs     []wireTx
+}
+
+// EncodeBlockMessage encodes a block gossip message.
+func EncodeBlockMessage(chainID uint64, block *core.Block) ([]byte, error) {
+	msg := wireBlock{ChainID: chainID, Header: block.Header, Txs: make([]wireTx, 0, len(block.Transactions))}
+	for _, tx := range block.Transactions {
+		w, err := encodeTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		msg.Txs = append(msg.Txs, w)
+	}
+	return encodeWire(&msg)
+}
+
+// DecodeBlockMessage decodes a block gossip message. It only checks the
+// encoding; validateBlockMessage checks the content.
+func DecodeBlockMessage(data []byte) (*BlockMessage, error) {
+	var msg wireBlock
+	if err := decodeWire(data, maxBlockMessageSize, &msg); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedBlock, err)
+	}
+	if msg.Header == nil {
+		return nil, fmt.Errorf("%w: no header", ErrMalformedBlock)
+	}
+	if len(msg.Txs) > maxBlockTxs {
+		return nil, fmt.Errorf("%w: %d txs, at most %d", ErrMalformedBlock, len(msg.Txs), maxBlockTxs)
+	}
+	txs := make([]*core.Transaction, 0, len(msg.Txs))
+	for i := range msg.Txs {
+		tx, err := decodeTx(&msg.Txs[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: tx %d: %v", ErrMalformedBlock, i, err)
+		}
+		txs = append(txs, tx)
+	}
+	return &BlockMessage{ChainID: msg.ChainID, Block: core.NewBlock(msg.Header, txs)}, nil
+}
+
+// EncodeTxMessage encodes a tx gossip message. Only user txs are gossiped.
+func EncodeTxMessage(tx *core.Transaction) ([]byte, error) {
+	w, err := encodeTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if w.Kind != wireUserTx {
+		return nil, fmt.Errorf("%w: system tx", ErrMalformedTx)
+	}
+	return encodeWire(&w)
+}
+
+// DecodeTxMessage decodes a tx gossip message.
+func DecodeTxMessage(data []byte) (*core.Transaction, error) {
+	var w wireTx
+	if err := decodeWire(data, maxTxMessageSize, &w); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedTx, err)
+	}
+	if w.Kind != wireUserTx {
+		return nil, fmt.Errorf("%w: system tx", ErrMalformedTx)
+	}
+	return decodeTx(&w)
+}
+
+func encodeTx(tx *core.Transaction) (wireTx, error) {
+	switch tx.Type {
+	case core.TxTypeDeposit, core.TxTypePriceUpdate:
+		return wireTx{Kind: tx.Type, Payload: tx.Data}, nil
+	}
+	if tx.V == nil || tx.R == nil || tx.S == nil {
+		return wireTx{}, core.ErrTransactionNotSigned
+	}
+	raw, err := tx.EthTx().MarshalBinary()
+	if err != nil {
+		return wireTx{}, err
+	}
+	return wireTx{Kind: wireUserTx, Payload: raw}, nil
+}
+
+func decodeTx(w *wireTx) (*core.Transaction, error) {
+	switch w.Kind {
+	case wireUserTx:
+		var etx ethtypes.Transaction
+		if err := etx.UnmarshalBinary(w.Payload); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedTx, err)
+		}
+		tx, err := core.NewTransactionFromEth(&etx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedTx, err)
+		}
+		return tx, nil
+	case core.TxTypeDeposit:
+		d, err := core.DecodeDeposit(w.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedTx, err)
+		}
+		return core.NewDepositTx(d)
+	case core.TxTypePriceUpdate:
+		u, err := core.DecodePriceUpdate(w.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedTx, err)
+		}
+		return core.NewPriceUpdateTx(u)
+	default:
+		return nil, fmt.Errorf("%w: unknown kind %d", ErrMalformedTx, w.Kind)
+	}
+}
+
+func encodeWire(v interface{}) ([]byte, error) {
+	encoded, err := rlp.EncodeToBytes(v)
+	if err != nil {
+		return nil, err
+	}
+	data := snappy.Encode(nil, encoded)
+	if len(data) > MaxGossipSize {
+		return nil, fmt.Errorf("message of %d bytes exceeds %d", len(data), MaxGossipSize)
+	}
+	return data, nil
+}
+
+// decodeWire decompresses and decodes a message, refusing anything that
+// would decompress to more than maxSize bytes before allocating it.
+func decodeWire(data []byte, maxSize int, v interface{}) error {
+	if len(data) > MaxGossipSize {
+		return fmt.Errorf("message of %d bytes exceeds %d", len(data), MaxGossipSize)
+	}
+	size, err := snappy.DecodedLen(data)
+	if err != nil {
+		return err
+	}
+	if size > maxSize {
+		return fmt.Errorf("message decompresses to %d bytes, at most %d", size, maxSize)
+	}
+	encoded, err := snappy.Decode(nil, data)
+	if err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(encoded, v)
+}
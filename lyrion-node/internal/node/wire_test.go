@@ -0,0 +1,125 @@
+package node
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+func TestBlockMessageRoundTrip(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	block := sealedBlock(t, key, 7, nil)
+	update, err := core.NewPriceUpdateTx(&core.PriceUpdate{Timestamp: 900, L1BlockNumber: 40, Prices: []*core.PriceFeed{{Symbol: "FLR", Price: big.NewInt(21500)}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.Transactions = append(block.Transactions, update, signedTx(t, key, 1))
+
+	data, err := EncodeBlockMessage(testChainID, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := DecodeBlockMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := msg.Block
+	if msg.ChainID != testChainID || got.Header.Hash() != block.Header.Hash() || !bytes.Equal(got.Header.Signature, block.Header.Signature) {
+		t.Fatalf("decoded header %+v, want %+v", got.Header, block.Header)
+	}
+	if signer, err := got.Header.SealSigner(big.NewInt(testChainID)); err != nil || signer != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("decoded seal recovers %s (%v)", signer.Hex(), err)
+	}
+	if len(got.Transactions) != len(block.Transactions) {
+		t.Fatalf("%d txs decoded, want %d", len(got.Transactions), len(block.Transactions))
+	}
+	for i, tx := range got.Transactions {
+		if tx.Type != block.Transactions[i].Type || tx.Hash() != block.Transactions[i].Hash() {
+			t.Fatalf("tx %d decoded as type %d %s", i, tx.Type, tx.Hash().Hex())
+		}
+	}
+	if sender, err := core.RecoverSender(got.Transactions[1], big.NewInt(testChainID)); err != nil || sender != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("decoded user tx recovers %s (%v)", sender.Hex(), err)
+	}
+}
+
+func TestTxMessage(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	tx := signedTx(t, key, 3)
+	data, err := EncodeTxMessage(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeTxMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Hash() != tx.Hash() || got.V.Cmp(tx.V) != 0 || got.R.Cmp(tx.R) != 0 || got.S.Cmp(tx.S) != 0 {
+		t.Fatalf("decoded tx %s, want %s with the same signature", got.Hash().Hex(), tx.Hash().Hex())
+	}
+
+	unsigned := *tx
+	unsigned.V, unsigned.R, unsigned.S = nil, nil, nil
+	if _, err := EncodeTxMessage(&unsigned); !errors.Is(err, core.ErrTransactionNotSigned) {
+		t.Fatalf("unsigned tx: %v", err)
+	}
+
+	// System txs only travel inside blocks
+	deposit, err := core.NewDepositTx(&core.Deposit{Nonce: 1, Amount: big.NewInt(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := EncodeTxMessage(deposit); !errors.Is(err, ErrMalformedTx) {
+		t.Fatalf("encoding a deposit: %v", err)
+	}
+	data, err = encodeWire(&wireTx{Kind: core.TxTypeDeposit, Payload: deposit.Data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecodeTxMessage(data); !errors.Is(err, ErrMalformedTx) {
+		t.Fatalf("deposit on the tx topic: %v", err)
+	}
+}
+
+func TestWireSizeLimits(t *testing.T) {
+	// Messages that decompress past the limit are refused before decoding
+	data, err := encodeWire(&wireTx{Payload: make([]byte, maxTxMessageSize)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecodeTxMessage(data); !errors.Is(err, ErrMalformedTx) || !strings.Contains(err.Error(), "decompresses") {
+		t.Fatalf("oversized tx message: %v", err)
+	}
+	data, err = encodeWire(&wireBlock{Header: &core.Header{Number: 1}, Txs: []wireTx{{Payload: make([]byte, maxBlockMessageSize)}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecodeBlockMessage(data); !errors.Is(err, ErrMalformedBlock) || !strings.Contains(err.Error(), "decompresses") {
+		t.Fatalf("oversized block message: %v", err)
+	}
+
+	// Nor is anything above the gossip size
+	noise := make([]byte, MaxGossipSize+1)
+	rand.Read(noise)
+	if _, err := DecodeBlockMessage(noise); !errors.Is(err, ErrMalformedBlock) || !strings.Contains(err.Error(), "exceeds") {
+		t.Fatalf("message above the gossip size: %v", err)
+	}
+	if _, err := encodeWire(&wireTx{Payload: noise}); err == nil {
+		t.Fatal("encoded a message above the gossip size")
+	}
+
+	// And blocks can't carry more than maxBlockTxs
+	data, err = encodeWire(&wireBlock{Header: &core.Header{Number: 1}, Txs: make([]wireTx, maxBlockTxs+1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecodeBlockMessage(data); !errors.Is(err, ErrMalformedBlock) || !strings.Contains(err.Error(), "at most") {
+		t.Fatalf("block with %d txs: %v", maxBlockTxs+1, err)
+	}
+}
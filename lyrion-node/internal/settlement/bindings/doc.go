@@ -0,0 +1,16 @@
+// Package bindings contains Go bindings for the L1 contracts in contracts/.
+//
+// The ABIs in contracts/abi mirror the Solidity sources; regenerate the
+// bindings after changing a contract's external interface. The deployable
+// contracts also embed their creation bytecode from contracts/bin, which
+// l1sim deploys. The committed .bin files are assembled by `go run
+// ./contracts/evm`, hand-written EVM that follows the Solidity sources so
+// the harness runs without a Solidity toolchain; after `forge build`,
+// contracts/scripts/export-bin.sh replaces them with the solc output.
+package bindings
+
+//go:generate abigen --abi ../../../contracts/abi/LyrionBridge.json --bin ../../../contracts/bin/LyrionBridge.bin --pkg bindings --type LyrionBridge --out lyrion_bridge.go
+//go:generate abigen --abi ../../../contracts/abi/ILyrionBridge.json --pkg bindings --type ILyrionBridge --out ilyrion_bridge.go
+//go:generate abigen --abi ../../../contracts/abi/LyrionToken.json --bin ../../../contracts/bin/LyrionToken.bin --pkg bindings --type LyrionToken --out lyrion_token.go
+//go:generate abigen --abi ../../../contracts/abi/IFtsoV2.json --pkg bindings --type IFtsoV2 --out iftso_v2.go
+//go:generate abigen --abi ../../../contracts/abi/MockFtsoV2.json --bin ../../../contracts/bin/MockFtsoV2.bin --pkg bindings --type MockFtsoV2 --out mock_ftso_v2.go
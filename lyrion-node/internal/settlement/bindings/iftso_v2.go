@@ -0,0 +1,281 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IFtsoV2MetaData contains all meta data concerning the IFtsoV2 contract.
+var IFtsoV2MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"getFeedById\",\"inputs\":[{\"name\":\"_feedId\",\"type\":\"bytes21\",\"internalType\":\"bytes21\"}],\"outputs\":[{\"name\":\"_value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_decimals\",\"type\":\"int8\",\"internalType\":\"int8\"},{\"name\":\"_timestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getFeedsById\",\"inputs\":[{\"name\":\"_feedIds\",\"type\":\"bytes21[]\",\"internalType\":\"bytes21[]\"}],\"outputs\":[{\"name\":\"_values\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"_decimals\",\"type\":\"int8[]\",\"internalType\":\"int8[]\"},{\"name\":\"_timestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"}]",
+}
+
+// IFtsoV2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use IFtsoV2MetaData.ABI instead.
+var IFtsoV2ABI = IFtsoV2MetaData.ABI
+
+// IFtsoV2 is an auto generated Go binding around an Ethereum contract.
+type IFtsoV2 struct {
+	IFtsoV2Caller     // Read-only binding to the contract
+	IFtsoV2Transactor // Write-only binding to the contract
+	IFtsoV2Filterer   // Log filterer for contract events
+}
+
+// IFtsoV2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type IFtsoV2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IFtsoV2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type IFtsoV2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IFtsoV2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IFtsoV2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IFtsoV2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IFtsoV2Session struct {
+	Contract     *IFtsoV2          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IFtsoV2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IFtsoV2CallerSession struct {
+	Contract *IFtsoV2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// IFtsoV2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IFtsoV2TransactorSession struct {
+	Contract     *IFtsoV2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// IFtsoV2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type IFtsoV2Raw struct {
+	Contract *IFtsoV2 // Generic contract binding to access the raw methods on
+}
+
+// IFtsoV2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IFtsoV2CallerRaw struct {
+	Contract *IFtsoV2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// IFtsoV2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IFtsoV2TransactorRaw struct {
+	Contract *IFtsoV2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIFtsoV2 creates a new instance of IFtsoV2, bound to a specific deployed contract.
+func NewIFtsoV2(address common.Address, backend bind.ContractBackend) (*IFtsoV2, error) {
+	contract, err := bindIFtsoV2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IFtsoV2{IFtsoV2Caller: IFtsoV2Caller{contract: contract}, IFtsoV2Transactor: IFtsoV2Transactor{contract: contract}, IFtsoV2Filterer: IFtsoV2Filterer{contract: contract}}, nil
+}
+
+// NewIFtsoV2Caller creates a new read-only instance of IFtsoV2, bound to a specific deployed contract.
+func NewIFtsoV2Caller(address common.Address, caller bind.ContractCaller) (*IFtsoV2Caller, error) {
+	contract, err := bindIFtsoV2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IFtsoV2Caller{contract: contract}, nil
+}
+
+// NewIFtsoV2Transactor creates a new write-only instance of IFtsoV2, bound to a specific deployed contract.
+func NewIFtsoV2Transactor(address common.Address, transactor bind.ContractTransactor) (*IFtsoV2Transactor, error) {
+	contract, err := bindIFtsoV2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IFtsoV2Transactor{contract: contract}, nil
+}
+
+// NewIFtsoV2Filterer creates a new log filterer instance of IFtsoV2, bound to a specific deployed contract.
+func NewIFtsoV2Filterer(address common.Address, filterer bind.ContractFilterer) (*IFtsoV2Filterer, error) {
+	contract, err := bindIFtsoV2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IFtsoV2Filterer{contract: contract}, nil
+}
+
+// bindIFtsoV2 binds a generic wrapper to an already deployed contract.
+func bindIFtsoV2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IFtsoV2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IFtsoV2 *IFtsoV2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IFtsoV2.Contract.IFtsoV2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IFtsoV2 *IFtsoV2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IFtsoV2.Contract.IFtsoV2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IFtsoV2 *IFtsoV2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IFtsoV2.Contract.IFtsoV2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IFtsoV2 *IFtsoV2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IFtsoV2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IFtsoV2 *IFtsoV2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IFtsoV2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IFtsoV2 *IFtsoV2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IFtsoV2.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetFeedById is a free data retrieval call binding the contract method 0x93e9f806.
+//
+// Solidity: function getFeedById(bytes21 _feedId) view returns(uint256 _value, int8 _decimals, uint64 _timestamp)
+func (_IFtsoV2 *IFtsoV2Caller) GetFeedById(opts *bind.CallOpts, _feedId [21]byte) (struct {
+	Value     *big.Int
+	Decimals  int8
+	Timestamp uint64
+}, error) {
+	var out []interface{}
+	err := _IFtsoV2.contract.Call(opts, &out, "getFeedById", _feedId)
+
+	outstruct := new(struct {
+		Value     *big.Int
+		Decimals  int8
+		Timestamp uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Value = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Decimals = *abi.ConvertType(out[1], new(int8)).(*int8)
+	outstruct.Timestamp = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// GetFeedById is a free data retrieval call binding the contract method 0x93e9f806.
+//
+// Solidity: function getFeedById(bytes21 _feedId) view returns(uint256 _value, int8 _decimals, uint64 _timestamp)
+func (_IFtsoV2 *IFtsoV2Session) GetFeedById(_feedId [21]byte) (struct {
+	Value     *big.Int
+	Decimals  int8
+	Timestamp uint64
+}, error) {
+	return _IFtsoV2.Contract.GetFeedById(&_IFtsoV2.CallOpts, _feedId)
+}
+
+// GetFeedById is a free data retrieval call binding the contract method 0x93e9f806.
+//
+// Solidity: function getFeedById(bytes21 _feedId) view returns(uint256 _value, int8 _decimals, uint64 _timestamp)
+func (_IFtsoV2 *IFtsoV2CallerSession) GetFeedById(_feedId [21]byte) (struct {
+	Value     *big.Int
+	Decimals  int8
+	Timestamp uint64
+}, error) {
+	return _IFtsoV2.Contract.GetFeedById(&_IFtsoV2.CallOpts, _feedId)
+}
+
+// GetFeedsById is a free data retrieval call binding the contract method 0x4c375745.
+//
+// Solidity: function getFeedsById(bytes21[] _feedIds) view returns(uint256[] _values, int8[] _decimals, uint64 _timestamp)
+func (_IFtsoV2 *IFtsoV2Caller) GetFeedsById(opts *bind.CallOpts, _feedIds [][21]byte) (struct {
+	Values    []*big.Int
+	Decimals  []int8
+	Timestamp uint64
+}, error) {
+	var out []interface{}
+	err := _IFtsoV2.contract.Call(opts, &out, "getFeedsById", _feedIds)
+
+	outstruct := new(struct {
+		Values    []*big.Int
+		Decimals  []int8
+		Timestamp uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Values = *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+	outstruct.Decimals = *abi.ConvertType(out[1], new([]int8)).(*[]int8)
+	outstruct.Timestamp = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// GetFeedsById is a free data retrieval call binding the contract method 0x4c375745.
+//
+// Solidity: function getFeedsById(bytes21[] _feedIds) view returns(uint256[] _values, int8[] _decimals, uint64 _timestamp)
+func (_IFtsoV2 *IFtsoV2Session) GetFeedsById(_feedIds [][21]byte) (struct {
+	Values    []*big.Int
+	Decimals  []int8
+	Timestamp uint64
+}, error) {
+	return _IFtsoV2.Contract.GetFeedsById(&_IFtsoV2.CallOpts, _feedIds)
+}
+
+// GetFeedsById is a free data retrieval call binding the contract method 0x4c375745.
+//
+// Solidity: function getFeedsById(bytes21[] _feedIds) view returns(uint256[] _values, int8[] _decimals, uint64 _timestamp)
+func (_IFtsoV2 *IFtsoV2CallerSession) GetFeedsById(_feedIds [][21]byte) (struct {
+	Values    []*big.Int
+	Decimals  []int8
+	Timestamp uint64
+}, error) {
+	return _IFtsoV2.Contract.GetFeedsById(&_IFtsoV2.CallOpts, _feedIds)
+}
@@ -0,0 +1,1145 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ILyrionBridgeMetaData contains all meta data concerning the ILyrionBridge contract.
+var ILyrionBridgeMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"depositToL2\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"depositToL2\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"submitBatch\",\"inputs\":[{\"name\":\"stateRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"startBlock\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"endBlock\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"txCount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawFromL2\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"proof\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"challengeBatch\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"derivedRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"proofHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"batchStateRoots\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"batchSubmissionTime\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"currentBatchNumber\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isBatchFinalized\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBatchInfo\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"stateRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"submissionTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"isFinalized\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBridgeStats\",\"inputs\":[],\"outputs\":[{\"name\":\"_currentBatchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_totalDeposited\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_totalWithdrawn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_bridgeBalance\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_depositNonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"DepositInitiated\",\"inputs\":[{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":true},{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchSubmitted\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":true},{\"name\":\"stateRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":false},{\"name\":\"startBlock\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"endBlock\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"txCount\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"WithdrawalCompleted\",\"inputs\":[{\"name\":\"withdrawalHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchChallenged\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":true},{\"name\":\"challenger\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"claimedRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":false},{\"name\":\"derivedRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":false},{\"name\":\"proofHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":false},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false}]",
+}
+
+// ILyrionBridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use ILyrionBridgeMetaData.ABI instead.
+var ILyrionBridgeABI = ILyrionBridgeMetaData.ABI
+
+// ILyrionBridge is an auto generated Go binding around an Ethereum contract.
+type ILyrionBridge struct {
+	ILyrionBridgeCaller     // Read-only binding to the contract
+	ILyrionBridgeTransactor // Write-only binding to the contract
+	ILyrionBridgeFilterer   // Log filterer for contract events
+}
+
+// ILyrionBridgeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ILyrionBridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ILyrionBridgeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ILyrionBridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ILyrionBridgeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ILyrionBridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ILyrionBridgeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ILyrionBridgeSession struct {
+	Contract     *ILyrionBridge    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ILyrionBridgeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ILyrionBridgeCallerSession struct {
+	Contract *ILyrionBridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// ILyrionBridgeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ILyrionBridgeTransactorSession struct {
+	Contract     *ILyrionBridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// ILyrionBridgeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ILyrionBridgeRaw struct {
+	Contract *ILyrionBridge // Generic contract binding to access the raw methods on
+}
+
+// ILyrionBridgeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ILyrionBridgeCallerRaw struct {
+	Contract *ILyrionBridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ILyrionBridgeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ILyrionBridgeTransactorRaw struct {
+	Contract *ILyrionBridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewILyrionBridge creates a new instance of ILyrionBridge, bound to a specific deployed contract.
+func NewILyrionBridge(address common.Address, backend bind.ContractBackend) (*ILyrionBridge, error) {
+	contract, err := bindILyrionBridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ILyrionBridge{ILyrionBridgeCaller: ILyrionBridgeCaller{contract: contract}, ILyrionBridgeTransactor: ILyrionBridgeTransactor{contract: contract}, ILyrionBridgeFilterer: ILyrionBridgeFilterer{contract: contract}}, nil
+}
+
+// NewILyrionBridgeCaller creates a new read-only instance of ILyrionBridge, bound to a specific deployed contract.
+func NewILyrionBridgeCaller(address common.Address, caller bind.ContractCaller) (*ILyrionBridgeCaller, error) {
+	contract, err := bindILyrionBridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ILyrionBridgeCaller{contract: contract}, nil
+}
+
+// NewILyrionBridgeTransactor creates a new write-only instance of ILyrionBridge, bound to a specific deployed contract.
+func NewILyrionBridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*ILyrionBridgeTransactor, error) {
+	contract, err := bindILyrionBridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ILyrionBridgeTransactor{contract: contract}, nil
+}
+
+// NewILyrionBridgeFilterer creates a new log filterer instance of ILyrionBridge, bound to a specific deployed contract.
+func NewILyrionBridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*ILyrionBridgeFilterer, error) {
+	contract, err := bindILyrionBridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ILyrionBridgeFilterer{contract: contract}, nil
+}
+
+// bindILyrionBridge binds a generic wrapper to an already deployed contract.
+func bindILyrionBridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ILyrionBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ILyrionBridge *ILyrionBridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ILyrionBridge.Contract.ILyrionBridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ILyrionBridge *ILyrionBridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.ILyrionBridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ILyrionBridge *ILyrionBridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.ILyrionBridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ILyrionBridge *ILyrionBridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ILyrionBridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ILyrionBridge *ILyrionBridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ILyrionBridge *ILyrionBridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// BatchStateRoots is a free data retrieval call binding the contract method 0x2f9582bd.
+//
+// Solidity: function batchStateRoots(uint256 batchNumber) view returns(bytes32)
+func (_ILyrionBridge *ILyrionBridgeCaller) BatchStateRoots(opts *bind.CallOpts, batchNumber *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _ILyrionBridge.contract.Call(opts, &out, "batchStateRoots", batchNumber)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BatchStateRoots is a free data retrieval call binding the contract method 0x2f9582bd.
+//
+// Solidity: function batchStateRoots(uint256 batchNumber) view returns(bytes32)
+func (_ILyrionBridge *ILyrionBridgeSession) BatchStateRoots(batchNumber *big.Int) ([32]byte, error) {
+	return _ILyrionBridge.Contract.BatchStateRoots(&_ILyrionBridge.CallOpts, batchNumber)
+}
+
+// BatchStateRoots is a free data retrieval call binding the contract method 0x2f9582bd.
+//
+// Solidity: function batchStateRoots(uint256 batchNumber) view returns(bytes32)
+func (_ILyrionBridge *ILyrionBridgeCallerSession) BatchStateRoots(batchNumber *big.Int) ([32]byte, error) {
+	return _ILyrionBridge.Contract.BatchStateRoots(&_ILyrionBridge.CallOpts, batchNumber)
+}
+
+// BatchSubmissionTime is a free data retrieval call binding the contract method 0x155366b3.
+//
+// Solidity: function batchSubmissionTime(uint256 batchNumber) view returns(uint256)
+func (_ILyrionBridge *ILyrionBridgeCaller) BatchSubmissionTime(opts *bind.CallOpts, batchNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ILyrionBridge.contract.Call(opts, &out, "batchSubmissionTime", batchNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BatchSubmissionTime is a free data retrieval call binding the contract method 0x155366b3.
+//
+// Solidity: function batchSubmissionTime(uint256 batchNumber) view returns(uint256)
+func (_ILyrionBridge *ILyrionBridgeSession) BatchSubmissionTime(batchNumber *big.Int) (*big.Int, error) {
+	return _ILyrionBridge.Contract.BatchSubmissionTime(&_ILyrionBridge.CallOpts, batchNumber)
+}
+
+// BatchSubmissionTime is a free data retrieval call binding the contract method 0x155366b3.
+//
+// Solidity: function batchSubmissionTime(uint256 batchNumber) view returns(uint256)
+func (_ILyrionBridge *ILyrionBridgeCallerSession) BatchSubmissionTime(batchNumber *big.Int) (*big.Int, error) {
+	return _ILyrionBridge.Contract.BatchSubmissionTime(&_ILyrionBridge.CallOpts, batchNumber)
+}
+
+// CurrentBatchNumber is a free data retrieval call binding the contract method 0xf48fa80b.
+//
+// Solidity: function currentBatchNumber() view returns(uint256)
+func (_ILyrionBridge *ILyrionBridgeCaller) CurrentBatchNumber(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ILyrionBridge.contract.Call(opts, &out, "currentBatchNumber")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CurrentBatchNumber is a free data retrieval call binding the contract method 0xf48fa80b.
+//
+// Solidity: function currentBatchNumber() view returns(uint256)
+func (_ILyrionBridge *ILyrionBridgeSession) CurrentBatchNumber() (*big.Int, error) {
+	return _ILyrionBridge.Contract.CurrentBatchNumber(&_ILyrionBridge.CallOpts)
+}
+
+// CurrentBatchNumber is a free data retrieval call binding the contract method 0xf48fa80b.
+//
+// Solidity: function currentBatchNumber() view returns(uint256)
+func (_ILyrionBridge *ILyrionBridgeCallerSession) CurrentBatchNumber() (*big.Int, error) {
+	return _ILyrionBridge.Contract.CurrentBatchNumber(&_ILyrionBridge.CallOpts)
+}
+
+// GetBatchInfo is a free data retrieval call binding the contract method 0x1a0058f5.
+//
+// Solidity: function getBatchInfo(uint256 batchNumber) view returns(bytes32 stateRoot, uint256 submissionTime, bool isFinalized)
+func (_ILyrionBridge *ILyrionBridgeCaller) GetBatchInfo(opts *bind.CallOpts, batchNumber *big.Int) (struct {
+	StateRoot      [32]byte
+	SubmissionTime *big.Int
+	IsFinalized    bool
+}, error) {
+	var out []interface{}
+	err := _ILyrionBridge.contract.Call(opts, &out, "getBatchInfo", batchNumber)
+
+	outstruct := new(struct {
+		StateRoot      [32]byte
+		SubmissionTime *big.Int
+		IsFinalized    bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StateRoot = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.SubmissionTime = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.IsFinalized = *abi.ConvertType(out[2], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetBatchInfo is a free data retrieval call binding the contract method 0x1a0058f5.
+//
+// Solidity: function getBatchInfo(uint256 batchNumber) view returns(bytes32 stateRoot, uint256 submissionTime, bool isFinalized)
+func (_ILyrionBridge *ILyrionBridgeSession) GetBatchInfo(batchNumber *big.Int) (struct {
+	StateRoot      [32]byte
+	SubmissionTime *big.Int
+	IsFinalized    bool
+}, error) {
+	return _ILyrionBridge.Contract.GetBatchInfo(&_ILyrionBridge.CallOpts, batchNumber)
+}
+
+// GetBatchInfo is a free data retrieval call binding the contract method 0x1a0058f5.
+//
+// Solidity: function getBatchInfo(uint256 batchNumber) view returns(bytes32 stateRoot, uint256 submissionTime, bool isFinalized)
+func (_ILyrionBridge *ILyrionBridgeCallerSession) GetBatchInfo(batchNumber *big.Int) (struct {
+	StateRoot      [32]byte
+	SubmissionTime *big.Int
+	IsFinalized    bool
+}, error) {
+	return _ILyrionBridge.Contract.GetBatchInfo(&_ILyrionBridge.CallOpts, batchNumber)
+}
+
+// GetBridgeStats is a free data retrieval call binding the contract method 0x2165cbb7.
+//
+// Solidity: function getBridgeStats() view returns(uint256 _currentBatchNumber, uint256 _totalDeposited, uint256 _totalWithdrawn, uint256 _bridgeBalance, uint256 _depositNonce)
+func (_ILyrionBridge *ILyrionBridgeCaller) GetBridgeStats(opts *bind.CallOpts) (struct {
+	CurrentBatchNumber *big.Int
+	TotalDeposited     *big.Int
+	TotalWithdrawn     *big.Int
+	BridgeBalance      *big.Int
+	DepositNonce       *big.Int
+}, error) {
+	var out []interface{}
+	err := _ILyrionBridge.contract.Call(opts, &out, "getBridgeStats")
+
+	outstruct := new(struct {
+		CurrentBatchNumber *big.Int
+		TotalDeposited     *big.Int
+		TotalWithdrawn     *big.Int
+		BridgeBalance      *big.Int
+		DepositNonce       *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.CurrentBatchNumber = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.TotalDeposited = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.TotalWithdrawn = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.BridgeBalance = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.DepositNonce = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetBridgeStats is a free data retrieval call binding the contract method 0x2165cbb7.
+//
+// Solidity: function getBridgeStats() view returns(uint256 _currentBatchNumber, uint256 _totalDeposited, uint256 _totalWithdrawn, uint256 _bridgeBalance, uint256 _depositNonce)
+func (_ILyrionBridge *ILyrionBridgeSession) GetBridgeStats() (struct {
+	CurrentBatchNumber *big.Int
+	TotalDeposited     *big.Int
+	TotalWithdrawn     *big.Int
+	BridgeBalance      *big.Int
+	DepositNonce       *big.Int
+}, error) {
+	return _ILyrionBridge.Contract.GetBridgeStats(&_ILyrionBridge.CallOpts)
+}
+
+// GetBridgeStats is a free data retrieval call binding the contract method 0x2165cbb7.
+//
+// Solidity: function getBridgeStats() view returns(uint256 _currentBatchNumber, uint256 _totalDeposited, uint256 _totalWithdrawn, uint256 _bridgeBalance, uint256 _depositNonce)
+func (_ILyrionBridge *ILyrionBridgeCallerSession) GetBridgeStats() (struct {
+	CurrentBatchNumber *big.Int
+	TotalDeposited     *big.Int
+	TotalWithdrawn     *big.Int
+	BridgeBalance      *big.Int
+	DepositNonce       *big.Int
+}, error) {
+	return _ILyrionBridge.Contract.GetBridgeStats(&_ILyrionBridge.CallOpts)
+}
+
+// IsBatchFinalized is a free data retrieval call binding the contract method 0x116a1f42.
+//
+// Solidity: function isBatchFinalized(uint256 batchNumber) view returns(bool)
+func (_ILyrionBridge *ILyrionBridgeCaller) IsBatchFinalized(opts *bind.CallOpts, batchNumber *big.Int) (bool, error) {
+	var out []interface{}
+	err := _ILyrionBridge.contract.Call(opts, &out, "isBatchFinalized", batchNumber)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsBatchFinalized is a free data retrieval call binding the contract method 0x116a1f42.
+//
+// Solidity: function isBatchFinalized(uint256 batchNumber) view returns(bool)
+func (_ILyrionBridge *ILyrionBridgeSession) IsBatchFinalized(batchNumber *big.Int) (bool, error) {
+	return _ILyrionBridge.Contract.IsBatchFinalized(&_ILyrionBridge.CallOpts, batchNumber)
+}
+
+// IsBatchFinalized is a free data retrieval call binding the contract method 0x116a1f42.
+//
+// Solidity: function isBatchFinalized(uint256 batchNumber) view returns(bool)
+func (_ILyrionBridge *ILyrionBridgeCallerSession) IsBatchFinalized(batchNumber *big.Int) (bool, error) {
+	return _ILyrionBridge.Contract.IsBatchFinalized(&_ILyrionBridge.CallOpts, batchNumber)
+}
+
+// ChallengeBatch is a paid mutator transaction binding the contract method 0xfb9e186a.
+//
+// Solidity: function challengeBatch(uint256 batchNumber, bytes32 derivedRoot, bytes32 proofHash) returns()
+func (_ILyrionBridge *ILyrionBridgeTransactor) ChallengeBatch(opts *bind.TransactOpts, batchNumber *big.Int, derivedRoot [32]byte, proofHash [32]byte) (*types.Transaction, error) {
+	return _ILyrionBridge.contract.Transact(opts, "challengeBatch", batchNumber, derivedRoot, proofHash)
+}
+
+// ChallengeBatch is a paid mutator transaction binding the contract method 0xfb9e186a.
+//
+// Solidity: function challengeBatch(uint256 batchNumber, bytes32 derivedRoot, bytes32 proofHash) returns()
+func (_ILyrionBridge *ILyrionBridgeSession) ChallengeBatch(batchNumber *big.Int, derivedRoot [32]byte, proofHash [32]byte) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.ChallengeBatch(&_ILyrionBridge.TransactOpts, batchNumber, derivedRoot, proofHash)
+}
+
+// ChallengeBatch is a paid mutator transaction binding the contract method 0xfb9e186a.
+//
+// Solidity: function challengeBatch(uint256 batchNumber, bytes32 derivedRoot, bytes32 proofHash) returns()
+func (_ILyrionBridge *ILyrionBridgeTransactorSession) ChallengeBatch(batchNumber *big.Int, derivedRoot [32]byte, proofHash [32]byte) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.ChallengeBatch(&_ILyrionBridge.TransactOpts, batchNumber, derivedRoot, proofHash)
+}
+
+// DepositToL2 is a paid mutator transaction binding the contract method 0xff04f12c.
+//
+// Solidity: function depositToL2(address recipient) payable returns()
+func (_ILyrionBridge *ILyrionBridgeTransactor) DepositToL2(opts *bind.TransactOpts, recipient common.Address) (*types.Transaction, error) {
+	return _ILyrionBridge.contract.Transact(opts, "depositToL2", recipient)
+}
+
+// DepositToL2 is a paid mutator transaction binding the contract method 0xff04f12c.
+//
+// Solidity: function depositToL2(address recipient) payable returns()
+func (_ILyrionBridge *ILyrionBridgeSession) DepositToL2(recipient common.Address) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.DepositToL2(&_ILyrionBridge.TransactOpts, recipient)
+}
+
+// DepositToL2 is a paid mutator transaction binding the contract method 0xff04f12c.
+//
+// Solidity: function depositToL2(address recipient) payable returns()
+func (_ILyrionBridge *ILyrionBridgeTransactorSession) DepositToL2(recipient common.Address) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.DepositToL2(&_ILyrionBridge.TransactOpts, recipient)
+}
+
+// DepositToL20 is a paid mutator transaction binding the contract method 0x5823d45b.
+//
+// Solidity: function depositToL2() payable returns()
+func (_ILyrionBridge *ILyrionBridgeTransactor) DepositToL20(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ILyrionBridge.contract.Transact(opts, "depositToL20")
+}
+
+// DepositToL20 is a paid mutator transaction binding the contract method 0x5823d45b.
+//
+// Solidity: function depositToL2() payable returns()
+func (_ILyrionBridge *ILyrionBridgeSession) DepositToL20() (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.DepositToL20(&_ILyrionBridge.TransactOpts)
+}
+
+// DepositToL20 is a paid mutator transaction binding the contract method 0x5823d45b.
+//
+// Solidity: function depositToL2() payable returns()
+func (_ILyrionBridge *ILyrionBridgeTransactorSession) DepositToL20() (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.DepositToL20(&_ILyrionBridge.TransactOpts)
+}
+
+// SubmitBatch is a paid mutator transaction binding the contract method 0x4d326825.
+//
+// Solidity: function submitBatch(bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount) returns()
+func (_ILyrionBridge *ILyrionBridgeTransactor) SubmitBatch(opts *bind.TransactOpts, stateRoot [32]byte, startBlock *big.Int, endBlock *big.Int, txCount *big.Int) (*types.Transaction, error) {
+	return _ILyrionBridge.contract.Transact(opts, "submitBatch", stateRoot, startBlock, endBlock, txCount)
+}
+
+// SubmitBatch is a paid mutator transaction binding the contract method 0x4d326825.
+//
+// Solidity: function submitBatch(bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount) returns()
+func (_ILyrionBridge *ILyrionBridgeSession) SubmitBatch(stateRoot [32]byte, startBlock *big.Int, endBlock *big.Int, txCount *big.Int) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.SubmitBatch(&_ILyrionBridge.TransactOpts, stateRoot, startBlock, endBlock, txCount)
+}
+
+// SubmitBatch is a paid mutator transaction binding the contract method 0x4d326825.
+//
+// Solidity: function submitBatch(bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount) returns()
+func (_ILyrionBridge *ILyrionBridgeTransactorSession) SubmitBatch(stateRoot [32]byte, startBlock *big.Int, endBlock *big.Int, txCount *big.Int) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.SubmitBatch(&_ILyrionBridge.TransactOpts, stateRoot, startBlock, endBlock, txCount)
+}
+
+// WithdrawFromL2 is a paid mutator transaction binding the contract method 0xf809ad39.
+//
+// Solidity: function withdrawFromL2(uint256 batchNumber, address recipient, uint256 amount, bytes32[] proof) returns()
+func (_ILyrionBridge *ILyrionBridgeTransactor) WithdrawFromL2(opts *bind.TransactOpts, batchNumber *big.Int, recipient common.Address, amount *big.Int, proof [][32]byte) (*types.Transaction, error) {
+	return _ILyrionBridge.contract.Transact(opts, "withdrawFromL2", batchNumber, recipient, amount, proof)
+}
+
+// WithdrawFromL2 is a paid mutator transaction binding the contract method 0xf809ad39.
+//
+// Solidity: function withdrawFromL2(uint256 batchNumber, address recipient, uint256 amount, bytes32[] proof) returns()
+func (_ILyrionBridge *ILyrionBridgeSession) WithdrawFromL2(batchNumber *big.Int, recipient common.Address, amount *big.Int, proof [][32]byte) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.WithdrawFromL2(&_ILyrionBridge.TransactOpts, batchNumber, recipient, amount, proof)
+}
+
+// WithdrawFromL2 is a paid mutator transaction binding the contract method 0xf809ad39.
+//
+// Solidity: function withdrawFromL2(uint256 batchNumber, address recipient, uint256 amount, bytes32[] proof) returns()
+func (_ILyrionBridge *ILyrionBridgeTransactorSession) WithdrawFromL2(batchNumber *big.Int, recipient common.Address, amount *big.Int, proof [][32]byte) (*types.Transaction, error) {
+	return _ILyrionBridge.Contract.WithdrawFromL2(&_ILyrionBridge.TransactOpts, batchNumber, recipient, amount, proof)
+}
+
+// ILyrionBridgeBatchChallengedIterator is returned from FilterBatchChallenged and is used to iterate over the raw logs and unpacked data for BatchChallenged events raised by the ILyrionBridge contract.
+type ILyrionBridgeBatchChallengedIterator struct {
+	Event *ILyrionBridgeBatchChallenged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ILyrionBridgeBatchChallengedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ILyrionBridgeBatchChallenged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ILyrionBridgeBatchChallenged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ILyrionBridgeBatchChallengedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ILyrionBridgeBatchChallengedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ILyrionBridgeBatchChallenged represents a BatchChallenged event raised by the ILyrionBridge contract.
+type ILyrionBridgeBatchChallenged struct {
+	BatchNumber *big.Int
+	Challenger  common.Address
+	ClaimedRoot [32]byte
+	DerivedRoot [32]byte
+	ProofHash   [32]byte
+	Timestamp   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchChallenged is a free log retrieval operation binding the contract event 0x95d903dd09c74748599bd1d37b2e4f8fb33bc193e23f59dff52607618817c119.
+//
+// Solidity: event BatchChallenged(uint256 indexed batchNumber, address indexed challenger, bytes32 claimedRoot, bytes32 derivedRoot, bytes32 proofHash, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) FilterBatchChallenged(opts *bind.FilterOpts, batchNumber []*big.Int, challenger []common.Address) (*ILyrionBridgeBatchChallengedIterator, error) {
+
+	var batchNumberRule []interface{}
+	for _, batchNumberItem := range batchNumber {
+		batchNumberRule = append(batchNumberRule, batchNumberItem)
+	}
+	var challengerRule []interface{}
+	for _, challengerItem := range challenger {
+		challengerRule = append(challengerRule, challengerItem)
+	}
+
+	logs, sub, err := _ILyrionBridge.contract.FilterLogs(opts, "BatchChallenged", batchNumberRule, challengerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ILyrionBridgeBatchChallengedIterator{contract: _ILyrionBridge.contract, event: "BatchChallenged", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchChallenged is a free log subscription operation binding the contract event 0x95d903dd09c74748599bd1d37b2e4f8fb33bc193e23f59dff52607618817c119.
+//
+// Solidity: event BatchChallenged(uint256 indexed batchNumber, address indexed challenger, bytes32 claimedRoot, bytes32 derivedRoot, bytes32 proofHash, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) WatchBatchChallenged(opts *bind.WatchOpts, sink chan<- *ILyrionBridgeBatchChallenged, batchNumber []*big.Int, challenger []common.Address) (event.Subscription, error) {
+
+	var batchNumberRule []interface{}
+	for _, batchNumberItem := range batchNumber {
+		batchNumberRule = append(batchNumberRule, batchNumberItem)
+	}
+	var challengerRule []interface{}
+	for _, challengerItem := range challenger {
+		challengerRule = append(challengerRule, challengerItem)
+	}
+
+	logs, sub, err := _ILyrionBridge.contract.WatchLogs(opts, "BatchChallenged", batchNumberRule, challengerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ILyrionBridgeBatchChallenged)
+				if err := _ILyrionBridge.contract.UnpackLog(event, "BatchChallenged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchChallenged is a log parse operation binding the contract event 0x95d903dd09c74748599bd1d37b2e4f8fb33bc193e23f59dff52607618817c119.
+//
+// Solidity: event BatchChallenged(uint256 indexed batchNumber, address indexed challenger, bytes32 claimedRoot, bytes32 derivedRoot, bytes32 proofHash, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) ParseBatchChallenged(log types.Log) (*ILyrionBridgeBatchChallenged, error) {
+	event := new(ILyrionBridgeBatchChallenged)
+	if err := _ILyrionBridge.contract.UnpackLog(event, "BatchChallenged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ILyrionBridgeBatchSubmittedIterator is returned from FilterBatchSubmitted and is used to iterate over the raw logs and unpacked data for BatchSubmitted events raised by the ILyrionBridge contract.
+type ILyrionBridgeBatchSubmittedIterator struct {
+	Event *ILyrionBridgeBatchSubmitted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ILyrionBridgeBatchSubmittedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ILyrionBridgeBatchSubmitted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ILyrionBridgeBatchSubmitted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ILyrionBridgeBatchSubmittedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ILyrionBridgeBatchSubmittedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ILyrionBridgeBatchSubmitted represents a BatchSubmitted event raised by the ILyrionBridge contract.
+type ILyrionBridgeBatchSubmitted struct {
+	BatchNumber *big.Int
+	StateRoot   [32]byte
+	StartBlock  *big.Int
+	EndBlock    *big.Int
+	TxCount     *big.Int
+	Timestamp   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchSubmitted is a free log retrieval operation binding the contract event 0x141f97b4e0ba9904418421c179e4315d00515f3906bbb4d48e7e197cc0488389.
+//
+// Solidity: event BatchSubmitted(uint256 indexed batchNumber, bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) FilterBatchSubmitted(opts *bind.FilterOpts, batchNumber []*big.Int) (*ILyrionBridgeBatchSubmittedIterator, error) {
+
+	var batchNumberRule []interface{}
+	for _, batchNumberItem := range batchNumber {
+		batchNumberRule = append(batchNumberRule, batchNumberItem)
+	}
+
+	logs, sub, err := _ILyrionBridge.contract.FilterLogs(opts, "BatchSubmitted", batchNumberRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ILyrionBridgeBatchSubmittedIterator{contract: _ILyrionBridge.contract, event: "BatchSubmitted", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchSubmitted is a free log subscription operation binding the contract event 0x141f97b4e0ba9904418421c179e4315d00515f3906bbb4d48e7e197cc0488389.
+//
+// Solidity: event BatchSubmitted(uint256 indexed batchNumber, bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) WatchBatchSubmitted(opts *bind.WatchOpts, sink chan<- *ILyrionBridgeBatchSubmitted, batchNumber []*big.Int) (event.Subscription, error) {
+
+	var batchNumberRule []interface{}
+	for _, batchNumberItem := range batchNumber {
+		batchNumberRule = append(batchNumberRule, batchNumberItem)
+	}
+
+	logs, sub, err := _ILyrionBridge.contract.WatchLogs(opts, "BatchSubmitted", batchNumberRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ILyrionBridgeBatchSubmitted)
+				if err := _ILyrionBridge.contract.UnpackLog(event, "BatchSubmitted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchSubmitted is a log parse operation binding the contract event 0x141f97b4e0ba9904418421c179e4315d00515f3906bbb4d48e7e197cc0488389.
+//
+// Solidity: event BatchSubmitted(uint256 indexed batchNumber, bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) ParseBatchSubmitted(log types.Log) (*ILyrionBridgeBatchSubmitted, error) {
+	event := new(ILyrionBridgeBatchSubmitted)
+	if err := _ILyrionBridge.contract.UnpackLog(event, "BatchSubmitted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ILyrionBridgeDepositInitiatedIterator is returned from FilterDepositInitiated and is used to iterate over the raw logs and unpacked data for DepositInitiated events raised by the ILyrionBridge contract.
+type ILyrionBridgeDepositInitiatedIterator struct {
+	Event *ILyrionBridgeDepositInitiated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ILyrionBridgeDepositInitiatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ILyrionBridgeDepositInitiated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ILyrionBridgeDepositInitiated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ILyrionBridgeDepositInitiatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ILyrionBridgeDepositInitiatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ILyrionBridgeDepositInitiated represents a DepositInitiated event raised by the ILyrionBridge contract.
+type ILyrionBridgeDepositInitiated struct {
+	Nonce     *big.Int
+	Sender    common.Address
+	Recipient common.Address
+	Amount    *big.Int
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDepositInitiated is a free log retrieval operation binding the contract event 0xb52dc1daa5b1965789550d1d40788f98710fd77ea436c94881031390d1830a1f.
+//
+// Solidity: event DepositInitiated(uint256 indexed nonce, address indexed sender, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) FilterDepositInitiated(opts *bind.FilterOpts, nonce []*big.Int, sender []common.Address, recipient []common.Address) (*ILyrionBridgeDepositInitiatedIterator, error) {
+
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ILyrionBridge.contract.FilterLogs(opts, "DepositInitiated", nonceRule, senderRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ILyrionBridgeDepositInitiatedIterator{contract: _ILyrionBridge.contract, event: "DepositInitiated", logs: logs, sub: sub}, nil
+}
+
+// WatchDepositInitiated is a free log subscription operation binding the contract event 0xb52dc1daa5b1965789550d1d40788f98710fd77ea436c94881031390d1830a1f.
+//
+// Solidity: event DepositInitiated(uint256 indexed nonce, address indexed sender, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) WatchDepositInitiated(opts *bind.WatchOpts, sink chan<- *ILyrionBridgeDepositInitiated, nonce []*big.Int, sender []common.Address, recipient []common.Address) (event.Subscription, error) {
+
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ILyrionBridge.contract.WatchLogs(opts, "DepositInitiated", nonceRule, senderRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ILyrionBridgeDepositInitiated)
+				if err := _ILyrionBridge.contract.UnpackLog(event, "DepositInitiated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDepositInitiated is a log parse operation binding the contract event 0xb52dc1daa5b1965789550d1d40788f98710fd77ea436c94881031390d1830a1f.
+//
+// Solidity: event DepositInitiated(uint256 indexed nonce, address indexed sender, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) ParseDepositInitiated(log types.Log) (*ILyrionBridgeDepositInitiated, error) {
+	event := new(ILyrionBridgeDepositInitiated)
+	if err := _ILyrionBridge.contract.UnpackLog(event, "DepositInitiated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ILyrionBridgeWithdrawalCompletedIterator is returned from FilterWithdrawalCompleted and is used to iterate over the raw logs and unpacked data for WithdrawalCompleted events raised by the ILyrionBridge contract.
+type ILyrionBridgeWithdrawalCompletedIterator struct {
+	Event *ILyrionBridgeWithdrawalCompleted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ILyrionBridgeWithdrawalCompletedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ILyrionBridgeWithdrawalCompleted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ILyrionBridgeWithdrawalCompleted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ILyrionBridgeWithdrawalCompletedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ILyrionBridgeWithdrawalCompletedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ILyrionBridgeWithdrawalCompleted represents a WithdrawalCompleted event raised by the ILyrionBridge contract.
+type ILyrionBridgeWithdrawalCompleted struct {
+	WithdrawalHash [32]byte
+	Recipient      common.Address
+	Amount         *big.Int
+	Timestamp      *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawalCompleted is a free log retrieval operation binding the contract event 0x8ce662b30f4d58ce2891162a6dbfe1ab72169bb7e9117b9527cfeaa897386ac6.
+//
+// Solidity: event WithdrawalCompleted(bytes32 indexed withdrawalHash, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) FilterWithdrawalCompleted(opts *bind.FilterOpts, withdrawalHash [][32]byte, recipient []common.Address) (*ILyrionBridgeWithdrawalCompletedIterator, error) {
+
+	var withdrawalHashRule []interface{}
+	for _, withdrawalHashItem := range withdrawalHash {
+		withdrawalHashRule = append(withdrawalHashRule, withdrawalHashItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ILyrionBridge.contract.FilterLogs(opts, "WithdrawalCompleted", withdrawalHashRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ILyrionBridgeWithdrawalCompletedIterator{contract: _ILyrionBridge.contract, event: "WithdrawalCompleted", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawalCompleted is a free log subscription operation binding the contract event 0x8ce662b30f4d58ce2891162a6dbfe1ab72169bb7e9117b9527cfeaa897386ac6.
+//
+// Solidity: event WithdrawalCompleted(bytes32 indexed withdrawalHash, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) WatchWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *ILyrionBridgeWithdrawalCompleted, withdrawalHash [][32]byte, recipient []common.Address) (event.Subscription, error) {
+
+	var withdrawalHashRule []interface{}
+	for _, withdrawalHashItem := range withdrawalHash {
+		withdrawalHashRule = append(withdrawalHashRule, withdrawalHashItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ILyrionBridge.contract.WatchLogs(opts, "WithdrawalCompleted", withdrawalHashRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ILyrionBridgeWithdrawalCompleted)
+				if err := _ILyrionBridge.contract.UnpackLog(event, "WithdrawalCompleted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawalCompleted is a log parse operation binding the contract event 0x8ce662b30f4d58ce2891162a6dbfe1ab72169bb7e9117b9527cfeaa897386ac6.
+//
+// Solidity: event WithdrawalCompleted(bytes32 indexed withdrawalHash, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_ILyrionBridge *ILyrionBridgeFilterer) ParseWithdrawalCompleted(log types.Log) (*ILyrionBridgeWithdrawalCompleted, error) {
+	event := new(ILyrionBridgeWithdrawalCompleted)
+	if err := _ILyrionBridge.contract.UnpackLog(event, "WithdrawalCompleted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
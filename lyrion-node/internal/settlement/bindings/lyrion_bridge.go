@@ -0,0 +1,2210 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LyrionBridgeMetaData contains all meta data concerning the LyrionBridge contract.
+var LyrionBridgeMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_sequencer\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"depositToL2\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"depositToL2\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"submitBatch\",\"inputs\":[{\"name\":\"stateRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"startBlock\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"endBlock\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"txCount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawFromL2\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"proof\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"challengeBatch\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"derivedRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"proofHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"batchStateRoots\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"batchSubmissionTime\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"currentBatchNumber\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isBatchFinalized\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBatchInfo\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"stateRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"submissionTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"isFinalized\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBridgeStats\",\"inputs\":[],\"outputs\":[{\"name\":\"_currentBatchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_totalDeposited\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_totalWithdrawn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_bridgeBalance\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_depositNonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"processedDeposits\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"processedWithdrawals\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"challengePeriod\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"sequencer\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalDeposited\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalWithdrawn\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"depositNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"challengers\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"batchInvalidations\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setSequencer\",\"inputs\":[{\"name\":\"_sequencer\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setChallengePeriod\",\"inputs\":[{\"name\":\"_period\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setChallenger\",\"inputs\":[{\"name\":\"challenger\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"allowed\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"emergencyWithdraw\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"DepositInitiated\",\"inputs\":[{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":true},{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchSubmitted\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":true},{\"name\":\"stateRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":false},{\"name\":\"startBlock\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"endBlock\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"txCount\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"WithdrawalCompleted\",\"inputs\":[{\"name\":\"withdrawalHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SequencerUpdated\",\"inputs\":[{\"name\":\"oldSequencer\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"newSequencer\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ChallengePeriodUpdated\",\"inputs\":[{\"name\":\"oldPeriod\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"newPeriod\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchChallenged\",\"inputs\":[{\"name\":\"batchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":true},{\"name\":\"challenger\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"claimedRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":false},{\"name\":\"derivedRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":false},{\"name\":\"proofHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":false},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ChallengerUpdated\",\"inputs\":[{\"name\":\"challenger\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"allowed\",\"type\":\"bool\",\"internalType\":\"bool\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidSequencer\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidAmount\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidProof\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"DepositAlreadyProcessed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"WithdrawalAlreadyProcessed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"BatchNotFinalized\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientBridgeBalance\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TransferFailed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotChallenger\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"BatchNotChallengeable\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]}]",
+	Bin: "0x341561000b5760006000fd5b60206020380360803960805160a01c1515156100275760006000fd5b336000553360007f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e06000610300a3600160015561025860075560805160085560805160007fcd58b762453bd126b48db83f2cecd464f5281dd7e5e6824b528c09d0482984d66000610300a3610f346100bb600039610f346000f35b634e487b7160e01b610300526011610304526024610300fd6004361061013c5760003560e01c8063ff04f12c1461014b5780635823d45b146102185780634d326825146102d0578063f809ad3914610397578063fb9e186a146106465780632f9582bd14610808578063155366b31461083f578063b6eeba3114610876578063bf49d631146108ad578063f48fa80b146108e4578063f3f480d91461090d5780635c1bba3814610936578063ff50abdc1461095f5780634b31971314610988578063de35f5cb146109b1578063cfea71c0146109da57806301cf261114610a24578063116a1f4214610a5b5780631a0058f514610ac45780632165cbb714610b575780632547fa3e14610b9a5780635d475fdd14610c2657806392b5d19014610ca757806395ccea6714610d515780638da5cb5b14610dcd578063715018a614610df6578063f2fde38b14610e6d5760006000fd5b36151561014557005b60006000fd5b602436101561015a5760006000fd5b60043560a01c15151561016d5760006000fd5b6002600154141561018b57633ee5aeb560e01b610300526004610300fd5b60026001553415156101aa57632c5211c660e01b610300526004610300fd5b600b546080526001608051818101808211610f1b57915050600b5534600954818101808211610f1b5791505060095534610300524261032052600435336080517fb52dc1daa5b1965789550d1d40788f98710fd77ea436c94881031390d1830a1f6040610300a46001600155005b60043610156102275760006000fd5b6002600154141561024557633ee5aeb560e01b610300526004610300fd5b600260015534151561026457632c5211c660e01b610300526004610300fd5b600b546080526001608051818101808211610f1b57915050600b5534600954818101808211610f1b579150506009553461030052426103205233336080517fb52dc1daa5b1965789550d1d40788f98710fd77ea436c94881031390d1830a1f6040610300a46001600155005b34156102dc5760006000fd5b60843610156102eb5760006000fd5b600854331415156103095763f27e160c60e01b610300526004610300fd5b6001600654818101808211610f1b5791505060805260805160065560043560805160005260026020526040600020554260805160005260036020526040600020556004356103005260243561032052604435610340526064356103605242610380526080517f141f97b4e0ba9904418421c179e4315d00515f3906bbb4d48e7e197cc048838960a0610300a2005b34156103a35760006000fd5b60843610156103b25760006000fd5b60243560a01c1515156103c55760006000fd5b63ffffffff60643511156103d95760006000fd5b606435600401356101005263ffffffff6101005111156103f95760006000fd5b60643560240160e052366101005160200260e05101111561041a5760006000fd5b6002600154141561043857633ee5aeb560e01b610300526004610300fd5b600260015560043560005260036020526040600020546080526080511561047757600754608051818101808211610f1b5791505042101560805261047d565b60006080525b608051151561049957635565870f60e01b610300526004610300fd5b60243561021452600435610200526044356102345260546102002060a05260a0516000526005602052604060002054156104e05763395c1f1160e01b610300526004610300fd5b6024356101f4526044356102145260346102002060c0526000610120525b61010051610120511015610569576101205160200260e051013560c051101561053b5760c0516000526101205160200260e0510135602052610551565b6101205160200260e051013560005260c0516020525b604060002060c05260016101205101610120526104fe565b600435600052600260205260406000205460c051141515610597576309bde33960e01b610300526004610300fd5b6044354710156105b45763bc73460460e01b610300526004610300fd5b600160a0516000526005602052604060002055604435600a54818101808211610f1b57915050600a5560006000600060006044356024355af11515610606576390b8ec1860e01b610300526004610300fd5b60443561030052426103205260243560a0517f8ce662b30f4d58ce2891162a6dbfe1ab72169bb7e9117b9527cfeaa897386ac66040610300a36001600155005b34156106525760006000fd5b60643610156106615760006000fd5b33600052600c60205260406000205415156106895763d44be46e60e01b610300526004610300fd5b60043515156106a557635439911260e01b610300526004610300fd5b60065460043511156106c457635439911260e01b610300526004610300fd5b6004356000526003602052604060002054608052608051156106fe57600754608051818101808211610f1b57915050421015608052610704565b60006080525b6080511561071f57635439911260e01b610300526004610300fd5b600435600052600260205260406000205460a05260043560c0525b60065460c0511115156107b857600060c0516000526002602052604060002055600060c0516000526003602052604060002055600160c051600052600d602052604060002054818101808211610f1b5791505060c051600052600d602052604060002055600160c051818101808211610f1b5791505060c05261073a565b60016004350360065560a0516103005260243561032052604435610340524261036052336004357f95d903dd09c74748599bd1d37b2e4f8fb33bc193e23f59dff52607618817c1196080610300a3005b34156108145760006000fd5b60243610156108235760006000fd5b6004356000526002602052604060002054610300526020610300f35b341561084b5760006000fd5b602436101561085a5760006000fd5b6004356000526003602052604060002054610300526020610300f35b34156108825760006000fd5b60243610156108915760006000fd5b6004356000526004602052604060002054610300526020610300f35b34156108b95760006000fd5b60243610156108c85760006000fd5b6004356000526005602052604060002054610300526020610300f35b34156108f05760006000fd5b60043610156108ff5760006000fd5b600654610300526020610300f35b34156109195760006000fd5b60043610156109285760006000fd5b600754610300526020610300f35b34156109425760006000fd5b60043610156109515760006000fd5b600854610300526020610300f35b341561096b5760006000fd5b600436101561097a5760006000fd5b600954610300526020610300f35b34156109945760006000fd5b60043610156109a35760006000fd5b600a54610300526020610300f35b34156109bd5760006000fd5b60043610156109cc5760006000fd5b600b54610300526020610300f35b34156109e65760006000fd5b60243610156109f55760006000fd5b60043560a01c151515610a085760006000fd5b600435600052600c602052604060002054610300526020610300f35b3415610a305760006000fd5b6024361015610a3f5760006000fd5b600435600052600d602052604060002054610300526020610300f35b3415610a675760006000fd5b6024361015610a765760006000fd5b600435600052600360205260406000205460805260805115610ab057600754608051818101808211610f1b57915050421015608052610ab6565b60006080525b608051610300526020610300f35b3415610ad05760006000fd5b6024361015610adf5760006000fd5b600435600052600360205260406000205460805260805115610b1957600754608051818101808211610f1b57915050421015608052610b1f565b60006080525b600435600052600260205260406000205461030052600435600052600360205260406000205461032052608051610340526060610300f35b3415610b635760006000fd5b6004361015610b725760006000fd5b6006546103005260095461032052600a54610340524761036052600b546103805260a0610300f35b3415610ba65760006000fd5b6024361015610bb55760006000fd5b60043560a01c151515610bc85760006000fd5b60005433141515610beb5763118cdaa760e01b6103005233610304526024610300fd5b6008546080526004356008556004356080517fcd58b762453bd126b48db83f2cecd464f5281dd7e5e6824b528c09d0482984d66000610300a3005b3415610c325760006000fd5b6024361015610c415760006000fd5b60005433141515610c645763118cdaa760e01b6103005233610304526024610300fd5b60075460805260043560075560805161030052600435610320527f6faeedb0dbe08f71a52ddff592571aafec07972ce3a25c4a30e6b161329466926040610300a1005b3415610cb35760006000fd5b6044361015610cc25760006000fd5b60043560a01c151515610cd55760006000fd5b6002602435101515610ce75760006000fd5b60005433141515610d0a5763118cdaa760e01b6103005233610304526024610300fd5b602435600435600052600c602052604060002055602435610300526004357f32bae78da1582e04b3d20a2d58c706339a9fe9531d524129ab14e0979dc1ca9c6020610300a2005b3415610d5d5760006000fd5b6044361015610d6c5760006000fd5b60043560a01c151515610d7f5760006000fd5b60005433141515610da25763118cdaa760e01b6103005233610304526024610300fd5b60006000600060006024356004355af11515610dcb576390b8ec1860e01b610300526004610300fd5b005b3415610dd95760006000fd5b6004361015610de85760006000fd5b600054610300526020610300f35b3415610e025760006000fd5b6004361015610e115760006000fd5b60005433141515610e345763118cdaa760e01b6103005233610304526024610300fd5b600054608052600060005560006080517f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e06000610300a3005b3415610e795760006000fd5b6024361015610e885760006000fd5b60043560a01c151515610e9b5760006000fd5b60005433141515610ebe5763118cdaa760e01b6103005233610304526024610300fd5b6004351515610ee057631e4fbdf760e01b610300526000610304526024610300fd5b6000546080526004356000556004356080517f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e06000610300a3005b634e487b7160e01b610300526011610304526024610300fd",
+}
+
+// LyrionBridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use LyrionBridgeMetaData.ABI instead.
+var LyrionBridgeABI = LyrionBridgeMetaData.ABI
+
+// LyrionBridgeBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use LyrionBridgeMetaData.Bin instead.
+var LyrionBridgeBin = LyrionBridgeMetaData.Bin
+
+// DeployLyrionBridge deploys a new Ethereum contract, binding an instance of LyrionBridge to it.
+func DeployLyrionBridge(auth *bind.TransactOpts, backend bind.ContractBackend, _sequencer common.Address) (common.Address, *types.Transaction, *LyrionBridge, error) {
+	parsed, err := LyrionBridgeMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(LyrionBridgeBin), backend, _sequencer)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &LyrionBridge{LyrionBridgeCaller: LyrionBridgeCaller{contract: contract}, LyrionBridgeTransactor: LyrionBridgeTransactor{contract: contract}, LyrionBridgeFilterer: LyrionBridgeFilterer{contract: contract}}, nil
+}
+
+// LyrionBridge is an auto generated Go binding around an Ethereum contract.
+type LyrionBridge struct {
+	LyrionBridgeCaller     // Read-only binding to the contract
+	LyrionBridgeTransactor // Write-only binding to the contract
+	LyrionBridgeFilterer   // Log filterer for contract events
+}
+
+// LyrionBridgeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type LyrionBridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LyrionBridgeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type LyrionBridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LyrionBridgeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type LyrionBridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LyrionBridgeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type LyrionBridgeSession struct {
+	Contract     *LyrionBridge     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// LyrionBridgeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type LyrionBridgeCallerSession struct {
+	Contract *LyrionBridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// LyrionBridgeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type LyrionBridgeTransactorSession struct {
+	Contract     *LyrionBridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// LyrionBridgeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type LyrionBridgeRaw struct {
+	Contract *LyrionBridge // Generic contract binding to access the raw methods on
+}
+
+// LyrionBridgeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type LyrionBridgeCallerRaw struct {
+	Contract *LyrionBridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// LyrionBridgeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type LyrionBridgeTransactorRaw struct {
+	Contract *LyrionBridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewLyrionBridge creates a new instance of LyrionBridge, bound to a specific deployed contract.
+func NewLyrionBridge(address common.Address, backend bind.ContractBackend) (*LyrionBridge, error) {
+	contract, err := bindLyrionBridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridge{LyrionBridgeCaller: LyrionBridgeCaller{contract: contract}, LyrionBridgeTransactor: LyrionBridgeTransactor{contract: contract}, LyrionBridgeFilterer: LyrionBridgeFilterer{contract: contract}}, nil
+}
+
+// NewLyrionBridgeCaller creates a new read-only instance of LyrionBridge, bound to a specific deployed contract.
+func NewLyrionBridgeCaller(address common.Address, caller bind.ContractCaller) (*LyrionBridgeCaller, error) {
+	contract, err := bindLyrionBridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeCaller{contract: contract}, nil
+}
+
+// NewLyrionBridgeTransactor creates a new write-only instance of LyrionBridge, bound to a specific deployed contract.
+func NewLyrionBridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*LyrionBridgeTransactor, error) {
+	contract, err := bindLyrionBridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeTransactor{contract: contract}, nil
+}
+
+// NewLyrionBridgeFilterer creates a new log filterer instance of LyrionBridge, bound to a specific deployed contract.
+func NewLyrionBridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*LyrionBridgeFilterer, error) {
+	contract, err := bindLyrionBridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeFilterer{contract: contract}, nil
+}
+
+// bindLyrionBridge binds a generic wrapper to an already deployed contract.
+func bindLyrionBridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := LyrionBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LyrionBridge *LyrionBridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LyrionBridge.Contract.LyrionBridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LyrionBridge *LyrionBridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.LyrionBridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LyrionBridge *LyrionBridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.LyrionBridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LyrionBridge *LyrionBridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LyrionBridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LyrionBridge *LyrionBridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LyrionBridge *LyrionBridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// BatchInvalidations is a free data retrieval call binding the contract method 0x01cf2611.
+//
+// Solidity: function batchInvalidations(uint256 ) view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCaller) BatchInvalidations(opts *bind.CallOpts, arg0 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "batchInvalidations", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BatchInvalidations is a free data retrieval call binding the contract method 0x01cf2611.
+//
+// Solidity: function batchInvalidations(uint256 ) view returns(uint256)
+func (_LyrionBridge *LyrionBridgeSession) BatchInvalidations(arg0 *big.Int) (*big.Int, error) {
+	return _LyrionBridge.Contract.BatchInvalidations(&_LyrionBridge.CallOpts, arg0)
+}
+
+// BatchInvalidations is a free data retrieval call binding the contract method 0x01cf2611.
+//
+// Solidity: function batchInvalidations(uint256 ) view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCallerSession) BatchInvalidations(arg0 *big.Int) (*big.Int, error) {
+	return _LyrionBridge.Contract.BatchInvalidations(&_LyrionBridge.CallOpts, arg0)
+}
+
+// BatchStateRoots is a free data retrieval call binding the contract method 0x2f9582bd.
+//
+// Solidity: function batchStateRoots(uint256 batchNumber) view returns(bytes32)
+func (_LyrionBridge *LyrionBridgeCaller) BatchStateRoots(opts *bind.CallOpts, batchNumber *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "batchStateRoots", batchNumber)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BatchStateRoots is a free data retrieval call binding the contract method 0x2f9582bd.
+//
+// Solidity: function batchStateRoots(uint256 batchNumber) view returns(bytes32)
+func (_LyrionBridge *LyrionBridgeSession) BatchStateRoots(batchNumber *big.Int) ([32]byte, error) {
+	return _LyrionBridge.Contract.BatchStateRoots(&_LyrionBridge.CallOpts, batchNumber)
+}
+
+// BatchStateRoots is a free data retrieval call binding the contract method 0x2f9582bd.
+//
+// Solidity: function batchStateRoots(uint256 batchNumber) view returns(bytes32)
+func (_LyrionBridge *LyrionBridgeCallerSession) BatchStateRoots(batchNumber *big.Int) ([32]byte, error) {
+	return _LyrionBridge.Contract.BatchStateRoots(&_LyrionBridge.CallOpts, batchNumber)
+}
+
+// BatchSubmissionTime is a free data retrieval call binding the contract method 0x155366b3.
+//
+// Solidity: function batchSubmissionTime(uint256 batchNumber) view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCaller) BatchSubmissionTime(opts *bind.CallOpts, batchNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "batchSubmissionTime", batchNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BatchSubmissionTime is a free data retrieval call binding the contract method 0x155366b3.
+//
+// Solidity: function batchSubmissionTime(uint256 batchNumber) view returns(uint256)
+func (_LyrionBridge *LyrionBridgeSession) BatchSubmissionTime(batchNumber *big.Int) (*big.Int, error) {
+	return _LyrionBridge.Contract.BatchSubmissionTime(&_LyrionBridge.CallOpts, batchNumber)
+}
+
+// BatchSubmissionTime is a free data retrieval call binding the contract method 0x155366b3.
+//
+// Solidity: function batchSubmissionTime(uint256 batchNumber) view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCallerSession) BatchSubmissionTime(batchNumber *big.Int) (*big.Int, error) {
+	return _LyrionBridge.Contract.BatchSubmissionTime(&_LyrionBridge.CallOpts, batchNumber)
+}
+
+// ChallengePeriod is a free data retrieval call binding the contract method 0xf3f480d9.
+//
+// Solidity: function challengePeriod() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCaller) ChallengePeriod(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "challengePeriod")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ChallengePeriod is a free data retrieval call binding the contract method 0xf3f480d9.
+//
+// Solidity: function challengePeriod() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeSession) ChallengePeriod() (*big.Int, error) {
+	return _LyrionBridge.Contract.ChallengePeriod(&_LyrionBridge.CallOpts)
+}
+
+// ChallengePeriod is a free data retrieval call binding the contract method 0xf3f480d9.
+//
+// Solidity: function challengePeriod() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCallerSession) ChallengePeriod() (*big.Int, error) {
+	return _LyrionBridge.Contract.ChallengePeriod(&_LyrionBridge.CallOpts)
+}
+
+// Challengers is a free data retrieval call binding the contract method 0xcfea71c0.
+//
+// Solidity: function challengers(address ) view returns(bool)
+func (_LyrionBridge *LyrionBridgeCaller) Challengers(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "challengers", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Challengers is a free data retrieval call binding the contract method 0xcfea71c0.
+//
+// Solidity: function challengers(address ) view returns(bool)
+func (_LyrionBridge *LyrionBridgeSession) Challengers(arg0 common.Address) (bool, error) {
+	return _LyrionBridge.Contract.Challengers(&_LyrionBridge.CallOpts, arg0)
+}
+
+// Challengers is a free data retrieval call binding the contract method 0xcfea71c0.
+//
+// Solidity: function challengers(address ) view returns(bool)
+func (_LyrionBridge *LyrionBridgeCallerSession) Challengers(arg0 common.Address) (bool, error) {
+	return _LyrionBridge.Contract.Challengers(&_LyrionBridge.CallOpts, arg0)
+}
+
+// CurrentBatchNumber is a free data retrieval call binding the contract method 0xf48fa80b.
+//
+// Solidity: function currentBatchNumber() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCaller) CurrentBatchNumber(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "currentBatchNumber")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CurrentBatchNumber is a free data retrieval call binding the contract method 0xf48fa80b.
+//
+// Solidity: function currentBatchNumber() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeSession) CurrentBatchNumber() (*big.Int, error) {
+	return _LyrionBridge.Contract.CurrentBatchNumber(&_LyrionBridge.CallOpts)
+}
+
+// CurrentBatchNumber is a free data retrieval call binding the contract method 0xf48fa80b.
+//
+// Solidity: function currentBatchNumber() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCallerSession) CurrentBatchNumber() (*big.Int, error) {
+	return _LyrionBridge.Contract.CurrentBatchNumber(&_LyrionBridge.CallOpts)
+}
+
+// DepositNonce is a free data retrieval call binding the contract method 0xde35f5cb.
+//
+// Solidity: function depositNonce() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCaller) DepositNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "depositNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DepositNonce is a free data retrieval call binding the contract method 0xde35f5cb.
+//
+// Solidity: function depositNonce() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeSession) DepositNonce() (*big.Int, error) {
+	return _LyrionBridge.Contract.DepositNonce(&_LyrionBridge.CallOpts)
+}
+
+// DepositNonce is a free data retrieval call binding the contract method 0xde35f5cb.
+//
+// Solidity: function depositNonce() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCallerSession) DepositNonce() (*big.Int, error) {
+	return _LyrionBridge.Contract.DepositNonce(&_LyrionBridge.CallOpts)
+}
+
+// GetBatchInfo is a free data retrieval call binding the contract method 0x1a0058f5.
+//
+// Solidity: function getBatchInfo(uint256 batchNumber) view returns(bytes32 stateRoot, uint256 submissionTime, bool isFinalized)
+func (_LyrionBridge *LyrionBridgeCaller) GetBatchInfo(opts *bind.CallOpts, batchNumber *big.Int) (struct {
+	StateRoot      [32]byte
+	SubmissionTime *big.Int
+	IsFinalized    bool
+}, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "getBatchInfo", batchNumber)
+
+	outstruct := new(struct {
+		StateRoot      [32]byte
+		SubmissionTime *big.Int
+		IsFinalized    bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StateRoot = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.SubmissionTime = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.IsFinalized = *abi.ConvertType(out[2], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetBatchInfo is a free data retrieval call binding the contract method 0x1a0058f5.
+//
+// Solidity: function getBatchInfo(uint256 batchNumber) view returns(bytes32 stateRoot, uint256 submissionTime, bool isFinalized)
+func (_LyrionBridge *LyrionBridgeSession) GetBatchInfo(batchNumber *big.Int) (struct {
+	StateRoot      [32]byte
+	SubmissionTime *big.Int
+	IsFinalized    bool
+}, error) {
+	return _LyrionBridge.Contract.GetBatchInfo(&_LyrionBridge.CallOpts, batchNumber)
+}
+
+// GetBatchInfo is a free data retrieval call binding the contract method 0x1a0058f5.
+//
+// Solidity: function getBatchInfo(uint256 batchNumber) view returns(bytes32 stateRoot, uint256 submissionTime, bool isFinalized)
+func (_LyrionBridge *LyrionBridgeCallerSession) GetBatchInfo(batchNumber *big.Int) (struct {
+	StateRoot      [32]byte
+	SubmissionTime *big.Int
+	IsFinalized    bool
+}, error) {
+	return _LyrionBridge.Contract.GetBatchInfo(&_LyrionBridge.CallOpts, batchNumber)
+}
+
+// GetBridgeStats is a free data retrieval call binding the contract method 0x2165cbb7.
+//
+// Solidity: function getBridgeStats() view returns(uint256 _currentBatchNumber, uint256 _totalDeposited, uint256 _totalWithdrawn, uint256 _bridgeBalance, uint256 _depositNonce)
+func (_LyrionBridge *LyrionBridgeCaller) GetBridgeStats(opts *bind.CallOpts) (struct {
+	CurrentBatchNumber *big.Int
+	TotalDeposited     *big.Int
+	TotalWithdrawn     *big.Int
+	BridgeBalance      *big.Int
+	DepositNonce       *big.Int
+}, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "getBridgeStats")
+
+	outstruct := new(struct {
+		CurrentBatchNumber *big.Int
+		TotalDeposited     *big.Int
+		TotalWithdrawn     *big.Int
+		BridgeBalance      *big.Int
+		DepositNonce       *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.CurrentBatchNumber = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.TotalDeposited = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.TotalWithdrawn = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.BridgeBalance = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.DepositNonce = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetBridgeStats is a free data retrieval call binding the contract method 0x2165cbb7.
+//
+// Solidity: function getBridgeStats() view returns(uint256 _currentBatchNumber, uint256 _totalDeposited, uint256 _totalWithdrawn, uint256 _bridgeBalance, uint256 _depositNonce)
+func (_LyrionBridge *LyrionBridgeSession) GetBridgeStats() (struct {
+	CurrentBatchNumber *big.Int
+	TotalDeposited     *big.Int
+	TotalWithdrawn     *big.Int
+	BridgeBalance      *big.Int
+	DepositNonce       *big.Int
+}, error) {
+	return _LyrionBridge.Contract.GetBridgeStats(&_LyrionBridge.CallOpts)
+}
+
+// GetBridgeStats is a free data retrieval call binding the contract method 0x2165cbb7.
+//
+// Solidity: function getBridgeStats() view returns(uint256 _currentBatchNumber, uint256 _totalDeposited, uint256 _totalWithdrawn, uint256 _bridgeBalance, uint256 _depositNonce)
+func (_LyrionBridge *LyrionBridgeCallerSession) GetBridgeStats() (struct {
+	CurrentBatchNumber *big.Int
+	TotalDeposited     *big.Int
+	TotalWithdrawn     *big.Int
+	BridgeBalance      *big.Int
+	DepositNonce       *big.Int
+}, error) {
+	return _LyrionBridge.Contract.GetBridgeStats(&_LyrionBridge.CallOpts)
+}
+
+// IsBatchFinalized is a free data retrieval call binding the contract method 0x116a1f42.
+//
+// Solidity: function isBatchFinalized(uint256 batchNumber) view returns(bool)
+func (_LyrionBridge *LyrionBridgeCaller) IsBatchFinalized(opts *bind.CallOpts, batchNumber *big.Int) (bool, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "isBatchFinalized", batchNumber)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsBatchFinalized is a free data retrieval call binding the contract method 0x116a1f42.
+//
+// Solidity: function isBatchFinalized(uint256 batchNumber) view returns(bool)
+func (_LyrionBridge *LyrionBridgeSession) IsBatchFinalized(batchNumber *big.Int) (bool, error) {
+	return _LyrionBridge.Contract.IsBatchFinalized(&_LyrionBridge.CallOpts, batchNumber)
+}
+
+// IsBatchFinalized is a free data retrieval call binding the contract method 0x116a1f42.
+//
+// Solidity: function isBatchFinalized(uint256 batchNumber) view returns(bool)
+func (_LyrionBridge *LyrionBridgeCallerSession) IsBatchFinalized(batchNumber *big.Int) (bool, error) {
+	return _LyrionBridge.Contract.IsBatchFinalized(&_LyrionBridge.CallOpts, batchNumber)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LyrionBridge *LyrionBridgeCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LyrionBridge *LyrionBridgeSession) Owner() (common.Address, error) {
+	return _LyrionBridge.Contract.Owner(&_LyrionBridge.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LyrionBridge *LyrionBridgeCallerSession) Owner() (common.Address, error) {
+	return _LyrionBridge.Contract.Owner(&_LyrionBridge.CallOpts)
+}
+
+// ProcessedDeposits is a free data retrieval call binding the contract method 0xb6eeba31.
+//
+// Solidity: function processedDeposits(uint256 ) view returns(bool)
+func (_LyrionBridge *LyrionBridgeCaller) ProcessedDeposits(opts *bind.CallOpts, arg0 *big.Int) (bool, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "processedDeposits", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// ProcessedDeposits is a free data retrieval call binding the contract method 0xb6eeba31.
+//
+// Solidity: function processedDeposits(uint256 ) view returns(bool)
+func (_LyrionBridge *LyrionBridgeSession) ProcessedDeposits(arg0 *big.Int) (bool, error) {
+	return _LyrionBridge.Contract.ProcessedDeposits(&_LyrionBridge.CallOpts, arg0)
+}
+
+// ProcessedDeposits is a free data retrieval call binding the contract method 0xb6eeba31.
+//
+// Solidity: function processedDeposits(uint256 ) view returns(bool)
+func (_LyrionBridge *LyrionBridgeCallerSession) ProcessedDeposits(arg0 *big.Int) (bool, error) {
+	return _LyrionBridge.Contract.ProcessedDeposits(&_LyrionBridge.CallOpts, arg0)
+}
+
+// ProcessedWithdrawals is a free data retrieval call binding the contract method 0xbf49d631.
+//
+// Solidity: function processedWithdrawals(bytes32 ) view returns(bool)
+func (_LyrionBridge *LyrionBridgeCaller) ProcessedWithdrawals(opts *bind.CallOpts, arg0 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "processedWithdrawals", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// ProcessedWithdrawals is a free data retrieval call binding the contract method 0xbf49d631.
+//
+// Solidity: function processedWithdrawals(bytes32 ) view returns(bool)
+func (_LyrionBridge *LyrionBridgeSession) ProcessedWithdrawals(arg0 [32]byte) (bool, error) {
+	return _LyrionBridge.Contract.ProcessedWithdrawals(&_LyrionBridge.CallOpts, arg0)
+}
+
+// ProcessedWithdrawals is a free data retrieval call binding the contract method 0xbf49d631.
+//
+// Solidity: function processedWithdrawals(bytes32 ) view returns(bool)
+func (_LyrionBridge *LyrionBridgeCallerSession) ProcessedWithdrawals(arg0 [32]byte) (bool, error) {
+	return _LyrionBridge.Contract.ProcessedWithdrawals(&_LyrionBridge.CallOpts, arg0)
+}
+
+// Sequencer is a free data retrieval call binding the contract method 0x5c1bba38.
+//
+// Solidity: function sequencer() view returns(address)
+func (_LyrionBridge *LyrionBridgeCaller) Sequencer(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "sequencer")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Sequencer is a free data retrieval call binding the contract method 0x5c1bba38.
+//
+// Solidity: function sequencer() view returns(address)
+func (_LyrionBridge *LyrionBridgeSession) Sequencer() (common.Address, error) {
+	return _LyrionBridge.Contract.Sequencer(&_LyrionBridge.CallOpts)
+}
+
+// Sequencer is a free data retrieval call binding the contract method 0x5c1bba38.
+//
+// Solidity: function sequencer() view returns(address)
+func (_LyrionBridge *LyrionBridgeCallerSession) Sequencer() (common.Address, error) {
+	return _LyrionBridge.Contract.Sequencer(&_LyrionBridge.CallOpts)
+}
+
+// TotalDeposited is a free data retrieval call binding the contract method 0xff50abdc.
+//
+// Solidity: function totalDeposited() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCaller) TotalDeposited(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "totalDeposited")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalDeposited is a free data retrieval call binding the contract method 0xff50abdc.
+//
+// Solidity: function totalDeposited() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeSession) TotalDeposited() (*big.Int, error) {
+	return _LyrionBridge.Contract.TotalDeposited(&_LyrionBridge.CallOpts)
+}
+
+// TotalDeposited is a free data retrieval call binding the contract method 0xff50abdc.
+//
+// Solidity: function totalDeposited() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCallerSession) TotalDeposited() (*big.Int, error) {
+	return _LyrionBridge.Contract.TotalDeposited(&_LyrionBridge.CallOpts)
+}
+
+// TotalWithdrawn is a free data retrieval call binding the contract method 0x4b319713.
+//
+// Solidity: function totalWithdrawn() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCaller) TotalWithdrawn(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionBridge.contract.Call(opts, &out, "totalWithdrawn")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalWithdrawn is a free data retrieval call binding the contract method 0x4b319713.
+//
+// Solidity: function totalWithdrawn() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeSession) TotalWithdrawn() (*big.Int, error) {
+	return _LyrionBridge.Contract.TotalWithdrawn(&_LyrionBridge.CallOpts)
+}
+
+// TotalWithdrawn is a free data retrieval call binding the contract method 0x4b319713.
+//
+// Solidity: function totalWithdrawn() view returns(uint256)
+func (_LyrionBridge *LyrionBridgeCallerSession) TotalWithdrawn() (*big.Int, error) {
+	return _LyrionBridge.Contract.TotalWithdrawn(&_LyrionBridge.CallOpts)
+}
+
+// ChallengeBatch is a paid mutator transaction binding the contract method 0xfb9e186a.
+//
+// Solidity: function challengeBatch(uint256 batchNumber, bytes32 derivedRoot, bytes32 proofHash) returns()
+func (_LyrionBridge *LyrionBridgeTransactor) ChallengeBatch(opts *bind.TransactOpts, batchNumber *big.Int, derivedRoot [32]byte, proofHash [32]byte) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "challengeBatch", batchNumber, derivedRoot, proofHash)
+}
+
+// ChallengeBatch is a paid mutator transaction binding the contract method 0xfb9e186a.
+//
+// Solidity: function challengeBatch(uint256 batchNumber, bytes32 derivedRoot, bytes32 proofHash) returns()
+func (_LyrionBridge *LyrionBridgeSession) ChallengeBatch(batchNumber *big.Int, derivedRoot [32]byte, proofHash [32]byte) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.ChallengeBatch(&_LyrionBridge.TransactOpts, batchNumber, derivedRoot, proofHash)
+}
+
+// ChallengeBatch is a paid mutator transaction binding the contract method 0xfb9e186a.
+//
+// Solidity: function challengeBatch(uint256 batchNumber, bytes32 derivedRoot, bytes32 proofHash) returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) ChallengeBatch(batchNumber *big.Int, derivedRoot [32]byte, proofHash [32]byte) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.ChallengeBatch(&_LyrionBridge.TransactOpts, batchNumber, derivedRoot, proofHash)
+}
+
+// DepositToL2 is a paid mutator transaction binding the contract method 0xff04f12c.
+//
+// Solidity: function depositToL2(address recipient) payable returns()
+func (_LyrionBridge *LyrionBridgeTransactor) DepositToL2(opts *bind.TransactOpts, recipient common.Address) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "depositToL2", recipient)
+}
+
+// DepositToL2 is a paid mutator transaction binding the contract method 0xff04f12c.
+//
+// Solidity: function depositToL2(address recipient) payable returns()
+func (_LyrionBridge *LyrionBridgeSession) DepositToL2(recipient common.Address) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.DepositToL2(&_LyrionBridge.TransactOpts, recipient)
+}
+
+// DepositToL2 is a paid mutator transaction binding the contract method 0xff04f12c.
+//
+// Solidity: function depositToL2(address recipient) payable returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) DepositToL2(recipient common.Address) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.DepositToL2(&_LyrionBridge.TransactOpts, recipient)
+}
+
+// DepositToL20 is a paid mutator transaction binding the contract method 0x5823d45b.
+//
+// Solidity: function depositToL2() payable returns()
+func (_LyrionBridge *LyrionBridgeTransactor) DepositToL20(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "depositToL20")
+}
+
+// DepositToL20 is a paid mutator transaction binding the contract method 0x5823d45b.
+//
+// Solidity: function depositToL2() payable returns()
+func (_LyrionBridge *LyrionBridgeSession) DepositToL20() (*types.Transaction, error) {
+	return _LyrionBridge.Contract.DepositToL20(&_LyrionBridge.TransactOpts)
+}
+
+// DepositToL20 is a paid mutator transaction binding the contract method 0x5823d45b.
+//
+// Solidity: function depositToL2() payable returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) DepositToL20() (*types.Transaction, error) {
+	return _LyrionBridge.Contract.DepositToL20(&_LyrionBridge.TransactOpts)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address to, uint256 amount) returns()
+func (_LyrionBridge *LyrionBridgeTransactor) EmergencyWithdraw(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "emergencyWithdraw", to, amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address to, uint256 amount) returns()
+func (_LyrionBridge *LyrionBridgeSession) EmergencyWithdraw(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.EmergencyWithdraw(&_LyrionBridge.TransactOpts, to, amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address to, uint256 amount) returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) EmergencyWithdraw(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.EmergencyWithdraw(&_LyrionBridge.TransactOpts, to, amount)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LyrionBridge *LyrionBridgeTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LyrionBridge *LyrionBridgeSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LyrionBridge.Contract.RenounceOwnership(&_LyrionBridge.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LyrionBridge.Contract.RenounceOwnership(&_LyrionBridge.TransactOpts)
+}
+
+// SetChallengePeriod is a paid mutator transaction binding the contract method 0x5d475fdd.
+//
+// Solidity: function setChallengePeriod(uint256 _period) returns()
+func (_LyrionBridge *LyrionBridgeTransactor) SetChallengePeriod(opts *bind.TransactOpts, _period *big.Int) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "setChallengePeriod", _period)
+}
+
+// SetChallengePeriod is a paid mutator transaction binding the contract method 0x5d475fdd.
+//
+// Solidity: function setChallengePeriod(uint256 _period) returns()
+func (_LyrionBridge *LyrionBridgeSession) SetChallengePeriod(_period *big.Int) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.SetChallengePeriod(&_LyrionBridge.TransactOpts, _period)
+}
+
+// SetChallengePeriod is a paid mutator transaction binding the contract method 0x5d475fdd.
+//
+// Solidity: function setChallengePeriod(uint256 _period) returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) SetChallengePeriod(_period *big.Int) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.SetChallengePeriod(&_LyrionBridge.TransactOpts, _period)
+}
+
+// SetChallenger is a paid mutator transaction binding the contract method 0x92b5d190.
+//
+// Solidity: function setChallenger(address challenger, bool allowed) returns()
+func (_LyrionBridge *LyrionBridgeTransactor) SetChallenger(opts *bind.TransactOpts, challenger common.Address, allowed bool) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "setChallenger", challenger, allowed)
+}
+
+// SetChallenger is a paid mutator transaction binding the contract method 0x92b5d190.
+//
+// Solidity: function setChallenger(address challenger, bool allowed) returns()
+func (_LyrionBridge *LyrionBridgeSession) SetChallenger(challenger common.Address, allowed bool) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.SetChallenger(&_LyrionBridge.TransactOpts, challenger, allowed)
+}
+
+// SetChallenger is a paid mutator transaction binding the contract method 0x92b5d190.
+//
+// Solidity: function setChallenger(address challenger, bool allowed) returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) SetChallenger(challenger common.Address, allowed bool) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.SetChallenger(&_LyrionBridge.TransactOpts, challenger, allowed)
+}
+
+// SetSequencer is a paid mutator transaction binding the contract method 0x2547fa3e.
+//
+// Solidity: function setSequencer(address _sequencer) returns()
+func (_LyrionBridge *LyrionBridgeTransactor) SetSequencer(opts *bind.TransactOpts, _sequencer common.Address) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "setSequencer", _sequencer)
+}
+
+// SetSequencer is a paid mutator transaction binding the contract method 0x2547fa3e.
+//
+// Solidity: function setSequencer(address _sequencer) returns()
+func (_LyrionBridge *LyrionBridgeSession) SetSequencer(_sequencer common.Address) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.SetSequencer(&_LyrionBridge.TransactOpts, _sequencer)
+}
+
+// SetSequencer is a paid mutator transaction binding the contract method 0x2547fa3e.
+//
+// Solidity: function setSequencer(address _sequencer) returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) SetSequencer(_sequencer common.Address) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.SetSequencer(&_LyrionBridge.TransactOpts, _sequencer)
+}
+
+// SubmitBatch is a paid mutator transaction binding the contract method 0x4d326825.
+//
+// Solidity: function submitBatch(bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount) returns()
+func (_LyrionBridge *LyrionBridgeTransactor) SubmitBatch(opts *bind.TransactOpts, stateRoot [32]byte, startBlock *big.Int, endBlock *big.Int, txCount *big.Int) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "submitBatch", stateRoot, startBlock, endBlock, txCount)
+}
+
+// SubmitBatch is a paid mutator transaction binding the contract method 0x4d326825.
+//
+// Solidity: function submitBatch(bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount) returns()
+func (_LyrionBridge *LyrionBridgeSession) SubmitBatch(stateRoot [32]byte, startBlock *big.Int, endBlock *big.Int, txCount *big.Int) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.SubmitBatch(&_LyrionBridge.TransactOpts, stateRoot, startBlock, endBlock, txCount)
+}
+
+// SubmitBatch is a paid mutator transaction binding the contract method 0x4d326825.
+//
+// Solidity: function submitBatch(bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount) returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) SubmitBatch(stateRoot [32]byte, startBlock *big.Int, endBlock *big.Int, txCount *big.Int) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.SubmitBatch(&_LyrionBridge.TransactOpts, stateRoot, startBlock, endBlock, txCount)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LyrionBridge *LyrionBridgeTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LyrionBridge *LyrionBridgeSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.TransferOwnership(&_LyrionBridge.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.TransferOwnership(&_LyrionBridge.TransactOpts, newOwner)
+}
+
+// WithdrawFromL2 is a paid mutator transaction binding the contract method 0xf809ad39.
+//
+// Solidity: function withdrawFromL2(uint256 batchNumber, address recipient, uint256 amount, bytes32[] proof) returns()
+func (_LyrionBridge *LyrionBridgeTransactor) WithdrawFromL2(opts *bind.TransactOpts, batchNumber *big.Int, recipient common.Address, amount *big.Int, proof [][32]byte) (*types.Transaction, error) {
+	return _LyrionBridge.contract.Transact(opts, "withdrawFromL2", batchNumber, recipient, amount, proof)
+}
+
+// WithdrawFromL2 is a paid mutator transaction binding the contract method 0xf809ad39.
+//
+// Solidity: function withdrawFromL2(uint256 batchNumber, address recipient, uint256 amount, bytes32[] proof) returns()
+func (_LyrionBridge *LyrionBridgeSession) WithdrawFromL2(batchNumber *big.Int, recipient common.Address, amount *big.Int, proof [][32]byte) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.WithdrawFromL2(&_LyrionBridge.TransactOpts, batchNumber, recipient, amount, proof)
+}
+
+// WithdrawFromL2 is a paid mutator transaction binding the contract method 0xf809ad39.
+//
+// Solidity: function withdrawFromL2(uint256 batchNumber, address recipient, uint256 amount, bytes32[] proof) returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) WithdrawFromL2(batchNumber *big.Int, recipient common.Address, amount *big.Int, proof [][32]byte) (*types.Transaction, error) {
+	return _LyrionBridge.Contract.WithdrawFromL2(&_LyrionBridge.TransactOpts, batchNumber, recipient, amount, proof)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_LyrionBridge *LyrionBridgeTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LyrionBridge.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_LyrionBridge *LyrionBridgeSession) Receive() (*types.Transaction, error) {
+	return _LyrionBridge.Contract.Receive(&_LyrionBridge.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_LyrionBridge *LyrionBridgeTransactorSession) Receive() (*types.Transaction, error) {
+	return _LyrionBridge.Contract.Receive(&_LyrionBridge.TransactOpts)
+}
+
+// LyrionBridgeBatchChallengedIterator is returned from FilterBatchChallenged and is used to iterate over the raw logs and unpacked data for BatchChallenged events raised by the LyrionBridge contract.
+type LyrionBridgeBatchChallengedIterator struct {
+	Event *LyrionBridgeBatchChallenged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionBridgeBatchChallengedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionBridgeBatchChallenged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionBridgeBatchChallenged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionBridgeBatchChallengedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionBridgeBatchChallengedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionBridgeBatchChallenged represents a BatchChallenged event raised by the LyrionBridge contract.
+type LyrionBridgeBatchChallenged struct {
+	BatchNumber *big.Int
+	Challenger  common.Address
+	ClaimedRoot [32]byte
+	DerivedRoot [32]byte
+	ProofHash   [32]byte
+	Timestamp   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchChallenged is a free log retrieval operation binding the contract event 0x95d903dd09c74748599bd1d37b2e4f8fb33bc193e23f59dff52607618817c119.
+//
+// Solidity: event BatchChallenged(uint256 indexed batchNumber, address indexed challenger, bytes32 claimedRoot, bytes32 derivedRoot, bytes32 proofHash, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) FilterBatchChallenged(opts *bind.FilterOpts, batchNumber []*big.Int, challenger []common.Address) (*LyrionBridgeBatchChallengedIterator, error) {
+
+	var batchNumberRule []interface{}
+	for _, batchNumberItem := range batchNumber {
+		batchNumberRule = append(batchNumberRule, batchNumberItem)
+	}
+	var challengerRule []interface{}
+	for _, challengerItem := range challenger {
+		challengerRule = append(challengerRule, challengerItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.FilterLogs(opts, "BatchChallenged", batchNumberRule, challengerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeBatchChallengedIterator{contract: _LyrionBridge.contract, event: "BatchChallenged", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchChallenged is a free log subscription operation binding the contract event 0x95d903dd09c74748599bd1d37b2e4f8fb33bc193e23f59dff52607618817c119.
+//
+// Solidity: event BatchChallenged(uint256 indexed batchNumber, address indexed challenger, bytes32 claimedRoot, bytes32 derivedRoot, bytes32 proofHash, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) WatchBatchChallenged(opts *bind.WatchOpts, sink chan<- *LyrionBridgeBatchChallenged, batchNumber []*big.Int, challenger []common.Address) (event.Subscription, error) {
+
+	var batchNumberRule []interface{}
+	for _, batchNumberItem := range batchNumber {
+		batchNumberRule = append(batchNumberRule, batchNumberItem)
+	}
+	var challengerRule []interface{}
+	for _, challengerItem := range challenger {
+		challengerRule = append(challengerRule, challengerItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.WatchLogs(opts, "BatchChallenged", batchNumberRule, challengerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionBridgeBatchChallenged)
+				if err := _LyrionBridge.contract.UnpackLog(event, "BatchChallenged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchChallenged is a log parse operation binding the contract event 0x95d903dd09c74748599bd1d37b2e4f8fb33bc193e23f59dff52607618817c119.
+//
+// Solidity: event BatchChallenged(uint256 indexed batchNumber, address indexed challenger, bytes32 claimedRoot, bytes32 derivedRoot, bytes32 proofHash, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) ParseBatchChallenged(log types.Log) (*LyrionBridgeBatchChallenged, error) {
+	event := new(LyrionBridgeBatchChallenged)
+	if err := _LyrionBridge.contract.UnpackLog(event, "BatchChallenged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LyrionBridgeBatchSubmittedIterator is returned from FilterBatchSubmitted and is used to iterate over the raw logs and unpacked data for BatchSubmitted events raised by the LyrionBridge contract.
+type LyrionBridgeBatchSubmittedIterator struct {
+	Event *LyrionBridgeBatchSubmitted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionBridgeBatchSubmittedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionBridgeBatchSubmitted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionBridgeBatchSubmitted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionBridgeBatchSubmittedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionBridgeBatchSubmittedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionBridgeBatchSubmitted represents a BatchSubmitted event raised by the LyrionBridge contract.
+type LyrionBridgeBatchSubmitted struct {
+	BatchNumber *big.Int
+	StateRoot   [32]byte
+	StartBlock  *big.Int
+	EndBlock    *big.Int
+	TxCount     *big.Int
+	Timestamp   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchSubmitted is a free log retrieval operation binding the contract event 0x141f97b4e0ba9904418421c179e4315d00515f3906bbb4d48e7e197cc0488389.
+//
+// Solidity: event BatchSubmitted(uint256 indexed batchNumber, bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) FilterBatchSubmitted(opts *bind.FilterOpts, batchNumber []*big.Int) (*LyrionBridgeBatchSubmittedIterator, error) {
+
+	var batchNumberRule []interface{}
+	for _, batchNumberItem := range batchNumber {
+		batchNumberRule = append(batchNumberRule, batchNumberItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.FilterLogs(opts, "BatchSubmitted", batchNumberRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeBatchSubmittedIterator{contract: _LyrionBridge.contract, event: "BatchSubmitted", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchSubmitted is a free log subscription operation binding the contract event 0x141f97b4e0ba9904418421c179e4315d00515f3906bbb4d48e7e197cc0488389.
+//
+// Solidity: event BatchSubmitted(uint256 indexed batchNumber, bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) WatchBatchSubmitted(opts *bind.WatchOpts, sink chan<- *LyrionBridgeBatchSubmitted, batchNumber []*big.Int) (event.Subscription, error) {
+
+	var batchNumberRule []interface{}
+	for _, batchNumberItem := range batchNumber {
+		batchNumberRule = append(batchNumberRule, batchNumberItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.WatchLogs(opts, "BatchSubmitted", batchNumberRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionBridgeBatchSubmitted)
+				if err := _LyrionBridge.contract.UnpackLog(event, "BatchSubmitted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchSubmitted is a log parse operation binding the contract event 0x141f97b4e0ba9904418421c179e4315d00515f3906bbb4d48e7e197cc0488389.
+//
+// Solidity: event BatchSubmitted(uint256 indexed batchNumber, bytes32 stateRoot, uint256 startBlock, uint256 endBlock, uint256 txCount, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) ParseBatchSubmitted(log types.Log) (*LyrionBridgeBatchSubmitted, error) {
+	event := new(LyrionBridgeBatchSubmitted)
+	if err := _LyrionBridge.contract.UnpackLog(event, "BatchSubmitted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LyrionBridgeChallengePeriodUpdatedIterator is returned from FilterChallengePeriodUpdated and is used to iterate over the raw logs and unpacked data for ChallengePeriodUpdated events raised by the LyrionBridge contract.
+type LyrionBridgeChallengePeriodUpdatedIterator struct {
+	Event *LyrionBridgeChallengePeriodUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionBridgeChallengePeriodUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionBridgeChallengePeriodUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionBridgeChallengePeriodUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionBridgeChallengePeriodUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionBridgeChallengePeriodUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionBridgeChallengePeriodUpdated represents a ChallengePeriodUpdated event raised by the LyrionBridge contract.
+type LyrionBridgeChallengePeriodUpdated struct {
+	OldPeriod *big.Int
+	NewPeriod *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterChallengePeriodUpdated is a free log retrieval operation binding the contract event 0x6faeedb0dbe08f71a52ddff592571aafec07972ce3a25c4a30e6b16132946692.
+//
+// Solidity: event ChallengePeriodUpdated(uint256 oldPeriod, uint256 newPeriod)
+func (_LyrionBridge *LyrionBridgeFilterer) FilterChallengePeriodUpdated(opts *bind.FilterOpts) (*LyrionBridgeChallengePeriodUpdatedIterator, error) {
+
+	logs, sub, err := _LyrionBridge.contract.FilterLogs(opts, "ChallengePeriodUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeChallengePeriodUpdatedIterator{contract: _LyrionBridge.contract, event: "ChallengePeriodUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchChallengePeriodUpdated is a free log subscription operation binding the contract event 0x6faeedb0dbe08f71a52ddff592571aafec07972ce3a25c4a30e6b16132946692.
+//
+// Solidity: event ChallengePeriodUpdated(uint256 oldPeriod, uint256 newPeriod)
+func (_LyrionBridge *LyrionBridgeFilterer) WatchChallengePeriodUpdated(opts *bind.WatchOpts, sink chan<- *LyrionBridgeChallengePeriodUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _LyrionBridge.contract.WatchLogs(opts, "ChallengePeriodUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionBridgeChallengePeriodUpdated)
+				if err := _LyrionBridge.contract.UnpackLog(event, "ChallengePeriodUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChallengePeriodUpdated is a log parse operation binding the contract event 0x6faeedb0dbe08f71a52ddff592571aafec07972ce3a25c4a30e6b16132946692.
+//
+// Solidity: event ChallengePeriodUpdated(uint256 oldPeriod, uint256 newPeriod)
+func (_LyrionBridge *LyrionBridgeFilterer) ParseChallengePeriodUpdated(log types.Log) (*LyrionBridgeChallengePeriodUpdated, error) {
+	event := new(LyrionBridgeChallengePeriodUpdated)
+	if err := _LyrionBridge.contract.UnpackLog(event, "ChallengePeriodUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LyrionBridgeChallengerUpdatedIterator is returned from FilterChallengerUpdated and is used to iterate over the raw logs and unpacked data for ChallengerUpdated events raised by the LyrionBridge contract.
+type LyrionBridgeChallengerUpdatedIterator struct {
+	Event *LyrionBridgeChallengerUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionBridgeChallengerUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionBridgeChallengerUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionBridgeChallengerUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionBridgeChallengerUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionBridgeChallengerUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionBridgeChallengerUpdated represents a ChallengerUpdated event raised by the LyrionBridge contract.
+type LyrionBridgeChallengerUpdated struct {
+	Challenger common.Address
+	Allowed    bool
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterChallengerUpdated is a free log retrieval operation binding the contract event 0x32bae78da1582e04b3d20a2d58c706339a9fe9531d524129ab14e0979dc1ca9c.
+//
+// Solidity: event ChallengerUpdated(address indexed challenger, bool allowed)
+func (_LyrionBridge *LyrionBridgeFilterer) FilterChallengerUpdated(opts *bind.FilterOpts, challenger []common.Address) (*LyrionBridgeChallengerUpdatedIterator, error) {
+
+	var challengerRule []interface{}
+	for _, challengerItem := range challenger {
+		challengerRule = append(challengerRule, challengerItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.FilterLogs(opts, "ChallengerUpdated", challengerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeChallengerUpdatedIterator{contract: _LyrionBridge.contract, event: "ChallengerUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchChallengerUpdated is a free log subscription operation binding the contract event 0x32bae78da1582e04b3d20a2d58c706339a9fe9531d524129ab14e0979dc1ca9c.
+//
+// Solidity: event ChallengerUpdated(address indexed challenger, bool allowed)
+func (_LyrionBridge *LyrionBridgeFilterer) WatchChallengerUpdated(opts *bind.WatchOpts, sink chan<- *LyrionBridgeChallengerUpdated, challenger []common.Address) (event.Subscription, error) {
+
+	var challengerRule []interface{}
+	for _, challengerItem := range challenger {
+		challengerRule = append(challengerRule, challengerItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.WatchLogs(opts, "ChallengerUpdated", challengerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionBridgeChallengerUpdated)
+				if err := _LyrionBridge.contract.UnpackLog(event, "ChallengerUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChallengerUpdated is a log parse operation binding the contract event 0x32bae78da1582e04b3d20a2d58c706339a9fe9531d524129ab14e0979dc1ca9c.
+//
+// Solidity: event ChallengerUpdated(address indexed challenger, bool allowed)
+func (_LyrionBridge *LyrionBridgeFilterer) ParseChallengerUpdated(log types.Log) (*LyrionBridgeChallengerUpdated, error) {
+	event := new(LyrionBridgeChallengerUpdated)
+	if err := _LyrionBridge.contract.UnpackLog(event, "ChallengerUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LyrionBridgeDepositInitiatedIterator is returned from FilterDepositInitiated and is used to iterate over the raw logs and unpacked data for DepositInitiated events raised by the LyrionBridge contract.
+type LyrionBridgeDepositInitiatedIterator struct {
+	Event *LyrionBridgeDepositInitiated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionBridgeDepositInitiatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionBridgeDepositInitiated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionBridgeDepositInitiated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionBridgeDepositInitiatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionBridgeDepositInitiatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionBridgeDepositInitiated represents a DepositInitiated event raised by the LyrionBridge contract.
+type LyrionBridgeDepositInitiated struct {
+	Nonce     *big.Int
+	Sender    common.Address
+	Recipient common.Address
+	Amount    *big.Int
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDepositInitiated is a free log retrieval operation binding the contract event 0xb52dc1daa5b1965789550d1d40788f98710fd77ea436c94881031390d1830a1f.
+//
+// Solidity: event DepositInitiated(uint256 indexed nonce, address indexed sender, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) FilterDepositInitiated(opts *bind.FilterOpts, nonce []*big.Int, sender []common.Address, recipient []common.Address) (*LyrionBridgeDepositInitiatedIterator, error) {
+
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.FilterLogs(opts, "DepositInitiated", nonceRule, senderRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeDepositInitiatedIterator{contract: _LyrionBridge.contract, event: "DepositInitiated", logs: logs, sub: sub}, nil
+}
+
+// WatchDepositInitiated is a free log subscription operation binding the contract event 0xb52dc1daa5b1965789550d1d40788f98710fd77ea436c94881031390d1830a1f.
+//
+// Solidity: event DepositInitiated(uint256 indexed nonce, address indexed sender, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) WatchDepositInitiated(opts *bind.WatchOpts, sink chan<- *LyrionBridgeDepositInitiated, nonce []*big.Int, sender []common.Address, recipient []common.Address) (event.Subscription, error) {
+
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.WatchLogs(opts, "DepositInitiated", nonceRule, senderRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionBridgeDepositInitiated)
+				if err := _LyrionBridge.contract.UnpackLog(event, "DepositInitiated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDepositInitiated is a log parse operation binding the contract event 0xb52dc1daa5b1965789550d1d40788f98710fd77ea436c94881031390d1830a1f.
+//
+// Solidity: event DepositInitiated(uint256 indexed nonce, address indexed sender, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) ParseDepositInitiated(log types.Log) (*LyrionBridgeDepositInitiated, error) {
+	event := new(LyrionBridgeDepositInitiated)
+	if err := _LyrionBridge.contract.UnpackLog(event, "DepositInitiated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LyrionBridgeOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the LyrionBridge contract.
+type LyrionBridgeOwnershipTransferredIterator struct {
+	Event *LyrionBridgeOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionBridgeOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionBridgeOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionBridgeOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionBridgeOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionBridgeOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionBridgeOwnershipTransferred represents a OwnershipTransferred event raised by the LyrionBridge contract.
+type LyrionBridgeOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LyrionBridge *LyrionBridgeFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*LyrionBridgeOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeOwnershipTransferredIterator{contract: _LyrionBridge.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LyrionBridge *LyrionBridgeFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *LyrionBridgeOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionBridgeOwnershipTransferred)
+				if err := _LyrionBridge.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LyrionBridge *LyrionBridgeFilterer) ParseOwnershipTransferred(log types.Log) (*LyrionBridgeOwnershipTransferred, error) {
+	event := new(LyrionBridgeOwnershipTransferred)
+	if err := _LyrionBridge.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LyrionBridgeSequencerUpdatedIterator is returned from FilterSequencerUpdated and is used to iterate over the raw logs and unpacked data for SequencerUpdated events raised by the LyrionBridge contract.
+type LyrionBridgeSequencerUpdatedIterator struct {
+	Event *LyrionBridgeSequencerUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionBridgeSequencerUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionBridgeSequencerUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionBridgeSequencerUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionBridgeSequencerUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionBridgeSequencerUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionBridgeSequencerUpdated represents a SequencerUpdated event raised by the LyrionBridge contract.
+type LyrionBridgeSequencerUpdated struct {
+	OldSequencer common.Address
+	NewSequencer common.Address
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterSequencerUpdated is a free log retrieval operation binding the contract event 0xcd58b762453bd126b48db83f2cecd464f5281dd7e5e6824b528c09d0482984d6.
+//
+// Solidity: event SequencerUpdated(address indexed oldSequencer, address indexed newSequencer)
+func (_LyrionBridge *LyrionBridgeFilterer) FilterSequencerUpdated(opts *bind.FilterOpts, oldSequencer []common.Address, newSequencer []common.Address) (*LyrionBridgeSequencerUpdatedIterator, error) {
+
+	var oldSequencerRule []interface{}
+	for _, oldSequencerItem := range oldSequencer {
+		oldSequencerRule = append(oldSequencerRule, oldSequencerItem)
+	}
+	var newSequencerRule []interface{}
+	for _, newSequencerItem := range newSequencer {
+		newSequencerRule = append(newSequencerRule, newSequencerItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.FilterLogs(opts, "SequencerUpdated", oldSequencerRule, newSequencerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeSequencerUpdatedIterator{contract: _LyrionBridge.contract, event: "SequencerUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchSequencerUpdated is a free log subscription operation binding the contract event 0xcd58b762453bd126b48db83f2cecd464f5281dd7e5e6824b528c09d0482984d6.
+//
+// Solidity: event SequencerUpdated(address indexed oldSequencer, address indexed newSequencer)
+func (_LyrionBridge *LyrionBridgeFilterer) WatchSequencerUpdated(opts *bind.WatchOpts, sink chan<- *LyrionBridgeSequencerUpdated, oldSequencer []common.Address, newSequencer []common.Address) (event.Subscription, error) {
+
+	var oldSequencerRule []interface{}
+	for _, oldSequencerItem := range oldSequencer {
+		oldSequencerRule = append(oldSequencerRule, oldSequencerItem)
+	}
+	var newSequencerRule []interface{}
+	for _, newSequencerItem := range newSequencer {
+		newSequencerRule = append(newSequencerRule, newSequencerItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.WatchLogs(opts, "SequencerUpdated", oldSequencerRule, newSequencerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionBridgeSequencerUpdated)
+				if err := _LyrionBridge.contract.UnpackLog(event, "SequencerUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSequencerUpdated is a log parse operation binding the contract event 0xcd58b762453bd126b48db83f2cecd464f5281dd7e5e6824b528c09d0482984d6.
+//
+// Solidity: event SequencerUpdated(address indexed oldSequencer, address indexed newSequencer)
+func (_LyrionBridge *LyrionBridgeFilterer) ParseSequencerUpdated(log types.Log) (*LyrionBridgeSequencerUpdated, error) {
+	event := new(LyrionBridgeSequencerUpdated)
+	if err := _LyrionBridge.contract.UnpackLog(event, "SequencerUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LyrionBridgeWithdrawalCompletedIterator is returned from FilterWithdrawalCompleted and is used to iterate over the raw logs and unpacked data for WithdrawalCompleted events raised by the LyrionBridge contract.
+type LyrionBridgeWithdrawalCompletedIterator struct {
+	Event *LyrionBridgeWithdrawalCompleted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionBridgeWithdrawalCompletedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionBridgeWithdrawalCompleted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionBridgeWithdrawalCompleted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionBridgeWithdrawalCompletedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionBridgeWithdrawalCompletedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionBridgeWithdrawalCompleted represents a WithdrawalCompleted event raised by the LyrionBridge contract.
+type LyrionBridgeWithdrawalCompleted struct {
+	WithdrawalHash [32]byte
+	Recipient      common.Address
+	Amount         *big.Int
+	Timestamp      *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawalCompleted is a free log retrieval operation binding the contract event 0x8ce662b30f4d58ce2891162a6dbfe1ab72169bb7e9117b9527cfeaa897386ac6.
+//
+// Solidity: event WithdrawalCompleted(bytes32 indexed withdrawalHash, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) FilterWithdrawalCompleted(opts *bind.FilterOpts, withdrawalHash [][32]byte, recipient []common.Address) (*LyrionBridgeWithdrawalCompletedIterator, error) {
+
+	var withdrawalHashRule []interface{}
+	for _, withdrawalHashItem := range withdrawalHash {
+		withdrawalHashRule = append(withdrawalHashRule, withdrawalHashItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.FilterLogs(opts, "WithdrawalCompleted", withdrawalHashRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionBridgeWithdrawalCompletedIterator{contract: _LyrionBridge.contract, event: "WithdrawalCompleted", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawalCompleted is a free log subscription operation binding the contract event 0x8ce662b30f4d58ce2891162a6dbfe1ab72169bb7e9117b9527cfeaa897386ac6.
+//
+// Solidity: event WithdrawalCompleted(bytes32 indexed withdrawalHash, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) WatchWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *LyrionBridgeWithdrawalCompleted, withdrawalHash [][32]byte, recipient []common.Address) (event.Subscription, error) {
+
+	var withdrawalHashRule []interface{}
+	for _, withdrawalHashItem := range withdrawalHash {
+		withdrawalHashRule = append(withdrawalHashRule, withdrawalHashItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _LyrionBridge.contract.WatchLogs(opts, "WithdrawalCompleted", withdrawalHashRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionBridgeWithdrawalCompleted)
+				if err := _LyrionBridge.contract.UnpackLog(event, "WithdrawalCompleted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawalCompleted is a log parse operation binding the contract event 0x8ce662b30f4d58ce2891162a6dbfe1ab72169bb7e9117b9527cfeaa897386ac6.
+//
+// Solidity: event WithdrawalCompleted(bytes32 indexed withdrawalHash, address indexed recipient, uint256 amount, uint256 timestamp)
+func (_LyrionBridge *LyrionBridgeFilterer) ParseWithdrawalCompleted(log types.Log) (*LyrionBridgeWithdrawalCompleted, error) {
+	event := new(LyrionBridgeWithdrawalCompleted)
+	if err := _LyrionBridge.contract.UnpackLog(event, "WithdrawalCompleted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
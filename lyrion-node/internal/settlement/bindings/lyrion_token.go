@@ -0,0 +1,1264 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LyrionTokenMetaData contains all meta data concerning the LyrionToken contract.
+var LyrionTokenMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_bridge\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"MAX_SUPPLY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"allowance\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"approve\",\"inputs\":[{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"bridge\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"burn\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"mint\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setBridge\",\"inputs\":[{\"name\":\"_bridge\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"symbol\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transfer\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Approval\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BridgeUpdated\",\"inputs\":[{\"name\":\"oldBridge\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"newBridge\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ERC20InsufficientAllowance\",\"inputs\":[{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"allowance\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"needed\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC20InsufficientBalance\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"balance\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"needed\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC20InvalidApprover\",\"inputs\":[{\"name\":\"approver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC20InvalidReceiver\",\"inputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC20InvalidSender\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC20InvalidSpender\",\"inputs\":[{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"MaxSupplyExceeded\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OnlyBridge\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+	Bin: "0x341561000b5760006000fd5b60206020380360803960805160a01c1515156100275760006000fd5b7f4c7972696f6e20546f6b656e00000000000000000000000000000000000000186003557f4c59520000000000000000000000000000000000000000000000000000000006600455336005553360007f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e06000610300a360805160065560805160007faae9beddccf584528e87b66c6ff2563825c8a1433305b8a656e9b5f9bf3904996000610300a3610ae66100f8600039610ae66000f35b634e487b7160e01b610300526011610304526024610300fd600436106100ce5760003560e01c806306fdde03146100d457806395d89b4114610127578063313ce5671461017a57806318160ddd146101a257806370a08231146101cb578063dd62ed3e1461021557806332cb6b0c14610280578063e78cea92146102b3578063a9059cbb146102dc578063095ea7b31461040b57806323b872dd146104da57806340c10f191461070957806342966c68146108215780638dd14802146108f35780638da5cb5b1461097f578063715018a6146109a8578063f2fde38b14610a1f5760006000fd5b60006000fd5b34156100e05760006000fd5b60043610156100ef5760006000fd5b602061030052600c610320527f4c7972696f6e20546f6b656e0000000000000000000000000000000000000000610340526060610300f35b34156101335760006000fd5b60043610156101425760006000fd5b6020610300526003610320527f4c59520000000000000000000000000000000000000000000000000000000000610340526060610300f35b34156101865760006000fd5b60043610156101955760006000fd5b6012610300526020610300f35b34156101ae5760006000fd5b60043610156101bd5760006000fd5b600254610300526020610300f35b34156101d75760006000fd5b60243610156101e65760006000fd5b60043560a01c1515156101f95760006000fd5b6004356000526000602052604060002054610300526020610300f35b34156102215760006000fd5b60443610156102305760006000fd5b60043560a01c1515156102435760006000fd5b60243560a01c1515156102565760006000fd5b60243560005260043560005260016020526040600020602052604060002054610300526020610300f35b341561028c5760006000fd5b600436101561029b5760006000fd5b6b033b2e3c9fd0803ce8000000610300526020610300f35b34156102bf5760006000fd5b60043610156102ce5760006000fd5b600654610300526020610300f35b34156102e85760006000fd5b60443610156102f75760006000fd5b60043560a01c15151561030a5760006000fd5b33151561032a576396c6fd1e60e01b610300526000610304526024610300fd5b600435151561034c5763ec442f0560e01b610300526000610304526024610300fd5b33600052600060205260406000205460a05260243560a05110156103905763e450d38c60e01b61030052336103045260a05161032452602435610344526064610300fd5b60243560a05103336000526000602052604060002055602435600435600052600060205260406000205401600435600052600060205260406000205560243561030052600435337fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef6020610300a36001610300526020610300f35b34156104175760006000fd5b60443610156104265760006000fd5b60043560a01c1515156104395760006000fd5b3315156104595763e602df0560e01b610300526000610304526024610300fd5b600435151561047b576394280d6260e01b610300526000610304526024610300fd5b602435600435600052336000526001602052604060002060205260406000205560243561030052600435337f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b9256020610300a36001610300526020610300f35b34156104e65760006000fd5b60643610156104f55760006000fd5b60043560a01c1515156105085760006000fd5b60243560a01c15151561051b5760006000fd5b33600052600435600052600160205260406000206020526040600020546080527fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff60805110156105fe5760443560805110156105975763fb8f41b260e01b61030052336103045260805161032452604435610344526064610300fd5b60043515156105b95763e602df0560e01b610300526000610304526024610300fd5b3315156105d9576394280d6260e01b610300526000610304526024610300fd5b6044356080510333600052600435600052600160205260406000206020526040600020555b6004351515610620576396c6fd1e60e01b610300526000610304526024610300fd5b60243515156106425763ec442f0560e01b610300526000610304526024610300fd5b600435600052600060205260406000205460a05260443560a051101561068a5763e450d38c60e01b610300526004356103045260a05161032452604435610344526064610300fd5b60443560a0510360043560005260006020526040600020556044356024356000526000602052604060002054016024356000526000602052604060002055604435610300526024356004357fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef6020610300a36001610300526020610300f35b34156107155760006000fd5b60443610156107245760006000fd5b60043560a01c1515156107375760006000fd5b60065433141515610755576338da3b1560e01b610300526004610300fd5b6b033b2e3c9fd0803ce8000000602435600254818101808211610acd57915050111561078e57638a164f6360e01b610300526004610300fd5b60043515156107b05763ec442f0560e01b610300526000610304526024610300fd5b602435600254818101808211610acd5791505060025560243560043560005260006020526040600020540160043560005260006020526040600020556024356103005260043560007fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef6020610300a3005b341561082d5760006000fd5b602436101561083c5760006000fd5b33151561085c576396c6fd1e60e01b610300526000610304526024610300fd5b33600052600060205260406000205460a05260043560a05110156108a05763e450d38c60e01b61030052336103045260a05161032452600435610344526064610300fd5b60043560a0510333600052600060205260406000205560043560025403600255600435610300526000337fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef6020610300a3005b34156108ff5760006000fd5b602436101561090e5760006000fd5b60043560a01c1515156109215760006000fd5b600554331415156109445763118cdaa760e01b6103005233610304526024610300fd5b6006546080526004356006556004356080517faae9beddccf584528e87b66c6ff2563825c8a1433305b8a656e9b5f9bf3904996000610300a3005b341561098b5760006000fd5b600436101561099a5760006000fd5b600554610300526020610300f35b34156109b45760006000fd5b60043610156109c35760006000fd5b600554331415156109e65763118cdaa760e01b6103005233610304526024610300fd5b600554608052600060055560006080517f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e06000610300a3005b3415610a2b5760006000fd5b6024361015610a3a5760006000fd5b60043560a01c151515610a4d5760006000fd5b60055433141515610a705763118cdaa760e01b6103005233610304526024610300fd5b6004351515610a9257631e4fbdf760e01b610300526000610304526024610300fd5b6005546080526004356005556004356080517f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e06000610300a3005b634e487b7160e01b610300526011610304526024610300fd",
+}
+
+// LyrionTokenABI is the input ABI used to generate the binding from.
+// Deprecated: Use LyrionTokenMetaData.ABI instead.
+var LyrionTokenABI = LyrionTokenMetaData.ABI
+
+// LyrionTokenBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use LyrionTokenMetaData.Bin instead.
+var LyrionTokenBin = LyrionTokenMetaData.Bin
+
+// DeployLyrionToken deploys a new Ethereum contract, binding an instance of LyrionToken to it.
+func DeployLyrionToken(auth *bind.TransactOpts, backend bind.ContractBackend, _bridge common.Address) (common.Address, *types.Transaction, *LyrionToken, error) {
+	parsed, err := LyrionTokenMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(LyrionTokenBin), backend, _bridge)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &LyrionToken{LyrionTokenCaller: LyrionTokenCaller{contract: contract}, LyrionTokenTransactor: LyrionTokenTransactor{contract: contract}, LyrionTokenFilterer: LyrionTokenFilterer{contract: contract}}, nil
+}
+
+// LyrionToken is an auto generated Go binding around an Ethereum contract.
+type LyrionToken struct {
+	LyrionTokenCaller     // Read-only binding to the contract
+	LyrionTokenTransactor // Write-only binding to the contract
+	LyrionTokenFilterer   // Log filterer for contract events
+}
+
+// LyrionTokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type LyrionTokenCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LyrionTokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type LyrionTokenTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LyrionTokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type LyrionTokenFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LyrionTokenSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type LyrionTokenSession struct {
+	Contract     *LyrionToken      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// LyrionTokenCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type LyrionTokenCallerSession struct {
+	Contract *LyrionTokenCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// LyrionTokenTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type LyrionTokenTransactorSession struct {
+	Contract     *LyrionTokenTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// LyrionTokenRaw is an auto generated low-level Go binding around an Ethereum contract.
+type LyrionTokenRaw struct {
+	Contract *LyrionToken // Generic contract binding to access the raw methods on
+}
+
+// LyrionTokenCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type LyrionTokenCallerRaw struct {
+	Contract *LyrionTokenCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// LyrionTokenTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type LyrionTokenTransactorRaw struct {
+	Contract *LyrionTokenTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewLyrionToken creates a new instance of LyrionToken, bound to a specific deployed contract.
+func NewLyrionToken(address common.Address, backend bind.ContractBackend) (*LyrionToken, error) {
+	contract, err := bindLyrionToken(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionToken{LyrionTokenCaller: LyrionTokenCaller{contract: contract}, LyrionTokenTransactor: LyrionTokenTransactor{contract: contract}, LyrionTokenFilterer: LyrionTokenFilterer{contract: contract}}, nil
+}
+
+// NewLyrionTokenCaller creates a new read-only instance of LyrionToken, bound to a specific deployed contract.
+func NewLyrionTokenCaller(address common.Address, caller bind.ContractCaller) (*LyrionTokenCaller, error) {
+	contract, err := bindLyrionToken(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionTokenCaller{contract: contract}, nil
+}
+
+// NewLyrionTokenTransactor creates a new write-only instance of LyrionToken, bound to a specific deployed contract.
+func NewLyrionTokenTransactor(address common.Address, transactor bind.ContractTransactor) (*LyrionTokenTransactor, error) {
+	contract, err := bindLyrionToken(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionTokenTransactor{contract: contract}, nil
+}
+
+// NewLyrionTokenFilterer creates a new log filterer instance of LyrionToken, bound to a specific deployed contract.
+func NewLyrionTokenFilterer(address common.Address, filterer bind.ContractFilterer) (*LyrionTokenFilterer, error) {
+	contract, err := bindLyrionToken(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionTokenFilterer{contract: contract}, nil
+}
+
+// bindLyrionToken binds a generic wrapper to an already deployed contract.
+func bindLyrionToken(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := LyrionTokenMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LyrionToken *LyrionTokenRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LyrionToken.Contract.LyrionTokenCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LyrionToken *LyrionTokenRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LyrionToken.Contract.LyrionTokenTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LyrionToken *LyrionTokenRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LyrionToken.Contract.LyrionTokenTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LyrionToken *LyrionTokenCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LyrionToken.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LyrionToken *LyrionTokenTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LyrionToken.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LyrionToken *LyrionTokenTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LyrionToken.Contract.contract.Transact(opts, method, params...)
+}
+
+// MAXSUPPLY is a free data retrieval call binding the contract method 0x32cb6b0c.
+//
+// Solidity: function MAX_SUPPLY() view returns(uint256)
+func (_LyrionToken *LyrionTokenCaller) MAXSUPPLY(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionToken.contract.Call(opts, &out, "MAX_SUPPLY")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXSUPPLY is a free data retrieval call binding the contract method 0x32cb6b0c.
+//
+// Solidity: function MAX_SUPPLY() view returns(uint256)
+func (_LyrionToken *LyrionTokenSession) MAXSUPPLY() (*big.Int, error) {
+	return _LyrionToken.Contract.MAXSUPPLY(&_LyrionToken.CallOpts)
+}
+
+// MAXSUPPLY is a free data retrieval call binding the contract method 0x32cb6b0c.
+//
+// Solidity: function MAX_SUPPLY() view returns(uint256)
+func (_LyrionToken *LyrionTokenCallerSession) MAXSUPPLY() (*big.Int, error) {
+	return _LyrionToken.Contract.MAXSUPPLY(&_LyrionToken.CallOpts)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_LyrionToken *LyrionTokenCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionToken.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_LyrionToken *LyrionTokenSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _LyrionToken.Contract.Allowance(&_LyrionToken.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_LyrionToken *LyrionTokenCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _LyrionToken.Contract.Allowance(&_LyrionToken.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_LyrionToken *LyrionTokenCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionToken.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_LyrionToken *LyrionTokenSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _LyrionToken.Contract.BalanceOf(&_LyrionToken.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_LyrionToken *LyrionTokenCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _LyrionToken.Contract.BalanceOf(&_LyrionToken.CallOpts, account)
+}
+
+// Bridge is a free data retrieval call binding the contract method 0xe78cea92.
+//
+// Solidity: function bridge() view returns(address)
+func (_LyrionToken *LyrionTokenCaller) Bridge(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _LyrionToken.contract.Call(opts, &out, "bridge")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Bridge is a free data retrieval call binding the contract method 0xe78cea92.
+//
+// Solidity: function bridge() view returns(address)
+func (_LyrionToken *LyrionTokenSession) Bridge() (common.Address, error) {
+	return _LyrionToken.Contract.Bridge(&_LyrionToken.CallOpts)
+}
+
+// Bridge is a free data retrieval call binding the contract method 0xe78cea92.
+//
+// Solidity: function bridge() view returns(address)
+func (_LyrionToken *LyrionTokenCallerSession) Bridge() (common.Address, error) {
+	return _LyrionToken.Contract.Bridge(&_LyrionToken.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_LyrionToken *LyrionTokenCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _LyrionToken.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_LyrionToken *LyrionTokenSession) Decimals() (uint8, error) {
+	return _LyrionToken.Contract.Decimals(&_LyrionToken.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_LyrionToken *LyrionTokenCallerSession) Decimals() (uint8, error) {
+	return _LyrionToken.Contract.Decimals(&_LyrionToken.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LyrionToken *LyrionTokenCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LyrionToken.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LyrionToken *LyrionTokenSession) Name() (string, error) {
+	return _LyrionToken.Contract.Name(&_LyrionToken.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LyrionToken *LyrionTokenCallerSession) Name() (string, error) {
+	return _LyrionToken.Contract.Name(&_LyrionToken.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LyrionToken *LyrionTokenCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _LyrionToken.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LyrionToken *LyrionTokenSession) Owner() (common.Address, error) {
+	return _LyrionToken.Contract.Owner(&_LyrionToken.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LyrionToken *LyrionTokenCallerSession) Owner() (common.Address, error) {
+	return _LyrionToken.Contract.Owner(&_LyrionToken.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LyrionToken *LyrionTokenCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LyrionToken.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LyrionToken *LyrionTokenSession) Symbol() (string, error) {
+	return _LyrionToken.Contract.Symbol(&_LyrionToken.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LyrionToken *LyrionTokenCallerSession) Symbol() (string, error) {
+	return _LyrionToken.Contract.Symbol(&_LyrionToken.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LyrionToken *LyrionTokenCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LyrionToken.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LyrionToken *LyrionTokenSession) TotalSupply() (*big.Int, error) {
+	return _LyrionToken.Contract.TotalSupply(&_LyrionToken.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LyrionToken *LyrionTokenCallerSession) TotalSupply() (*big.Int, error) {
+	return _LyrionToken.Contract.TotalSupply(&_LyrionToken.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_LyrionToken *LyrionTokenTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_LyrionToken *LyrionTokenSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.Contract.Approve(&_LyrionToken.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_LyrionToken *LyrionTokenTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.Contract.Approve(&_LyrionToken.TransactOpts, spender, value)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 amount) returns()
+func (_LyrionToken *LyrionTokenTransactor) Burn(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.contract.Transact(opts, "burn", amount)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 amount) returns()
+func (_LyrionToken *LyrionTokenSession) Burn(amount *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.Contract.Burn(&_LyrionToken.TransactOpts, amount)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 amount) returns()
+func (_LyrionToken *LyrionTokenTransactorSession) Burn(amount *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.Contract.Burn(&_LyrionToken.TransactOpts, amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns()
+func (_LyrionToken *LyrionTokenTransactor) Mint(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.contract.Transact(opts, "mint", to, amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns()
+func (_LyrionToken *LyrionTokenSession) Mint(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.Contract.Mint(&_LyrionToken.TransactOpts, to, amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns()
+func (_LyrionToken *LyrionTokenTransactorSession) Mint(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.Contract.Mint(&_LyrionToken.TransactOpts, to, amount)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LyrionToken *LyrionTokenTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LyrionToken.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LyrionToken *LyrionTokenSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LyrionToken.Contract.RenounceOwnership(&_LyrionToken.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LyrionToken *LyrionTokenTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LyrionToken.Contract.RenounceOwnership(&_LyrionToken.TransactOpts)
+}
+
+// SetBridge is a paid mutator transaction binding the contract method 0x8dd14802.
+//
+// Solidity: function setBridge(address _bridge) returns()
+func (_LyrionToken *LyrionTokenTransactor) SetBridge(opts *bind.TransactOpts, _bridge common.Address) (*types.Transaction, error) {
+	return _LyrionToken.contract.Transact(opts, "setBridge", _bridge)
+}
+
+// SetBridge is a paid mutator transaction binding the contract method 0x8dd14802.
+//
+// Solidity: function setBridge(address _bridge) returns()
+func (_LyrionToken *LyrionTokenSession) SetBridge(_bridge common.Address) (*types.Transaction, error) {
+	return _LyrionToken.Contract.SetBridge(&_LyrionToken.TransactOpts, _bridge)
+}
+
+// SetBridge is a paid mutator transaction binding the contract method 0x8dd14802.
+//
+// Solidity: function setBridge(address _bridge) returns()
+func (_LyrionToken *LyrionTokenTransactorSession) SetBridge(_bridge common.Address) (*types.Transaction, error) {
+	return _LyrionToken.Contract.SetBridge(&_LyrionToken.TransactOpts, _bridge)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_LyrionToken *LyrionTokenTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_LyrionToken *LyrionTokenSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.Contract.Transfer(&_LyrionToken.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_LyrionToken *LyrionTokenTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.Contract.Transfer(&_LyrionToken.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_LyrionToken *LyrionTokenTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_LyrionToken *LyrionTokenSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.Contract.TransferFrom(&_LyrionToken.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_LyrionToken *LyrionTokenTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _LyrionToken.Contract.TransferFrom(&_LyrionToken.TransactOpts, from, to, value)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LyrionToken *LyrionTokenTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _LyrionToken.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LyrionToken *LyrionTokenSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LyrionToken.Contract.TransferOwnership(&_LyrionToken.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LyrionToken *LyrionTokenTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LyrionToken.Contract.TransferOwnership(&_LyrionToken.TransactOpts, newOwner)
+}
+
+// LyrionTokenApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the LyrionToken contract.
+type LyrionTokenApprovalIterator struct {
+	Event *LyrionTokenApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionTokenApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionTokenApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionTokenApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionTokenApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionTokenApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionTokenApproval represents a Approval event raised by the LyrionToken contract.
+type LyrionTokenApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_LyrionToken *LyrionTokenFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*LyrionTokenApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _LyrionToken.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionTokenApprovalIterator{contract: _LyrionToken.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_LyrionToken *LyrionTokenFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *LyrionTokenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _LyrionToken.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionTokenApproval)
+				if err := _LyrionToken.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_LyrionToken *LyrionTokenFilterer) ParseApproval(log types.Log) (*LyrionTokenApproval, error) {
+	event := new(LyrionTokenApproval)
+	if err := _LyrionToken.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LyrionTokenBridgeUpdatedIterator is returned from FilterBridgeUpdated and is used to iterate over the raw logs and unpacked data for BridgeUpdated events raised by the LyrionToken contract.
+type LyrionTokenBridgeUpdatedIterator struct {
+	Event *LyrionTokenBridgeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionTokenBridgeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionTokenBridgeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionTokenBridgeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionTokenBridgeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionTokenBridgeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionTokenBridgeUpdated represents a BridgeUpdated event raised by the LyrionToken contract.
+type LyrionTokenBridgeUpdated struct {
+	OldBridge common.Address
+	NewBridge common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterBridgeUpdated is a free log retrieval operation binding the contract event 0xaae9beddccf584528e87b66c6ff2563825c8a1433305b8a656e9b5f9bf390499.
+//
+// Solidity: event BridgeUpdated(address indexed oldBridge, address indexed newBridge)
+func (_LyrionToken *LyrionTokenFilterer) FilterBridgeUpdated(opts *bind.FilterOpts, oldBridge []common.Address, newBridge []common.Address) (*LyrionTokenBridgeUpdatedIterator, error) {
+
+	var oldBridgeRule []interface{}
+	for _, oldBridgeItem := range oldBridge {
+		oldBridgeRule = append(oldBridgeRule, oldBridgeItem)
+	}
+	var newBridgeRule []interface{}
+	for _, newBridgeItem := range newBridge {
+		newBridgeRule = append(newBridgeRule, newBridgeItem)
+	}
+
+	logs, sub, err := _LyrionToken.contract.FilterLogs(opts, "BridgeUpdated", oldBridgeRule, newBridgeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionTokenBridgeUpdatedIterator{contract: _LyrionToken.contract, event: "BridgeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchBridgeUpdated is a free log subscription operation binding the contract event 0xaae9beddccf584528e87b66c6ff2563825c8a1433305b8a656e9b5f9bf390499.
+//
+// Solidity: event BridgeUpdated(address indexed oldBridge, address indexed newBridge)
+func (_LyrionToken *LyrionTokenFilterer) WatchBridgeUpdated(opts *bind.WatchOpts, sink chan<- *LyrionTokenBridgeUpdated, oldBridge []common.Address, newBridge []common.Address) (event.Subscription, error) {
+
+	var oldBridgeRule []interface{}
+	for _, oldBridgeItem := range oldBridge {
+		oldBridgeRule = append(oldBridgeRule, oldBridgeItem)
+	}
+	var newBridgeRule []interface{}
+	for _, newBridgeItem := range newBridge {
+		newBridgeRule = append(newBridgeRule, newBridgeItem)
+	}
+
+	logs, sub, err := _LyrionToken.contract.WatchLogs(opts, "BridgeUpdated", oldBridgeRule, newBridgeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionTokenBridgeUpdated)
+				if err := _LyrionToken.contract.UnpackLog(event, "BridgeUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBridgeUpdated is a log parse operation binding the contract event 0xaae9beddccf584528e87b66c6ff2563825c8a1433305b8a656e9b5f9bf390499.
+//
+// Solidity: event BridgeUpdated(address indexed oldBridge, address indexed newBridge)
+func (_LyrionToken *LyrionTokenFilterer) ParseBridgeUpdated(log types.Log) (*LyrionTokenBridgeUpdated, error) {
+	event := new(LyrionTokenBridgeUpdated)
+	if err := _LyrionToken.contract.UnpackLog(event, "BridgeUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LyrionTokenOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the LyrionToken contract.
+type LyrionTokenOwnershipTransferredIterator struct {
+	Event *LyrionTokenOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionTokenOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionTokenOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionTokenOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionTokenOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionTokenOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionTokenOwnershipTransferred represents a OwnershipTransferred event raised by the LyrionToken contract.
+type LyrionTokenOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LyrionToken *LyrionTokenFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*LyrionTokenOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LyrionToken.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionTokenOwnershipTransferredIterator{contract: _LyrionToken.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LyrionToken *LyrionTokenFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *LyrionTokenOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LyrionToken.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionTokenOwnershipTransferred)
+				if err := _LyrionToken.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LyrionToken *LyrionTokenFilterer) ParseOwnershipTransferred(log types.Log) (*LyrionTokenOwnershipTransferred, error) {
+	event := new(LyrionTokenOwnershipTransferred)
+	if err := _LyrionToken.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LyrionTokenTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the LyrionToken contract.
+type LyrionTokenTransferIterator struct {
+	Event *LyrionTokenTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LyrionTokenTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LyrionTokenTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LyrionTokenTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LyrionTokenTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LyrionTokenTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LyrionTokenTransfer represents a Transfer event raised by the LyrionToken contract.
+type LyrionTokenTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_LyrionToken *LyrionTokenFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*LyrionTokenTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _LyrionToken.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LyrionTokenTransferIterator{contract: _LyrionToken.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_LyrionToken *LyrionTokenFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *LyrionTokenTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _LyrionToken.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LyrionTokenTransfer)
+				if err := _LyrionToken.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_LyrionToken *LyrionTokenFilterer) ParseTransfer(log types.Log) (*LyrionTokenTransfer, error) {
+	event := new(LyrionTokenTransfer)
+	if err := _LyrionToken.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,583 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MockFtsoV2MetaData contains all meta data concerning the MockFtsoV2 contract.
+var MockFtsoV2MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"feeds\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes21\",\"internalType\":\"bytes21\"}],\"outputs\":[{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"decimals\",\"type\":\"int8\",\"internalType\":\"int8\"},{\"name\":\"exists\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getFeedById\",\"inputs\":[{\"name\":\"_feedId\",\"type\":\"bytes21\",\"internalType\":\"bytes21\"}],\"outputs\":[{\"name\":\"_value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_decimals\",\"type\":\"int8\",\"internalType\":\"int8\"},{\"name\":\"_timestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getFeedsById\",\"inputs\":[{\"name\":\"_feedIds\",\"type\":\"bytes21[]\",\"internalType\":\"bytes21[]\"}],\"outputs\":[{\"name\":\"_values\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"_decimals\",\"type\":\"int8[]\",\"internalType\":\"int8[]\"},{\"name\":\"_timestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setFeed\",\"inputs\":[{\"name\":\"_feedId\",\"type\":\"bytes21\",\"internalType\":\"bytes21\"},{\"name\":\"_value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_decimals\",\"type\":\"int8\",\"internalType\":\"int8\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"timestamp\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"FeedUpdated\",\"inputs\":[{\"name\":\"feedId\",\"type\":\"bytes21\",\"internalType\":\"bytes21\",\"indexed\":true},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"decimals\",\"type\":\"int8\",\"internalType\":\"int8\",\"indexed\":false},{\"name\":\"timestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"NotOwner\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UnknownFeed\",\"inputs\":[{\"name\":\"feedId\",\"type\":\"bytes21\",\"internalType\":\"bytes21\"}]}]",
+	Bin: "0x341561000b5760006000fd5b3360005561048a61003860003961048a6000f35b634e487b7160e01b610300526011610304526024610300fd600436106100555760003560e01c80638da5cb5b1461005b578063b80777ea1461009a57806371b67274146100c6578063d34d71ca1461013e57806393e9f806146102575780634c375745146102f55760006000fd5b60006000fd5b34156100675760006000fd5b60043610156100765760006000fd5b73ffffffffffffffffffffffffffffffffffffffff60005416610300526020610300f35b34156100a65760006000fd5b60043610156100b55760006000fd5b60005460a01c610300526020610300f35b34156100d25760006000fd5b60243610156100e15760006000fd5b60043560a81b1515156100f45760006000fd5b600160043560005260016020526040600020015460805260043560005260016020526040600020546103005260805160000b6103205260ff60805160081c16610340526060610300f35b341561014a5760006000fd5b60643610156101595760006000fd5b60043560a81b15151561016c5760006000fd5b60443560443560000b1415156101825760006000fd5b73ffffffffffffffffffffffffffffffffffffffff60005416331415156101b6576330cd747160e01b610300526004610300fd5b602435600435600052600160205260406000205561010060ff6044351617600160043560005260016020526040600020015567ffffffffffffffff421660a01b73ffffffffffffffffffffffffffffffffffffffff6000541617600055602435610300526044356103205260005460a01c610340526004357ff7c9af45a17e82661c90b3de1636d9ee17ff1b6309f54694b3e0d6db43afecae6060610300a2005b34156102635760006000fd5b60243610156102725760006000fd5b60043560a81b1515156102855760006000fd5b600160043560005260016020526040600020015460805260ff60805160081c1615156102c557633bc0eca760e01b61030052600435610304526024610300fd5b60043560005260016020526040600020546103005260805160000b6103205260005460a01c610340526060610300f35b34156103015760006000fd5b60243610156103105760006000fd5b63ffffffff60043511156103245760006000fd5b6004356004013560a05263ffffffff60a05111156103425760006000fd5b6004356024016080523660a0516020026080510111156103625760006000fd5b60606103005261030060a05160200261038001036103205260005460a01c6103405260a0516103605260a05160a0516020026103800152600060c0525b60a05160c05110156104545760c051602002608051013560e05260e05160a81b1515156103cc5760006000fd5b600160e0516000526001602052604060002001546101005260ff6101005160081c16151561040e57633bc0eca760e01b6103005260e051610304526024610300fd5b60e051600052600160205260406000205460c05160200261038001526101005160000b60c05160200260200160a051602002610380010152600160c0510160c05261039f565b61030060a05160200260200160a051602002610380010103610300f35b634e487b7160e01b610300526011610304526024610300fd",
+}
+
+// MockFtsoV2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use MockFtsoV2MetaData.ABI instead.
+var MockFtsoV2ABI = MockFtsoV2MetaData.ABI
+
+// MockFtsoV2Bin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use MockFtsoV2MetaData.Bin instead.
+var MockFtsoV2Bin = MockFtsoV2MetaData.Bin
+
+// DeployMockFtsoV2 deploys a new Ethereum contract, binding an instance of MockFtsoV2 to it.
+func DeployMockFtsoV2(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *MockFtsoV2, error) {
+	parsed, err := MockFtsoV2MetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(MockFtsoV2Bin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &MockFtsoV2{MockFtsoV2Caller: MockFtsoV2Caller{contract: contract}, MockFtsoV2Transactor: MockFtsoV2Transactor{contract: contract}, MockFtsoV2Filterer: MockFtsoV2Filterer{contract: contract}}, nil
+}
+
+// MockFtsoV2 is an auto generated Go binding around an Ethereum contract.
+type MockFtsoV2 struct {
+	MockFtsoV2Caller     // Read-only binding to the contract
+	MockFtsoV2Transactor // Write-only binding to the contract
+	MockFtsoV2Filterer   // Log filterer for contract events
+}
+
+// MockFtsoV2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type MockFtsoV2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MockFtsoV2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type MockFtsoV2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MockFtsoV2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MockFtsoV2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MockFtsoV2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MockFtsoV2Session struct {
+	Contract     *MockFtsoV2       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MockFtsoV2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MockFtsoV2CallerSession struct {
+	Contract *MockFtsoV2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// MockFtsoV2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MockFtsoV2TransactorSession struct {
+	Contract     *MockFtsoV2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// MockFtsoV2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type MockFtsoV2Raw struct {
+	Contract *MockFtsoV2 // Generic contract binding to access the raw methods on
+}
+
+// MockFtsoV2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MockFtsoV2CallerRaw struct {
+	Contract *MockFtsoV2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// MockFtsoV2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MockFtsoV2TransactorRaw struct {
+	Contract *MockFtsoV2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMockFtsoV2 creates a new instance of MockFtsoV2, bound to a specific deployed contract.
+func NewMockFtsoV2(address common.Address, backend bind.ContractBackend) (*MockFtsoV2, error) {
+	contract, err := bindMockFtsoV2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MockFtsoV2{MockFtsoV2Caller: MockFtsoV2Caller{contract: contract}, MockFtsoV2Transactor: MockFtsoV2Transactor{contract: contract}, MockFtsoV2Filterer: MockFtsoV2Filterer{contract: contract}}, nil
+}
+
+// NewMockFtsoV2Caller creates a new read-only instance of MockFtsoV2, bound to a specific deployed contract.
+func NewMockFtsoV2Caller(address common.Address, caller bind.ContractCaller) (*MockFtsoV2Caller, error) {
+	contract, err := bindMockFtsoV2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MockFtsoV2Caller{contract: contract}, nil
+}
+
+// NewMockFtsoV2Transactor creates a new write-only instance of MockFtsoV2, bound to a specific deployed contract.
+func NewMockFtsoV2Transactor(address common.Address, transactor bind.ContractTransactor) (*MockFtsoV2Transactor, error) {
+	contract, err := bindMockFtsoV2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MockFtsoV2Transactor{contract: contract}, nil
+}
+
+// NewMockFtsoV2Filterer creates a new log filterer instance of MockFtsoV2, bound to a specific deployed contract.
+func NewMockFtsoV2Filterer(address common.Address, filterer bind.ContractFilterer) (*MockFtsoV2Filterer, error) {
+	contract, err := bindMockFtsoV2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MockFtsoV2Filterer{contract: contract}, nil
+}
+
+// bindMockFtsoV2 binds a generic wrapper to an already deployed contract.
+func bindMockFtsoV2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MockFtsoV2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MockFtsoV2 *MockFtsoV2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MockFtsoV2.Contract.MockFtsoV2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MockFtsoV2 *MockFtsoV2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MockFtsoV2.Contract.MockFtsoV2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MockFtsoV2 *MockFtsoV2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MockFtsoV2.Contract.MockFtsoV2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MockFtsoV2 *MockFtsoV2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MockFtsoV2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MockFtsoV2 *MockFtsoV2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MockFtsoV2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MockFtsoV2 *MockFtsoV2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MockFtsoV2.Contract.contract.Transact(opts, method, params...)
+}
+
+// Feeds is a free data retrieval call binding the contract method 0x71b67274.
+//
+// Solidity: function feeds(bytes21 ) view returns(uint256 value, int8 decimals, bool exists)
+func (_MockFtsoV2 *MockFtsoV2Caller) Feeds(opts *bind.CallOpts, arg0 [21]byte) (struct {
+	Value    *big.Int
+	Decimals int8
+	Exists   bool
+}, error) {
+	var out []interface{}
+	err := _MockFtsoV2.contract.Call(opts, &out, "feeds", arg0)
+
+	outstruct := new(struct {
+		Value    *big.Int
+		Decimals int8
+		Exists   bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Value = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Decimals = *abi.ConvertType(out[1], new(int8)).(*int8)
+	outstruct.Exists = *abi.ConvertType(out[2], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// Feeds is a free data retrieval call binding the contract method 0x71b67274.
+//
+// Solidity: function feeds(bytes21 ) view returns(uint256 value, int8 decimals, bool exists)
+func (_MockFtsoV2 *MockFtsoV2Session) Feeds(arg0 [21]byte) (struct {
+	Value    *big.Int
+	Decimals int8
+	Exists   bool
+}, error) {
+	return _MockFtsoV2.Contract.Feeds(&_MockFtsoV2.CallOpts, arg0)
+}
+
+// Feeds is a free data retrieval call binding the contract method 0x71b67274.
+//
+// Solidity: function feeds(bytes21 ) view returns(uint256 value, int8 decimals, bool exists)
+func (_MockFtsoV2 *MockFtsoV2CallerSession) Feeds(arg0 [21]byte) (struct {
+	Value    *big.Int
+	Decimals int8
+	Exists   bool
+}, error) {
+	return _MockFtsoV2.Contract.Feeds(&_MockFtsoV2.CallOpts, arg0)
+}
+
+// GetFeedById is a free data retrieval call binding the contract method 0x93e9f806.
+//
+// Solidity: function getFeedById(bytes21 _feedId) view returns(uint256 _value, int8 _decimals, uint64 _timestamp)
+func (_MockFtsoV2 *MockFtsoV2Caller) GetFeedById(opts *bind.CallOpts, _feedId [21]byte) (struct {
+	Value     *big.Int
+	Decimals  int8
+	Timestamp uint64
+}, error) {
+	var out []interface{}
+	err := _MockFtsoV2.contract.Call(opts, &out, "getFeedById", _feedId)
+
+	outstruct := new(struct {
+		Value     *big.Int
+		Decimals  int8
+		Timestamp uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Value = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Decimals = *abi.ConvertType(out[1], new(int8)).(*int8)
+	outstruct.Timestamp = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// GetFeedById is a free data retrieval call binding the contract method 0x93e9f806.
+//
+// Solidity: function getFeedById(bytes21 _feedId) view returns(uint256 _value, int8 _decimals, uint64 _timestamp)
+func (_MockFtsoV2 *MockFtsoV2Session) GetFeedById(_feedId [21]byte) (struct {
+	Value     *big.Int
+	Decimals  int8
+	Timestamp uint64
+}, error) {
+	return _MockFtsoV2.Contract.GetFeedById(&_MockFtsoV2.CallOpts, _feedId)
+}
+
+// GetFeedById is a free data retrieval call binding the contract method 0x93e9f806.
+//
+// Solidity: function getFeedById(bytes21 _feedId) view returns(uint256 _value, int8 _decimals, uint64 _timestamp)
+func (_MockFtsoV2 *MockFtsoV2CallerSession) GetFeedById(_feedId [21]byte) (struct {
+	Value     *big.Int
+	Decimals  int8
+	Timestamp uint64
+}, error) {
+	return _MockFtsoV2.Contract.GetFeedById(&_MockFtsoV2.CallOpts, _feedId)
+}
+
+// GetFeedsById is a free data retrieval call binding the contract method 0x4c375745.
+//
+// Solidity: function getFeedsById(bytes21[] _feedIds) view returns(uint256[] _values, int8[] _decimals, uint64 _timestamp)
+func (_MockFtsoV2 *MockFtsoV2Caller) GetFeedsById(opts *bind.CallOpts, _feedIds [][21]byte) (struct {
+	Values    []*big.Int
+	Decimals  []int8
+	Timestamp uint64
+}, error) {
+	var out []interface{}
+	err := _MockFtsoV2.contract.Call(opts, &out, "getFeedsById", _feedIds)
+
+	outstruct := new(struct {
+		Values    []*big.Int
+		Decimals  []int8
+		Timestamp uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Values = *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+	outstruct.Decimals = *abi.ConvertType(out[1], new([]int8)).(*[]int8)
+	outstruct.Timestamp = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// GetFeedsById is a free data retrieval call binding the contract method 0x4c375745.
+//
+// Solidity: function getFeedsById(bytes21[] _feedIds) view returns(uint256[] _values, int8[] _decimals, uint64 _timestamp)
+func (_MockFtsoV2 *MockFtsoV2Session) GetFeedsById(_feedIds [][21]byte) (struct {
+	Values    []*big.Int
+	Decimals  []int8
+	Timestamp uint64
+}, error) {
+	return _MockFtsoV2.Contract.GetFeedsById(&_MockFtsoV2.CallOpts, _feedIds)
+}
+
+// GetFeedsById is a free data retrieval call binding the contract method 0x4c375745.
+//
+// Solidity: function getFeedsById(bytes21[] _feedIds) view returns(uint256[] _values, int8[] _decimals, uint64 _timestamp)
+func (_MockFtsoV2 *MockFtsoV2CallerSession) GetFeedsById(_feedIds [][21]byte) (struct {
+	Values    []*big.Int
+	Decimals  []int8
+	Timestamp uint64
+}, error) {
+	return _MockFtsoV2.Contract.GetFeedsById(&_MockFtsoV2.CallOpts, _feedIds)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_MockFtsoV2 *MockFtsoV2Caller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MockFtsoV2.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_MockFtsoV2 *MockFtsoV2Session) Owner() (common.Address, error) {
+	return _MockFtsoV2.Contract.Owner(&_MockFtsoV2.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_MockFtsoV2 *MockFtsoV2CallerSession) Owner() (common.Address, error) {
+	return _MockFtsoV2.Contract.Owner(&_MockFtsoV2.CallOpts)
+}
+
+// Timestamp is a free data retrieval call binding the contract method 0xb80777ea.
+//
+// Solidity: function timestamp() view returns(uint64)
+func (_MockFtsoV2 *MockFtsoV2Caller) Timestamp(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _MockFtsoV2.contract.Call(opts, &out, "timestamp")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// Timestamp is a free data retrieval call binding the contract method 0xb80777ea.
+//
+// Solidity: function timestamp() view returns(uint64)
+func (_MockFtsoV2 *MockFtsoV2Session) Timestamp() (uint64, error) {
+	return _MockFtsoV2.Contract.Timestamp(&_MockFtsoV2.CallOpts)
+}
+
+// Timestamp is a free data retrieval call binding the contract method 0xb80777ea.
+//
+// Solidity: function timestamp() view returns(uint64)
+func (_MockFtsoV2 *MockFtsoV2CallerSession) Timestamp() (uint64, error) {
+	return _MockFtsoV2.Contract.Timestamp(&_MockFtsoV2.CallOpts)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0xd34d71ca.
+//
+// Solidity: function setFeed(bytes21 _feedId, uint256 _value, int8 _decimals) returns()
+func (_MockFtsoV2 *MockFtsoV2Transactor) SetFeed(opts *bind.TransactOpts, _feedId [21]byte, _value *big.Int, _decimals int8) (*types.Transaction, error) {
+	return _MockFtsoV2.contract.Transact(opts, "setFeed", _feedId, _value, _decimals)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0xd34d71ca.
+//
+// Solidity: function setFeed(bytes21 _feedId, uint256 _value, int8 _decimals) returns()
+func (_MockFtsoV2 *MockFtsoV2Session) SetFeed(_feedId [21]byte, _value *big.Int, _decimals int8) (*types.Transaction, error) {
+	return _MockFtsoV2.Contract.SetFeed(&_MockFtsoV2.TransactOpts, _feedId, _value, _decimals)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0xd34d71ca.
+//
+// Solidity: function setFeed(bytes21 _feedId, uint256 _value, int8 _decimals) returns()
+func (_MockFtsoV2 *MockFtsoV2TransactorSession) SetFeed(_feedId [21]byte, _value *big.Int, _decimals int8) (*types.Transaction, error) {
+	return _MockFtsoV2.Contract.SetFeed(&_MockFtsoV2.TransactOpts, _feedId, _value, _decimals)
+}
+
+// MockFtsoV2FeedUpdatedIterator is returned from FilterFeedUpdated and is used to iterate over the raw logs and unpacked data for FeedUpdated events raised by the MockFtsoV2 contract.
+type MockFtsoV2FeedUpdatedIterator struct {
+	Event *MockFtsoV2FeedUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MockFtsoV2FeedUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MockFtsoV2FeedUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MockFtsoV2FeedUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MockFtsoV2FeedUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MockFtsoV2FeedUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MockFtsoV2FeedUpdated represents a FeedUpdated event raised by the MockFtsoV2 contract.
+type MockFtsoV2FeedUpdated struct {
+	FeedId    [21]byte
+	Value     *big.Int
+	Decimals  int8
+	Timestamp uint64
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeedUpdated is a free log retrieval operation binding the contract event 0xf7c9af45a17e82661c90b3de1636d9ee17ff1b6309f54694b3e0d6db43afecae.
+//
+// Solidity: event FeedUpdated(bytes21 indexed feedId, uint256 value, int8 decimals, uint64 timestamp)
+func (_MockFtsoV2 *MockFtsoV2Filterer) FilterFeedUpdated(opts *bind.FilterOpts, feedId [][21]byte) (*MockFtsoV2FeedUpdatedIterator, error) {
+
+	var feedIdRule []interface{}
+	for _, feedIdItem := range feedId {
+		feedIdRule = append(feedIdRule, feedIdItem)
+	}
+
+	logs, sub, err := _MockFtsoV2.contract.FilterLogs(opts, "FeedUpdated", feedIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MockFtsoV2FeedUpdatedIterator{contract: _MockFtsoV2.contract, event: "FeedUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchFeedUpdated is a free log subscription operation binding the contract event 0xf7c9af45a17e82661c90b3de1636d9ee17ff1b6309f54694b3e0d6db43afecae.
+//
+// Solidity: event FeedUpdated(bytes21 indexed feedId, uint256 value, int8 decimals, uint64 timestamp)
+func (_MockFtsoV2 *MockFtsoV2Filterer) WatchFeedUpdated(opts *bind.WatchOpts, sink chan<- *MockFtsoV2FeedUpdated, feedId [][21]byte) (event.Subscription, error) {
+
+	var feedIdRule []interface{}
+	for _, feedIdItem := range feedId {
+		feedIdRule = append(feedIdRule, feedIdItem)
+	}
+
+	logs, sub, err := _MockFtsoV2.contract.WatchLogs(opts, "FeedUpdated", feedIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MockFtsoV2FeedUpdated)
+				if err := _MockFtsoV2.contract.UnpackLog(event, "FeedUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeedUpdated is a log parse operation binding the contract event 0xf7c9af45a17e82661c90b3de1636d9ee17ff1b6309f54694b3e0d6db43afecae.
+//
+// Solidity: event FeedUpdated(bytes21 indexed feedId, uint256 value, int8 decimals, uint64 timestamp)
+func (_MockFtsoV2 *MockFtsoV2Filterer) ParseFeedUpdated(log types.Log) (*MockFtsoV2FeedUpdated, error) {
+	event := new(MockFtsoV2FeedUpdated)
+	if err := _MockFtsoV2.contract.UnpackLog(event, "FeedUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
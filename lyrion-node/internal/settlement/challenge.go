@@ -0,0 +1,447 @@
+package settlement
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/execution"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/bindings"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+// A batch is disputed when re-executing its L1 data does not give the
+// output root the sequencer submitted. The challenger sends challengeBatch
+// to LyrionBridge, which drops the batch and everything after it while it
+// is still inside the challenge period.
+//
+// This is a permissioned challenge, not a fraud proof: LyrionBridge checks
+// nothing but that the sender is a registered challenger, and stores a hash
+// of the dispute. The dispute itself is kept by the challenger and served
+// over RPC for off-chain review. Only invalid-tx disputes carry a step proof
+// (see VerifyStepProof): batch data holds no intermediate state roots, so a
+// root mismatch can't be narrowed down to one step.
+
+// Dispute kinds
+const (
+	DisputeInvalidTx      = "invalid-tx"      // A tx in the batch fails to execute (see Step)
+	DisputeInvalidDeposit = "invalid-deposit" // The batch credits a deposit that did not happen on L1
+	DisputeInvalidPrice   = "invalid-price"   // The batch posts prices the FTSO did not publish
+	DisputeRootMismatch   = "root-mismatch"   // Every tx executes but the output root differs
+	DisputeMissingData    = "missing-data"    // No batch data was published to the inbox
+)
+
+// Dispute statuses
+const (
+	DisputePending      = "pending"      // Recorded, challenge not sent yet
+	DisputeSubmitted    = "submitted"    // challengeBatch sent to L1
+	DisputeChallenged   = "challenged"   // The bridge invalidated the batch
+	DisputeTooLate      = "too-late"     // The batch was already finalized
+	DisputeUnregistered = "unregistered" // Our account is not a bridge challenger
+	DisputeFailed       = "failed"       // challengeBatch reverted
+)
+
+// disputesKey is the metadata key of the recorded disputes
+const disputesKey = "challenger-disputes"
+
+// WitnessEntry is a raw state key and its value (empty if the key does not
+// exist).
+type WitnessEntry struct {
+	Key   hexutil.Bytes `json:"key"`
+	Value hexutil.Bytes `json:"value"`
+}
+
+// StepProof is a one-step execution proof: executing Tx at BlockTime on a
+// state holding exactly PreState gives PostState, or fails with Error.
+// Since the executor only touches the keys it reads, PreState is all the
+// state the step depends on.
+type StepProof struct {
+	BatchNumber uint64         `json:"batchNumber"`
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockTime   uint64         `json:"blockTime"`
+	TxIndex     int            `json:"txIndex"`
+	TxHash      common.Hash    `json:"txHash"`
+	Deposit     bool           `json:"deposit"`         // Tx is an RLP core.Deposit instead of a signed tx
+	Price       bool           `json:"price,omitempty"` // Tx is an RLP core.PriceUpdate instead of a signed tx
+	Tx          hexutil.Bytes  `json:"tx"`
+	PreState    []WitnessEntry `json:"preState"`
+	PostState   []WitnessEntry `json:"postState"`
+	Error       string         `json:"error,omitempty"`
+}
+
+// snapshotState is a state that can run a step on a snapshot and record
+// its witness (state.BadgerStateDB).
+type snapshotState interface {
+	Snapshot() *state.BadgerStateDB
+}
+
+// executeTx runs one L2 tx of a block. The recovered sender is stored in
+// tx.From: txs decoded from batch data don't carry it, and withdrawals are
+// collected by sender.
+func executeTx(exec *execution.Executor, tx *core.Transaction, chainID *big.Int) error {
+	switch tx.Type {
+	case core.TxTypeDeposit:
+		return exec.ExecuteDeposit(tx)
+	case core.TxTypePriceUpdate:
+		return exec.ExecutePriceUpdate(tx)
+	}
+	sender, err := tx.Sender(chainID)
+	if err != nil {
+		return err
+	}
+	tx.From = &sender
+	return exec.ExecuteTransaction(tx, sender)
+}
+
+// newStepProof builds the proof of a tx that was executed on the snapshot
+// snap.
+func newStepProof(snap *state.BadgerStateDB, block *BlockData, index int, tx *core.Transaction, execErr error) (*StepProof, error) {
+	p := &StepProof{
+		BlockNumber: block.Number,
+		BlockTime:   block.Time,
+		TxIndex:     index,
+		TxHash:      tx.Hash(),
+		Deposit:     tx.Type == core.TxTypeDeposit,
+		Price:       tx.Type == core.TxTypePriceUpdate,
+	}
+	var err error
+	if p.Deposit || p.Price {
+		// Both carry their RLP payload in Data
+		p.Tx = common.CopyBytes(tx.Data)
+	} else {
+		p.Tx, err = tx.EthTx().MarshalBinary()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if execErr != nil {
+		p.Error = execErr.Error()
+	}
+
+	pre := snap.Witness()
+	keys := make([]string, 0, len(pre))
+	for key := range pre {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		p.PreState = append(p.PreState, WitnessEntry{Key: []byte(key), Value: pre[key]})
+		p.PostState = append(p.PostState, WitnessEntry{Key: []byte(key), Value: snap.GetRaw([]byte(key))})
+	}
+	return p, nil
+}
+
+// transaction decodes the proven tx.
+func (p *StepProof) transaction() (*core.Transaction, error) {
+	if p.Deposit {
+		var d core.Deposit
+		if err := rlp.DecodeBytes(p.Tx, &d); err != nil {
+			return nil, err
+		}
+		return core.NewDepositTx(&d)
+	}
+	if p.Price {
+		var u core.PriceUpdate
+		if err := rlp.DecodeBytes(p.Tx, &u); err != nil {
+			return nil, err
+		}
+		return core.NewPriceUpdateTx(&u)
+	}
+	var etx ethtypes.Transaction
+	if err := etx.UnmarshalBinary(p.Tx); err != nil {
+		return nil, err
+	}
+	return core.NewTransactionFromEth(&etx)
+}
+
+// VerifyStepProof re-executes the step on a fresh state seeded with its
+// pre-state and checks that it reads no other key and ends with the claimed
+// post-state and error.
+func VerifyStepProof(p *StepProof, chainID *big.Int) error {
+	tx, err := p.transaction()
+	if err != nil {
+		return fmt.Errorf("invalid tx: %v", err)
+	}
+	if tx.Hash() != p.TxHash {
+		return fmt.Errorf("tx hash is %s, proof claims %s", tx.Hash().Hex(), p.TxHash.Hex())
+	}
+
+	st, err := state.NewInMemoryBadgerStateDB()
+	if err != nil {
+		return err
+	}
+	defer st.Close()
+	pre := make(map[string]bool, len(p.PreState))
+	for _, e := range p.PreState {
+		pre[string(e.Key)] = true
+		if len(e.Value) > 0 {
+			if err := st.SetRaw(e.Key, e.Value); err != nil {
+				return err
+			}
+		}
+	}
+
+	snap := st.Snapshot()
+	exec := execution.NewExecutor(snap, chainID)
+	exec.SetBlockTime(p.BlockTime)
+	execErr := executeTx(exec, tx, chainID)
+	touched := snap.Witness()
+
+	for key := range touched {
+		if !pre[key] {
+			return fmt.Errorf("step reads %x, which is not in the pre-state", key)
+		}
+	}
+	var gotErr string
+	if execErr != nil {
+		gotErr = execErr.Error()
+	}
+	if gotErr != p.Error {
+		return fmt.Errorf("step error is %q, proof claims %q", gotErr, p.Error)
+	}
+	for _, e := range p.PostState {
+		if got := snap.GetRaw(e.Key); !bytes.Equal(got, e.Value) {
+			return fmt.Errorf("post-state of %x is %x, proof claims %x", []byte(e.Key), got, []byte(e.Value))
+		}
+	}
+	return nil
+}
+
+// Dispute is the evidence that a submitted batch is invalid.
+type Dispute struct {
+	BatchNumber      uint64      `json:"batchNumber"`
+	Kind             string      `json:"kind"`
+	Reason           string      `json:"reason"`
+	ClaimedRoot      common.Hash `json:"claimedRoot"`                // Output root on L1
+	DerivedRoot      common.Hash `json:"derivedRoot"`                // Output root from re-execution (zero if derivation stopped)
+	DerivedStateRoot common.Hash `json:"derivedStateRoot,omitempty"` // State root the derived output root commits to
+	BatchTxHash      common.Hash `json:"batchTxHash"`                // L1 tx that submitted the batch
+	Step             *StepProof  `json:"step,omitempty"`
+
+	ProofHash       common.Hash `json:"proofHash"` // Sent to the bridge with the challenge
+	Status          string      `json:"status"`
+	ChallengeTxHash common.Hash `json:"challengeTxHash,omitempty"`
+	CreatedAt       int64       `json:"createdAt"`
+}
+
+// hash commits to the evidence (not the challenge status).
+func (d *Dispute) hash() common.Hash {
+	evidence, _ := json.Marshal(struct {
+		BatchNumber      uint64
+		Kind             string
+		ClaimedRoot      common.Hash
+		DerivedRoot      common.Hash
+		DerivedStateRoot common.Hash
+		Step             *StepProof
+	}{d.BatchNumber, d.Kind, d.ClaimedRoot, d.DerivedRoot, d.DerivedStateRoot, d.Step})
+	return crypto.Keccak256Hash(evidence)
+}
+
+// disputeError is a derivation failure that proves the batch invalid.
+type disputeError struct {
+	kind      string
+	err       error
+	root      common.Hash // Derived output root (root mismatches)
+	stateRoot common.Hash
+	step      *StepProof
+}
+
+func (e *disputeError) Error() string { return e.err.Error() }
+func (e *disputeError) Unwrap() error { return e.err }
+
+// Challenger sends challenges for disputed batches to LyrionBridge.
+type Challenger struct {
+	bridge        *bindings.LyrionBridge
+	bridgeAddress common.Address
+	bridgeABI     *abi.ABI
+	txmgr         *TxManager
+	from          common.Address
+	store         state.StateDB
+
+	disputes []*Dispute
+	mu       sync.Mutex
+}
+
+// NewChallenger connects to L1 and loads the recorded disputes.
+func NewChallenger(flareRPC string, bridgeAddress common.Address, store state.StateDB, key *ecdsa.PrivateKey) (*Challenger, error) {
+	client, err := ethclient.Dial(flareRPC)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to Flare L1 at %s: %v", flareRPC, err)
+	}
+	return NewChallengerWithClient(client, bridgeAddress, store, key)
+}
+
+// NewChallengerWithClient creates a challenger on an existing L1 client.
+func NewChallengerWithClient(client L1Client, bridgeAddress common.Address, store state.StateDB, key *ecdsa.PrivateKey) (*Challenger, error) {
+	bridgeABI, err := bindings.LyrionBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse LyrionBridge ABI: %v", err)
+	}
+	l1ChainID, err := client.ChainID(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("could not get Flare chain ID: %v", err)
+	}
+	bridge, err := bindings.NewLyrionBridge(bridgeAddress, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind LyrionBridge: %v", err)
+	}
+
+	c := &Challenger{
+		bridge:        bridge,
+		bridgeAddress: bridgeAddress,
+		bridgeABI:     bridgeABI,
+		txmgr:         NewTxManager(client, key, l1ChainID, DefaultTxConfig()),
+		from:          crypto.PubkeyToAddress(key.PublicKey),
+		store:         store,
+	}
+	if data := store.GetMeta(disputesKey); data != nil {
+		if err := json.Unmarshal(data, &c.disputes); err != nil {
+			return nil, fmt.Errorf("corrupt dispute records: %v", err)
+		}
+	}
+	return c, nil
+}
+
+// SetTxConfig replaces the L1 tx settings.
+func (c *Challenger) SetTxConfig(cfg TxConfig) {
+	c.txmgr.SetConfig(cfg)
+}
+
+// Start resumes the challenges that were not settled before a restart.
+func (c *Challenger) Start() {
+	log.Printf("⚔️ Challenger enabled (Account: %s, Bridge: %s)", c.from.Hex(), c.bridgeAddress.Hex())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, d := range c.disputes {
+		if d.Status == DisputePending || d.Status == DisputeSubmitted {
+			go c.challenge(d)
+		}
+	}
+}
+
+// Challenge records a dispute and challenges its batch on L1.
+func (c *Challenger) Challenge(d *Dispute) {
+	d.ProofHash = d.hash()
+	d.Status = DisputePending
+	d.CreatedAt = time.Now().Unix()
+
+	c.mu.Lock()
+	c.disputes = append(c.disputes, d)
+	c.persist()
+	c.mu.Unlock()
+
+	log.Printf("🚨 Disputing batch #%d (%s): %s", d.BatchNumber, d.Kind, d.Reason)
+	go c.challenge(d)
+}
+
+// challenge sends challengeBatch unless the batch is already gone or final.
+func (c *Challenger) challenge(d *Dispute) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	status, txHash, err := c.sendChallenge(ctx, d)
+	if err != nil {
+		log.Printf("⚠️ Challenge of batch #%d failed: %v", d.BatchNumber, err)
+		return
+	}
+	c.setStatus(d, status, txHash)
+}
+
+// sendChallenge returns the final dispute status and the mined challenge tx
+// (zero if none was sent).
+func (c *Challenger) sendChallenge(ctx context.Context, d *Dispute) (string, common.Hash, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	n := new(big.Int).SetUint64(d.BatchNumber)
+
+	current, err := c.bridge.CurrentBatchNumber(opts)
+	if err != nil {
+		return "", common.Hash{}, fmt.Errorf("failed to read currentBatchNumber: %v", err)
+	}
+	if current.Cmp(n) < 0 {
+		log.Printf("⚔️ Batch #%d is no longer on L1", d.BatchNumber)
+		return DisputeChallenged, common.Hash{}, nil
+	}
+	if final, err := c.bridge.IsBatchFinalized(opts, n); err != nil {
+		return "", common.Hash{}, fmt.Errorf("failed to read batch finality: %v", err)
+	} else if final {
+		log.Printf("🚨 Batch #%d is invalid but already finalized: too late to challenge", d.BatchNumber)
+		return DisputeTooLate, common.Hash{}, nil
+	}
+	if ok, err := c.bridge.Challengers(opts, c.from); err != nil {
+		return "", common.Hash{}, fmt.Errorf("failed to read challenger registry: %v", err)
+	} else if !ok {
+		log.Printf("🚨 %s is not a registered challenger (LyrionBridge.setChallenger): batch #%d cannot be challenged", c.from.Hex(), d.BatchNumber)
+		return DisputeUnregistered, common.Hash{}, nil
+	}
+
+	data, err := c.bridgeABI.Pack("challengeBatch", n, d.DerivedRoot, d.ProofHash)
+	if err != nil {
+		return "", common.Hash{}, fmt.Errorf("failed to encode challengeBatch: %v", err)
+	}
+	tx, err := c.txmgr.Sign(ctx, c.bridgeAddress, data)
+	if err != nil {
+		return "", common.Hash{}, err
+	}
+	c.setStatus(d, DisputeSubmitted, tx.Hash())
+	log.Printf("⚔️ Challenging batch #%d - TxHash: %s", d.BatchNumber, tx.Hash().Hex())
+
+	receipt, err := c.txmgr.Publish(ctx, tx, func(bumped *ethtypes.Transaction) error {
+		c.setStatus(d, DisputeSubmitted, bumped.Hash())
+		return nil
+	})
+	if err != nil {
+		return "", common.Hash{}, err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return DisputeFailed, receipt.TxHash, nil
+	}
+	log.Printf("✅ Batch #%d invalidated on L1 (Block: %d)", d.BatchNumber, receipt.BlockNumber.Uint64())
+	return DisputeChallenged, receipt.TxHash, nil
+}
+
+func (c *Challenger) setStatus(d *Dispute, status string, txHash common.Hash) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d.Status = status
+	if txHash != (common.Hash{}) {
+		d.ChallengeTxHash = txHash
+	}
+	c.persist()
+}
+
+// persist saves the disputes. Caller holds c.mu.
+func (c *Challenger) persist() {
+	data, _ := json.Marshal(c.disputes)
+	if err := c.store.SetMeta(disputesKey, data); err != nil {
+		log.Printf("⚠️ Failed to persist disputes: %v", err)
+	}
+}
+
+// GetDisputes returns the recorded disputes
+func (c *Challenger) GetDisputes() []*Dispute {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]*Dispute, len(c.disputes))
+	for i, d := range c.disputes {
+		cp := *d
+		out[i] = &cp
+	}
+	return out
+}
@@ -0,0 +1,389 @@
+package settlement
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+// Batch data is published to L1 so anyone can rebuild the L2 chain without
+// the sequencer. A batch's blocks are RLP-encoded and zlib-compressed into a
+// channel, which is split into frames small enough for one L1 tx each.
+//
+// Frame layout:
+//
+//	version     uint8    (ChannelVersion0, or ChannelVersion1 if the channel has price updates)
+//	channelID   [16]byte (first 16 bytes of keccak256 of the compressed channel)
+//	frameNumber uint16   (big-endian)
+//	isLast      uint8    (1 on the last frame)
+//	data        []byte   (slice of the compressed channel)
+const (
+	ChannelVersion0 = 0x00
+	ChannelVersion1 = 0x01 // Blocks also carry FTSO price updates
+
+	// MaxFrameSize keeps frame txs below the 128 KB L1 txpool limit
+	MaxFrameSize = 120_000
+
+	// MaxChannelSize bounds a decompressed channel, so a malicious frame
+	// can't exhaust memory
+	MaxChannelSize = 16 * 1024 * 1024
+
+	frameHeaderSize = 1 + 16 + 2 + 1
+)
+
+var ErrInvalidChannel = errors.New("invalid batch channel")
+
+// ChannelID identifies the frames of one channel.
+type ChannelID [16]byte
+
+// BatchData is the content of a channel: the L2 blocks of one batch.
+type BatchData struct {
+	BatchNumber uint64
+	StartBlock  uint64
+	EndBlock    uint64
+	Blocks      []*BlockData
+}
+
+// BlockData holds what is needed to re-execute an L2 block: its number and
+// time, the L1 deposits it credited (in order), the FTSO prices it posted
+// and its signed user txs.
+type BlockData struct {
+	Number   uint64
+	Time     uint64
+	Deposits []*core.Deposit
+	Prices   []*core.PriceUpdate
+	Txs      []*core.Transaction
+}
+
+// channelV0 and blockV0 are the RLP layout of a version 0 channel.
+type channelV0 struct {
+	BatchNumber uint64
+	StartBlock  uint64
+	EndBlock    uint64
+	Blocks      []blockV0
+}
+
+type blockV0 struct {
+	Number   uint64
+	Time     uint64
+	Deposits []*core.Deposit
+	Txs      [][]byte // Ethereum binary encoding of signed txs
+}
+
+// channelV1 and blockV1 are the RLP layout of a version 1 channel, whose
+// blocks add the price updates they posted.
+type channelV1 struct {
+	BatchNumber uint64
+	StartBlock  uint64
+	EndBlock    uint64
+	Blocks      []blockV1
+}
+
+type blockV1 struct {
+	Number   uint64
+	Time     uint64
+	Deposits []*core.Deposit
+	Prices   []*core.PriceUpdate
+	Txs      [][]byte
+}
+
+// encode RLP-encodes ch in the given version. Version 0 has no prices.
+func (ch *channelV1) encode(version byte) ([]byte, error) {
+	if version == ChannelVersion1 {
+		return rlp.EncodeToBytes(ch)
+	}
+	v0 := channelV0{BatchNumber: ch.BatchNumber, StartBlock: ch.StartBlock, EndBlock: ch.EndBlock}
+	for _, b := range ch.Blocks {
+		if len(b.Prices) > 0 {
+			return nil, fmt.Errorf("%w: block %d has price updates", ErrInvalidChannel, b.Number)
+		}
+		v0.Blocks = append(v0.Blocks, blockV0{Number: b.Number, Time: b.Time, Deposits: b.Deposits, Txs: b.Txs})
+	}
+	return rlp.EncodeToBytes(&v0)
+}
+
+// decodeChannel decodes the RLP of a channel of the given version.
+func decodeChannel(version byte, encoded []byte) (*channelV1, error) {
+	if version == ChannelVersion1 {
+		var ch channelV1
+		return &ch, rlp.DecodeBytes(encoded, &ch)
+	}
+	var v0 channelV0
+	if err := rlp.DecodeBytes(encoded, &v0); err != nil {
+		return nil, err
+	}
+	ch := &channelV1{BatchNumber: v0.BatchNumber, StartBlock: v0.StartBlock, EndBlock: v0.EndBlock}
+	for _, b := range v0.Blocks {
+		ch.Blocks = append(ch.Blocks, blockV1{Number: b.Number, Time: b.Time, Deposits: b.Deposits, Txs: b.Txs})
+	}
+	return ch, nil
+}
+
+// NewBatchData collects the data of blocks for batch batchNumber.
+func NewBatchData(batchNumber uint64, blocks []*core.Block) (*BatchData, error) {
+	if len(blocks) == 0 {
+		return nil, fmt.Errorf("%w: no blocks", ErrInvalidChannel)
+	}
+	bd := &BatchData{
+		BatchNumber: batchNumber,
+		StartBlock:  blocks[0].Header.Number,
+		EndBlock:    blocks[len(blocks)-1].Header.Number,
+	}
+	for i, block := range blocks {
+		if block.Header.Number != bd.StartBlock+uint64(i) {
+			return nil, fmt.Errorf("%w: missing block %d", ErrInvalidChannel, bd.StartBlock+uint64(i))
+		}
+		b := &BlockData{Number: block.Header.Number, Time: block.Header.Time}
+		for _, tx := range block.Transactions {
+			if tx.Type == core.TxTypeDeposit {
+				d, err := core.DecodeDeposit(tx.Data)
+				if err != nil {
+					return nil, err
+				}
+				b.Deposits = append(b.Deposits, d)
+				continue
+			}
+			if tx.Type == core.TxTypePriceUpdate {
+				u, err := core.DecodePriceUpdate(tx.Data)
+				if err != nil {
+					return nil, err
+				}
+				b.Prices = append(b.Prices, u)
+				continue
+			}
+			b.Txs = append(b.Txs, tx)
+		}
+		bd.Blocks = append(bd.Blocks, b)
+	}
+	return bd, nil
+}
+
+// Transactions rebuilds the block's tx list in execution order: deposits
+// first, then price updates, then user txs.
+func (b *BlockData) Transactions() ([]*core.Transaction, error) {
+	txs := make([]*core.Transaction, 0, len(b.Deposits)+len(b.Prices)+len(b.Txs))
+	for _, d := range b.Deposits {
+		tx, err := core.NewDepositTx(d)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	for _, u := range b.Prices {
+		tx, err := core.NewPriceUpdateTx(u)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return append(txs, b.Txs...), nil
+}
+
+// EncodeChannel compresses the batch data into a channel and splits it into
+// frames of at most MaxFrameSize bytes. Batches without price updates keep
+// using version 0.
+func EncodeChannel(bd *BatchData) ([][]byte, error) {
+	version := byte(ChannelVersion0)
+	ch := channelV1{BatchNumber: bd.BatchNumber, StartBlock: bd.StartBlock, EndBlock: bd.EndBlock}
+	for _, b := range bd.Blocks {
+		if len(b.Prices) > 0 {
+			version = ChannelVersion1
+		}
+		block := blockV1{Number: b.Number, Time: b.Time, Deposits: b.Deposits, Prices: b.Prices}
+		for _, tx := range b.Txs {
+			raw, err := tx.EthTx().MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			block.Txs = append(block.Txs, raw)
+		}
+		ch.Blocks = append(ch.Blocks, block)
+	}
+	encoded, err := ch.encode(version)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w, _ := zlib.NewWriterLevel(&buf, zlib.BestCompression)
+	if _, err := w.Write(encoded); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	compressed := buf.Bytes()
+
+	var id ChannelID
+	copy(id[:], crypto.Keccak256(compressed))
+
+	chunk := MaxFrameSize - frameHeaderSize
+	var frames [][]byte
+	for n := 0; n == 0 || n*chunk < len(compressed); n++ {
+		end := min((n+1)*chunk, len(compressed))
+		frame := make([]byte, frameHeaderSize, frameHeaderSize+end-n*chunk)
+		frame[0] = version
+		copy(frame[1:17], id[:])
+		binary.BigEndian.PutUint16(frame[17:19], uint16(n))
+		if end == len(compressed) {
+			frame[19] = 1
+		}
+		frames = append(frames, append(frame, compressed[n*chunk:end]...))
+	}
+	if len(frames) > 1<<16 {
+		return nil, fmt.Errorf("%w: %d frames", ErrInvalidChannel, len(frames))
+	}
+	return frames, nil
+}
+
+// Frame is a parsed channel frame.
+type Frame struct {
+	Version   byte
+	ChannelID ChannelID
+	Number    uint16
+	IsLast    bool
+	Data      []byte
+}
+
+// ParseFrame parses the calldata of a batch data tx.
+func ParseFrame(data []byte) (*Frame, error) {
+	if len(data) < frameHeaderSize {
+		return nil, fmt.Errorf("%w: frame too short", ErrInvalidChannel)
+	}
+	if data[0] != ChannelVersion0 && data[0] != ChannelVersion1 {
+		return nil, fmt.Errorf("%w: unknown version %d", ErrInvalidChannel, data[0])
+	}
+	if data[19] > 1 {
+		return nil, fmt.Errorf("%w: bad last-frame flag", ErrInvalidChannel)
+	}
+	f := &Frame{
+		Version: data[0],
+		Number:  binary.BigEndian.Uint16(data[17:19]),
+		IsLast:  data[19] == 1,
+		Data:    data[frameHeaderSize:],
+	}
+	copy(f.ChannelID[:], data[1:17])
+	return f, nil
+}
+
+// ChannelAssembler collects frames (in any order, duplicates allowed) and
+// decodes channels once all their frames are in.
+type ChannelAssembler struct {
+	frames map[ChannelID]map[uint16]*Frame
+}
+
+func NewChannelAssembler() *ChannelAssembler {
+	return &ChannelAssembler{frames: make(map[ChannelID]map[uint16]*Frame)}
+}
+
+// AddFrame adds a frame and returns the decoded batch data if this frame
+// completed its channel.
+func (a *ChannelAssembler) AddFrame(f *Frame) (*BatchData, error) {
+	frames := a.frames[f.ChannelID]
+	if frames == nil {
+		frames = make(map[uint16]*Frame)
+		a.frames[f.ChannelID] = frames
+	}
+	for _, fr := range frames {
+		if fr.Version != f.Version {
+			delete(a.frames, f.ChannelID)
+			return nil, fmt.Errorf("%w: channel %x mixes versions %d and %d", ErrInvalidChannel, f.ChannelID, fr.Version, f.Version)
+		}
+		break
+	}
+	frames[f.Number] = f
+
+	// Complete when frames 0..last are all present
+	var last *Frame
+	for _, fr := range frames {
+		if fr.IsLast {
+			last = fr
+		}
+	}
+	if last == nil || len(frames) != int(last.Number)+1 {
+		return nil, nil
+	}
+	numbers := make([]int, 0, len(frames))
+	for n := range frames {
+		numbers = append(numbers, int(n))
+	}
+	sort.Ints(numbers)
+
+	var compressed []byte
+	for _, n := range numbers {
+		compressed = append(compressed, frames[uint16(n)].Data...)
+	}
+	delete(a.frames, f.ChannelID)
+
+	if !bytes.Equal(crypto.Keccak256(compressed)[:16], f.ChannelID[:]) {
+		return nil, fmt.Errorf("%w: channel %x does not match its data", ErrInvalidChannel, f.ChannelID)
+	}
+	return DecodeChannel(f.Version, compressed)
+}
+
+// Drop discards the frames collected for an incomplete channel.
+func (a *ChannelAssembler) Drop(id ChannelID) {
+	delete(a.frames, id)
+}
+
+// DecodeChannel decodes a complete compressed channel of the given version.
+func DecodeChannel(version byte, compressed []byte) (*BatchData, error) {
+	r, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidChannel, err)
+	}
+	defer r.Close()
+	encoded, err := io.ReadAll(io.LimitReader(r, MaxChannelSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidChannel, err)
+	}
+	if len(encoded) > MaxChannelSize {
+		return nil, fmt.Errorf("%w: channel exceeds %d bytes", ErrInvalidChannel, MaxChannelSize)
+	}
+
+	ch, err := decodeChannel(version, encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidChannel, err)
+	}
+	bd := &BatchData{BatchNumber: ch.BatchNumber, StartBlock: ch.StartBlock, EndBlock: ch.EndBlock}
+	next := ch.StartBlock
+	for _, block := range ch.Blocks {
+		if block.Number != next {
+			return nil, fmt.Errorf("%w: block %d out of order", ErrInvalidChannel, block.Number)
+		}
+		next++
+		b := &BlockData{Number: block.Number, Time: block.Time, Deposits: block.Deposits, Prices: block.Prices}
+		for _, raw := range block.Txs {
+			var etx ethtypes.Transaction
+			if err := etx.UnmarshalBinary(raw); err != nil {
+				return nil, fmt.Errorf("%w: block %d: %v", ErrInvalidChannel, block.Number, err)
+			}
+			tx, err := core.NewTransactionFromEth(&etx)
+			if err != nil {
+				return nil, fmt.Errorf("%w: block %d: %v", ErrInvalidChannel, block.Number, err)
+			}
+			b.Txs = append(b.Txs, tx)
+		}
+		bd.Blocks = append(bd.Blocks, b)
+	}
+	if len(bd.Blocks) == 0 || next-1 != ch.EndBlock {
+		return nil, fmt.Errorf("%w: blocks do not cover %d-%d", ErrInvalidChannel, ch.StartBlock, ch.EndBlock)
+	}
+	return bd, nil
+}
+
+// DefaultBatchInbox returns the conventional L1 address batch data is sent
+// to for an L2 chain: 0xff00..00 followed by the chain ID in decimal digits.
+func DefaultBatchInbox(chainID uint64) common.Address {
+	return common.HexToAddress(fmt.Sprintf("0xff%038d", chainID))
+}
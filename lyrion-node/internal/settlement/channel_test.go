@@ -0,0 +1,170 @@
+package settlement
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+// testBatchData returns a batch of blocks 5-6 with a deposit and signed
+// txs carrying data of the given size, plus prices if withPrices is set.
+func testBatchData(t *testing.T, dataSize int, withPrices bool) *BatchData {
+	t.Helper()
+	key, _ := crypto.GenerateKey()
+	to := common.HexToAddress("0xb0b")
+	bd := &BatchData{BatchNumber: 3, StartBlock: 5, EndBlock: 6}
+	for n := uint64(5); n <= 6; n++ {
+		b := &BlockData{Number: n, Time: 1000 + n}
+		b.Deposits = append(b.Deposits, &core.Deposit{
+			Nonce: n, Sender: to, Recipient: to, Amount: big.NewInt(int64(n)),
+			L1TxHash: common.Hash{byte(n)}, L1BlockNumber: 40 + n,
+		})
+		if withPrices {
+			b.Prices = append(b.Prices, &core.PriceUpdate{
+				Timestamp: 900 + n, L1BlockNumber: 40 + n,
+				Prices: []*core.PriceFeed{{Symbol: "FLR", Price: big.NewInt(21500 + int64(n))}},
+			})
+		}
+		data := make([]byte, dataSize)
+		rand.Read(data)
+		tx, err := core.SignTx(&core.Transaction{
+			Nonce: n, To: &to, Value: big.NewInt(1), Gas: 21000 + 16*uint64(dataSize),
+			GasPrice: big.NewInt(1), Data: data,
+		}, testL2ChainID, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.Txs = append(b.Txs, tx)
+		bd.Blocks = append(bd.Blocks, b)
+	}
+	return bd
+}
+
+// assemble feeds frames to a new assembler in the given order and returns
+// the channel completed by the last one.
+func assemble(t *testing.T, frames [][]byte, order []int) (*BatchData, error) {
+	t.Helper()
+	a := NewChannelAssembler()
+	var bd *BatchData
+	for i, n := range order {
+		f, err := ParseFrame(frames[n])
+		if err != nil {
+			t.Fatal(err)
+		}
+		bd, err = a.AddFrame(f)
+		if err != nil {
+			return nil, err
+		}
+		if bd != nil && i != len(order)-1 {
+			t.Fatalf("channel completed after %d of %d frames", i+1, len(order))
+		}
+	}
+	return bd, nil
+}
+
+func TestChannelRoundTrip(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		withPrices bool
+		version    byte
+	}{
+		{"no prices", false, ChannelVersion0},
+		{"prices", true, ChannelVersion1},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			want := testBatchData(t, 100, c.withPrices)
+			frames, err := EncodeChannel(want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(frames) != 1 || frames[0][0] != c.version {
+				t.Fatalf("%d frames of version %d, want 1 of version %d", len(frames), frames[0][0], c.version)
+			}
+			got, err := assemble(t, frames, []int{0})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.BatchNumber != want.BatchNumber || got.StartBlock != want.StartBlock || got.EndBlock != want.EndBlock || len(got.Blocks) != len(want.Blocks) {
+				t.Fatalf("decoded batch #%d %d-%d with %d blocks", got.BatchNumber, got.StartBlock, got.EndBlock, len(got.Blocks))
+			}
+			for i, b := range got.Blocks {
+				w := want.Blocks[i]
+				if b.Number != w.Number || b.Time != w.Time {
+					t.Fatalf("block %d at %d, want %d at %d", b.Number, b.Time, w.Number, w.Time)
+				}
+				gotRLP, _ := rlp.EncodeToBytes([]interface{}{b.Deposits, b.Prices})
+				wantRLP, _ := rlp.EncodeToBytes([]interface{}{w.Deposits, w.Prices})
+				if !bytes.Equal(gotRLP, wantRLP) {
+					t.Fatalf("block %d deposits or prices changed", b.Number)
+				}
+				if len(b.Txs) != len(w.Txs) || b.Txs[0].Hash() != w.Txs[0].Hash() {
+					t.Fatalf("block %d txs changed", b.Number)
+				}
+				sender, err := core.RecoverSender(b.Txs[0], testL2ChainID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want, _ := core.RecoverSender(w.Txs[0], testL2ChainID); sender != want {
+					t.Fatalf("decoded tx signed by %s, want %s", sender.Hex(), want.Hex())
+				}
+			}
+		})
+	}
+}
+
+func TestChannelSizeLimits(t *testing.T) {
+	// Incompressible data is split into full frames that reassemble in any order
+	want := testBatchData(t, 2*MaxFrameSize, false)
+	frames, err := EncodeChannel(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) < 4 {
+		t.Fatalf("%d frames for %d bytes of tx data", len(frames), 4*MaxFrameSize)
+	}
+	for i, f := range frames {
+		if len(f) > MaxFrameSize {
+			t.Fatalf("frame %d is %d bytes, above %d", i, len(f), MaxFrameSize)
+		}
+	}
+	order := mrand.Perm(len(frames))
+	got, err := assemble(t, frames, order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || got.Blocks[1].Txs[0].Hash() != want.Blocks[1].Txs[0].Hash() {
+		t.Fatalf("frames in order %v did not reassemble the channel", order)
+	}
+
+	// Frames of one channel can't mix versions
+	frames[1][0] = ChannelVersion1
+	if _, err := assemble(t, frames, []int{0, 1}); !errors.Is(err, ErrInvalidChannel) {
+		t.Fatalf("mixed versions: %v", err)
+	}
+
+	// A channel inflating past MaxChannelSize is rejected
+	encoded, err := rlp.EncodeToBytes(&channelV0{
+		BatchNumber: 1, StartBlock: 1, EndBlock: 1,
+		Blocks: []blockV0{{Number: 1, Txs: [][]byte{make([]byte, MaxChannelSize)}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	w.Write(encoded)
+	w.Close()
+	if _, err := DecodeChannel(ChannelVersion0, buf.Bytes()); !errors.Is(err, ErrInvalidChannel) {
+		t.Fatalf("oversized channel: %v", err)
+	}
+}
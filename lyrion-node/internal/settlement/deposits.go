@@ -0,0 +1,278 @@
+package settlement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/lyrion-l2/lyrion-node/internal/consensus"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/bindings"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+const (
+	// maxLogBlockRange is the widest eth_getLogs range we request
+	// (Flare public RPC nodes reject wider ranges)
+	maxLogBlockRange = 30
+
+	// depositCursorKey is the metadata key of the last fully scanned L1 block
+	depositCursorKey = "deposit-cursor"
+
+	// depositRequeueBlocks is how many L2 blocks a queued deposit may wait
+	// before it is queued again (the sequencer drops deposits that fail)
+	depositRequeueBlocks = 10
+)
+
+// pendingDeposit is a deposit queued on the sequencer and the L2 height it
+// was queued at.
+type pendingDeposit struct {
+	deposit  *core.Deposit
+	queuedAt uint64
+}
+
+// l1Cursor identifies an L1 block, so reorgs below it can be detected.
+type l1Cursor struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+}
+
+// DepositWatcher follows LyrionBridge DepositInitiated events on L1 and
+// queues each deposit on the sequencer once it has enough confirmations.
+//
+// Exactly-once crediting: the executor marks each bridge nonce as processed
+// in L2 state, and the watcher only persists its L1 cursor once every deposit
+// it queued has been included. After a restart or an L1 reorg it rescans from
+// the cursor and skips nonces that were already credited.
+type DepositWatcher struct {
+	client        L1Client
+	bridge        *bindings.LyrionBridgeFilterer
+	bridgeAddress common.Address
+	state         state.StateDB
+	sequencer     *consensus.Sequencer
+
+	confirmations uint64
+	startBlock    uint64 // First L1 block to scan when there is no cursor yet
+
+	cursor  l1Cursor                   // Last L1 block scanned
+	pending map[uint64]*pendingDeposit // Queued on the sequencer, not yet included
+	mu      sync.Mutex
+}
+
+// NewDepositWatcher connects to L1 and restores the persisted cursor.
+func NewDepositWatcher(flareRPC string, bridgeAddress common.Address, st state.StateDB, seq *consensus.Sequencer, confirmations, startBlock uint64) (*DepositWatcher, error) {
+	client, err := ethclient.Dial(flareRPC)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to Flare L1 at %s: %v", flareRPC, err)
+	}
+	return NewDepositWatcherWithClient(client, bridgeAddress, st, seq, confirmations, startBlock)
+}
+
+// NewDepositWatcherWithClient creates a deposit watcher on an existing L1
+// client.
+func NewDepositWatcherWithClient(client L1Client, bridgeAddress common.Address, st state.StateDB, seq *consensus.Sequencer, confirmations, startBlock uint64) (*DepositWatcher, error) {
+	bridge, err := bindings.NewLyrionBridgeFilterer(bridgeAddress, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind LyrionBridge: %v", err)
+	}
+
+	w := &DepositWatcher{
+		client:        client,
+		bridge:        bridge,
+		bridgeAddress: bridgeAddress,
+		state:         st,
+		sequencer:     seq,
+		confirmations: confirmations,
+		startBlock:    startBlock,
+		pending:       make(map[uint64]*pendingDeposit),
+	}
+	if data := st.GetMeta(depositCursorKey); data != nil {
+		if err := json.Unmarshal(data, &w.cursor); err != nil {
+			return nil, fmt.Errorf("corrupt deposit cursor: %v", err)
+		}
+		log.Printf("📜 Resuming L1 deposit scan after block %d", w.cursor.Number)
+	}
+	return w, nil
+}
+
+// Start begins polling L1 for deposits
+func (w *DepositWatcher) Start() {
+	log.Printf("👀 L1 Deposit Watcher started (Bridge: %s, Confirmations: %d)", w.bridgeAddress.Hex(), w.confirmations)
+
+	go func() {
+		ticker := time.NewTicker(10 * time.Second)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := w.poll(); err != nil {
+				log.Printf("⚠️ Deposit watcher: %v", err)
+			}
+		}
+	}()
+}
+
+// poll scans the confirmed L1 blocks since the cursor for new deposits.
+func (w *DepositWatcher) poll() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	// Release deposits that made it into a block before touching the cursor
+	defer w.persistCursor()
+
+	head, err := w.client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 head: %v", err)
+	}
+	if head < w.confirmations {
+		return nil
+	}
+	safe := head - w.confirmations
+
+	if w.cursor.Number == 0 && w.cursor.Hash == (common.Hash{}) {
+		if w.startBlock == 0 {
+			log.Printf("⚠️ No --l1.start-block set, only deposits after L1 block %d will be credited", safe)
+			w.startBlock = safe
+		}
+		if w.startBlock > 0 {
+			w.cursor.Number = w.startBlock - 1
+		}
+	} else if err := w.checkReorg(ctx); err != nil {
+		return err
+	}
+
+	for from := w.cursor.Number + 1; from <= safe; {
+		to := from + maxLogBlockRange - 1
+		if to > safe {
+			to = safe
+		}
+		if err := w.scan(ctx, from, to); err != nil {
+			return err
+		}
+		header, err := w.client.HeaderByNumber(ctx, new(big.Int).SetUint64(to))
+		if err != nil {
+			return fmt.Errorf("failed to get L1 block %d: %v", to, err)
+		}
+		w.cursor = l1Cursor{Number: to, Hash: header.Hash()}
+		from = to + 1
+	}
+	return nil
+}
+
+// checkReorg rewinds the cursor if the L1 block it points to was reorged out.
+func (w *DepositWatcher) checkReorg(ctx context.Context) error {
+	header, err := w.client.HeaderByNumber(ctx, new(big.Int).SetUint64(w.cursor.Number))
+	if err != nil {
+		return fmt.Errorf("failed to get L1 block %d: %v", w.cursor.Number, err)
+	}
+	if header.Hash() == w.cursor.Hash {
+		return nil
+	}
+
+	// Rescanning is safe: credited nonces are skipped
+	rewind := w.confirmations
+	if rewind == 0 || rewind > w.cursor.Number {
+		rewind = w.cursor.Number
+	}
+	log.Printf("⚠️ L1 reorg detected at block %d, rescanning deposits from block %d", w.cursor.Number, w.cursor.Number-rewind+1)
+	number := w.cursor.Number - rewind
+	header, err = w.client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return fmt.Errorf("failed to get L1 block %d: %v", number, err)
+	}
+	w.cursor = l1Cursor{Number: number, Hash: header.Hash()}
+	return nil
+}
+
+// scan queues the deposits emitted in L1 blocks [from, to].
+func (w *DepositWatcher) scan(ctx context.Context, from, to uint64) error {
+	it, err := w.bridge.FilterDepositInitiated(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to filter deposits in blocks %d-%d: %v", from, to, err)
+	}
+	defer it.Close()
+
+	for it.Next() {
+		ev := it.Event
+		if ev.Raw.Removed || !ev.Nonce.IsUint64() {
+			continue
+		}
+		d := &core.Deposit{
+			Nonce:         ev.Nonce.Uint64(),
+			Sender:        ev.Sender,
+			Recipient:     ev.Recipient,
+			Amount:        ev.Amount,
+			L1TxHash:      ev.Raw.TxHash,
+			L1BlockNumber: ev.Raw.BlockNumber,
+		}
+		if w.processed(d.Nonce) {
+			continue
+		}
+		if _, ok := w.pending[d.Nonce]; ok {
+			continue
+		}
+		if err := w.sequencer.AddDeposit(d); err != nil {
+			return fmt.Errorf("failed to queue deposit %d: %v", d.Nonce, err)
+		}
+		w.pending[d.Nonce] = &pendingDeposit{deposit: d, queuedAt: w.sequencer.CurrentHeight()}
+		log.Printf("📥 L1 Deposit #%d: %s FLR-wei to %s (L1 tx %s)", d.Nonce, d.Amount.String(), d.Recipient.Hex(), d.L1TxHash.Hex()[:18]+"...")
+	}
+	return it.Error()
+}
+
+// persistCursor saves the cursor once no queued deposit is still waiting for
+// inclusion, so a restart never skips a deposit that was queued but lost.
+// Deposits still not included depositRequeueBlocks after they were queued
+// were dropped by the sequencer and are queued again.
+func (w *DepositWatcher) persistCursor() {
+	height := w.sequencer.CurrentHeight()
+	for nonce, p := range w.pending {
+		if w.processed(nonce) {
+			delete(w.pending, nonce)
+			continue
+		}
+		if height < p.queuedAt+depositRequeueBlocks {
+			continue
+		}
+		if err := w.sequencer.AddDeposit(p.deposit); err != nil {
+			log.Printf("⚠️ Failed to requeue deposit %d: %v", nonce, err)
+			continue
+		}
+		p.queuedAt = height
+		log.Printf("🔁 L1 Deposit #%d not included after %d blocks, queued again", nonce, depositRequeueBlocks)
+	}
+	if len(w.pending) > 0 || w.cursor.Hash == (common.Hash{}) {
+		return
+	}
+	data, _ := json.Marshal(w.cursor)
+	if err := w.state.SetMeta(depositCursorKey, data); err != nil {
+		log.Printf("⚠️ Failed to persist deposit cursor: %v", err)
+	}
+}
+
+// processed reports whether the deposit nonce was credited on L2.
+func (w *DepositWatcher) processed(nonce uint64) bool {
+	return w.state.GetState(core.SystemAddress, core.DepositProcessedKey(nonce)) != (common.Hash{})
+}
+
+// GetStats returns deposit watcher statistics
+func (w *DepositWatcher) GetStats() map[string]interface{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return map[string]interface{}{
+		"lastScannedL1Block": w.cursor.Number,
+		"pendingDeposits":    len(w.pending),
+		"confirmations":      w.confirmations,
+		"bridgeAddress":      w.bridgeAddress.Hex(),
+	}
+}
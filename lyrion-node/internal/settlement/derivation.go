@@ -0,0 +1,687 @@
+package settlement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/lyrion-l2/lyrion-node/internal/consensus"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/execution"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/bindings"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+const (
+	// verifierProgressKey is the metadata key of the verifier progress
+	verifierProgressKey = "verifier-progress"
+
+	// channelTimeout is how many L1 blocks a channel may take to be
+	// completed and settled before its frames are dropped
+	channelTimeout = 300
+)
+
+// verifierProgress records how far derivation got.
+type verifierProgress struct {
+	Cursor    uint64 `json:"cursor"`    // L1 blocks up to here need no rescan
+	LastBatch uint64 `json:"lastBatch"` // Last verified batch
+	LastBlock uint64 `json:"lastBlock"` // Last derived L2 block
+}
+
+// pendingChannel is batch data seen on L1 whose batch is not settled yet.
+type pendingChannel struct {
+	firstBlock uint64     // L1 block of the first frame seen
+	data       *BatchData // Nil until all frames are in
+}
+
+// Verifier rebuilds the L2 chain from L1 alone: it reads the batch data
+// published to the batch inbox and the deposits made on LyrionBridge,
+// re-executes every block and checks the resulting output roots against the
+// roots submitted to the bridge. It replaces block production on --verifier
+// nodes.
+type Verifier struct {
+	client        L1Client
+	l1ChainID     *big.Int
+	bridge        *bindings.LyrionBridge
+	bridgeAddress common.Address
+	inbox         common.Address
+	batcher       common.Address // Only frames sent by the bridge sequencer count
+
+	state     state.StateDB
+	snapshots snapshotState // Same as state: batches are derived on a snapshot
+	executor  *execution.Executor
+	sequencer *consensus.Sequencer // Stores the derived blocks
+	chainID   *big.Int
+
+	confirmations uint64
+	startBlock    uint64
+
+	coinbase   common.Address          // L2 sequencer derived blocks are credited to (zero = the batcher)
+	challenger *Challenger             // Disputes invalid batches on L1 (nil = report only)
+	ftso       *bindings.IFtsoV2Caller // Checks posted prices (nil = trust them)
+
+	assembler *ChannelAssembler
+	channels  map[ChannelID]*pendingChannel
+	progress  verifierProgress
+	scanned   uint64 // Last L1 block scanned in this run
+	mismatch  error  // Set when a batch does not match its root on L1
+	badBatch  uint64 // Batch that caused mismatch (0 = not a batch fault)
+	badAt     uint64 // L1 block that settled badBatch
+	badCount  uint64 // Times badBatch had been challenged when it was settled
+	mu        sync.Mutex
+}
+
+// NewVerifier connects to L1 and restores the derivation progress.
+func NewVerifier(flareRPC string, bridgeAddress, inbox common.Address, st state.StateDB, exec *execution.Executor, seq *consensus.Sequencer, chainID *big.Int, confirmations, startBlock uint64) (*Verifier, error) {
+	client, err := ethclient.Dial(flareRPC)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to Flare L1 at %s: %v", flareRPC, err)
+	}
+	return NewVerifierWithClient(client, bridgeAddress, inbox, st, exec, seq, chainID, confirmations, startBlock)
+}
+
+// NewVerifierWithClient creates a verifier on an existing L1 client.
+func NewVerifierWithClient(client L1Client, bridgeAddress, inbox common.Address, st state.StateDB, exec *execution.Executor, seq *consensus.Sequencer, chainID *big.Int, confirmations, startBlock uint64) (*Verifier, error) {
+	l1ChainID, err := client.ChainID(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("could not get Flare chain ID: %v", err)
+	}
+	bridge, err := bindings.NewLyrionBridge(bridgeAddress, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind LyrionBridge: %v", err)
+	}
+	snapshots, ok := st.(snapshotState)
+	if !ok {
+		return nil, fmt.Errorf("verifier state does not support snapshots")
+	}
+
+	v := &Verifier{
+		client:        client,
+		l1ChainID:     l1ChainID,
+		bridge:        bridge,
+		bridgeAddress: bridgeAddress,
+		inbox:         inbox,
+		state:         st,
+		snapshots:     snapshots,
+		executor:      exec,
+		sequencer:     seq,
+		chainID:       chainID,
+		confirmations: confirmations,
+		startBlock:    startBlock,
+		assembler:     NewChannelAssembler(),
+		channels:      make(map[ChannelID]*pendingChannel),
+	}
+	if data := st.GetMeta(verifierProgressKey); data != nil {
+		if err := json.Unmarshal(data, &v.progress); err != nil {
+			return nil, fmt.Errorf("corrupt verifier progress: %v", err)
+		}
+		log.Printf("📜 Resuming derivation after batch #%d (L1 block %d)", v.progress.LastBatch, v.progress.Cursor)
+	}
+	v.scanned = v.progress.Cursor
+	return v, nil
+}
+
+// Start begins deriving L2 blocks from L1
+func (v *Verifier) Start() {
+	log.Printf("🔍 Verifier started (Bridge: %s, Inbox: %s, Confirmations: %d)", v.bridgeAddress.Hex(), v.inbox.Hex(), v.confirmations)
+
+	go func() {
+		ticker := time.NewTicker(10 * time.Second)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := v.poll(); err != nil {
+				log.Printf("⚠️ Verifier: %v", err)
+			}
+		}
+	}()
+}
+
+// poll derives everything the confirmed L1 blocks since the last scan allow.
+func (v *Verifier) poll() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	if v.mismatch != nil && !v.reverted(ctx) {
+		return nil // Stopped, see GetStats
+	}
+	if height := v.sequencer.CurrentHeight() - 1; height != v.progress.LastBlock {
+		return v.fail(fmt.Errorf("local chain is at block %d but only blocks up to %d were verified (stale data dir?)", height, v.progress.LastBlock))
+	}
+
+	head, err := v.client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 head: %v", err)
+	}
+	if head < v.confirmations {
+		return nil
+	}
+	safe := head - v.confirmations
+
+	batcher, err := v.bridge.Sequencer(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to read bridge sequencer: %v", err)
+	}
+	v.batcher = batcher
+
+	if v.scanned == 0 {
+		start, err := v.findStart(ctx)
+		if err != nil {
+			return err
+		}
+		if start > 0 {
+			v.scanned = start - 1
+		}
+	}
+
+	defer v.persistProgress()
+	for from := v.scanned + 1; from <= safe; {
+		to := min(from+maxLogBlockRange-1, safe)
+		if err := v.scanInbox(ctx, from, to); err != nil {
+			return err
+		}
+		if err := v.scanBatches(ctx, from, to, safe); err != nil {
+			return err
+		}
+		v.scanned = to
+		v.pruneChannels()
+		from = to + 1
+	}
+	return nil
+}
+
+// findStart picks the first L1 block to scan: --l1.start-block, or a bit
+// before the first batch was submitted.
+func (v *Verifier) findStart(ctx context.Context) (uint64, error) {
+	if v.startBlock > 0 {
+		return v.startBlock, nil
+	}
+	current, err := v.bridge.CurrentBatchNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("failed to read currentBatchNumber: %v", err)
+	}
+	if current.Sign() == 0 {
+		head, err := v.client.BlockNumber(ctx)
+		if err != nil {
+			return 0, err
+		}
+		// Nothing settled yet: follow from here, with room for data
+		// published ahead of the first batch
+		if head < channelTimeout {
+			return 1, nil
+		}
+		return head - channelTimeout, nil
+	}
+	submittedAt, err := v.bridge.BatchSubmissionTime(&bind.CallOpts{Context: ctx}, big.NewInt(1))
+	if err != nil {
+		return 0, err
+	}
+	first, err := findL1BlockByTime(ctx, v.client, submittedAt.Uint64())
+	if err != nil {
+		return 0, err
+	}
+	if first < channelTimeout {
+		return 1, nil
+	}
+	return first - channelTimeout, nil
+}
+
+// scanInbox collects the channel frames sent to the inbox by the batcher.
+func (v *Verifier) scanInbox(ctx context.Context, from, to uint64) error {
+	signer := types.LatestSignerForChainID(v.l1ChainID)
+	for n := from; n <= to; n++ {
+		block, err := v.client.BlockByNumber(ctx, new(big.Int).SetUint64(n))
+		if err != nil {
+			return fmt.Errorf("failed to get L1 block %d: %v", n, err)
+		}
+		for _, tx := range block.Transactions() {
+			if tx.To() == nil || *tx.To() != v.inbox {
+				continue
+			}
+			if sender, err := types.Sender(signer, tx); err != nil || sender != v.batcher {
+				continue
+			}
+			receipt, err := v.client.TransactionReceipt(ctx, tx.Hash())
+			if err != nil || receipt.Status != types.ReceiptStatusSuccessful {
+				continue
+			}
+
+			frame, err := ParseFrame(tx.Data())
+			if err != nil {
+				log.Printf("⚠️ Skipping batch data tx %s: %v", tx.Hash().Hex(), err)
+				continue
+			}
+			ch := v.channels[frame.ChannelID]
+			if ch == nil {
+				ch = &pendingChannel{firstBlock: n}
+				v.channels[frame.ChannelID] = ch
+			}
+			data, err := v.assembler.AddFrame(frame)
+			if err != nil {
+				log.Printf("⚠️ Dropping channel %x: %v", frame.ChannelID, err)
+				delete(v.channels, frame.ChannelID)
+				continue
+			}
+			if data != nil {
+				ch.data = data
+			}
+		}
+	}
+	return nil
+}
+
+// scanBatches derives and verifies every batch settled in the range.
+func (v *Verifier) scanBatches(ctx context.Context, from, to, safe uint64) error {
+	it, err := v.bridge.FilterBatchSubmitted(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to filter BatchSubmitted: %v", err)
+	}
+	defer it.Close()
+
+	for it.Next() {
+		ev := it.Event
+		n := ev.BatchNumber.Uint64()
+		if n <= v.progress.LastBatch {
+			continue
+		}
+		// A challenged batch is settled again later: only the latest
+		// submission counts
+		root, err := v.bridge.BatchStateRoots(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(safe)}, ev.BatchNumber)
+		if err != nil {
+			return fmt.Errorf("failed to read batch #%d root: %v", n, err)
+		}
+		if root != ev.StateRoot {
+			log.Printf("⏭️ Skipping batch #%d settled in L1 block %d: it was challenged since", n, ev.Raw.BlockNumber)
+			continue
+		}
+		if n != v.progress.LastBatch+1 {
+			return v.failBatch(ev, fmt.Errorf("batch #%d settled but #%d was never seen", n, v.progress.LastBatch+1))
+		}
+		if s := ev.StartBlock.Uint64(); s != v.progress.LastBlock+1 {
+			return v.failBatch(ev, fmt.Errorf("batch #%d starts at block %d, expected %d", n, s, v.progress.LastBlock+1))
+		}
+
+		id, data := v.findBatchData(n, ev.StartBlock.Uint64(), ev.EndBlock.Uint64(), ev.Raw.BlockNumber)
+		if data == nil {
+			err := &disputeError{kind: DisputeMissingData, err: fmt.Errorf("batch #%d (blocks %s-%s) has no data on L1", n, ev.StartBlock, ev.EndBlock)}
+			v.dispute(ev, err)
+			return v.failBatch(ev, err)
+		}
+		err = v.derive(ctx, data, ev, safe)
+		if _, ok := err.(*disputeError); ok {
+			// A resubmitted batch comes with new data
+			delete(v.channels, id)
+			v.dispute(ev, err)
+			return v.failBatch(ev, err)
+		}
+		if err != nil {
+			return err // Nothing was applied, retried on the next poll
+		}
+		delete(v.channels, id)
+	}
+	return it.Error()
+}
+
+// findBatchData returns the complete channel holding batch n settled at L1
+// block settledAt. If a challenged batch left its data behind, the latest
+// channel wins.
+func (v *Verifier) findBatchData(n, start, end, settledAt uint64) (ChannelID, *BatchData) {
+	var found ChannelID
+	var latest *pendingChannel
+	for id, ch := range v.channels {
+		if ch.data == nil || ch.data.BatchNumber != n || ch.data.StartBlock != start || ch.data.EndBlock != end || ch.firstBlock > settledAt {
+			continue
+		}
+		if latest == nil || ch.firstBlock > latest.firstBlock {
+			found, latest = id, ch
+		}
+	}
+	if latest == nil {
+		return ChannelID{}, nil
+	}
+	return found, latest.data
+}
+
+// derive re-executes the blocks of a batch on a snapshot of the state and
+// checks its output root. The state and the blocks are only kept if the
+// batch matches L1.
+func (v *Verifier) derive(ctx context.Context, data *BatchData, ev *bindings.LyrionBridgeBatchSubmitted, safe uint64) error {
+	if data.StartBlock != v.progress.LastBlock+1 {
+		return fmt.Errorf("batch #%d starts at block %d, expected %d", data.BatchNumber, data.StartBlock, v.progress.LastBlock+1)
+	}
+
+	batch := v.snapshots.Snapshot()
+	exec := v.executor.WithState(batch)
+	var parentHash common.Hash
+	if parent := v.sequencer.GetBlock(data.StartBlock - 1); parent != nil {
+		parentHash = parent.Header.Hash()
+	}
+	blocks := make([]*core.Block, 0, len(data.Blocks))
+	var txCount uint64
+	for _, b := range data.Blocks {
+		block, err := v.applyBlock(ctx, batch, exec, parentHash, b, safe)
+		if dErr, ok := err.(*disputeError); ok {
+			dErr.err = fmt.Errorf("batch #%d: %v", data.BatchNumber, dErr.err)
+			if dErr.step != nil {
+				dErr.step.BatchNumber = data.BatchNumber
+			}
+			return dErr
+		}
+		if err != nil {
+			return fmt.Errorf("batch #%d: %v", data.BatchNumber, err)
+		}
+		blocks = append(blocks, block)
+		txCount += uint64(len(block.Transactions))
+		parentHash = block.Header.Hash()
+	}
+
+	stateRoot := blocks[len(blocks)-1].Header.Root
+	outputRoot := batchTree(stateRoot, collectWithdrawals(blocks)).Root()
+	if outputRoot != ev.StateRoot {
+		return &disputeError{kind: DisputeRootMismatch, root: outputRoot, stateRoot: stateRoot,
+			err: fmt.Errorf("batch #%d root mismatch: L1 has %s, derived %s (state root %s)", data.BatchNumber, common.Hash(ev.StateRoot).Hex(), outputRoot.Hex(), stateRoot.Hex())}
+	}
+	if txCount != ev.TxCount.Uint64() {
+		return &disputeError{kind: DisputeRootMismatch, root: outputRoot, stateRoot: stateRoot,
+			err: fmt.Errorf("batch #%d tx count mismatch: L1 has %s, derived %d", data.BatchNumber, ev.TxCount, txCount)}
+	}
+
+	if err := batch.Flush(); err != nil {
+		return v.fail(fmt.Errorf("batch #%d: %v", data.BatchNumber, err))
+	}
+	if _, err := v.state.Commit(true); err != nil {
+		return v.fail(fmt.Errorf("batch #%d: %v", data.BatchNumber, err))
+	}
+	for _, block := range blocks {
+		if err := v.sequencer.InsertBlock(block); err != nil {
+			return v.fail(fmt.Errorf("batch #%d: %v", data.BatchNumber, err))
+		}
+	}
+	v.progress.LastBatch = data.BatchNumber
+	v.progress.LastBlock = data.EndBlock
+	v.persistProgress()
+	log.Printf("✅ Verified batch #%d (Blocks %d-%d, %d txs, root %s)", data.BatchNumber, data.StartBlock, data.EndBlock, txCount, outputRoot.Hex()[:18]+"...")
+	return nil
+}
+
+// applyBlock executes one derived block on the batch snapshot. The sequencer
+// only includes txs that executed successfully, so a failing tx is a fault
+// and comes back as a dispute with its step proof.
+func (v *Verifier) applyBlock(ctx context.Context, batch *state.BadgerStateDB, exec *execution.Executor, parentHash common.Hash, b *BlockData, safe uint64) (*core.Block, error) {
+	txs, err := b.Transactions()
+	if err != nil {
+		return nil, err
+	}
+	exec.SetBlockTime(b.Time)
+
+	for _, d := range b.Deposits {
+		if err := v.checkDeposit(ctx, d, safe); err != nil {
+			if dErr, ok := err.(*disputeError); ok {
+				dErr.err = fmt.Errorf("block %d: %v", b.Number, dErr.err)
+				return nil, dErr
+			}
+			return nil, fmt.Errorf("block %d: %v", b.Number, err)
+		}
+	}
+	for _, u := range b.Prices {
+		if err := v.checkPrices(ctx, u, safe); err != nil {
+			if dErr, ok := err.(*disputeError); ok {
+				dErr.err = fmt.Errorf("block %d: %v", b.Number, dErr.err)
+				return nil, dErr
+			}
+			return nil, fmt.Errorf("block %d: %v", b.Number, err)
+		}
+	}
+	for i, tx := range txs {
+		// Each tx runs on its own snapshot, so its witness holds exactly
+		// the keys it used
+		snap := batch.Snapshot()
+		err = executeTx(exec.WithState(snap), tx, v.chainID)
+		if err == nil {
+			if err := snap.Flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		dErr := &disputeError{kind: DisputeInvalidTx, err: fmt.Errorf("block %d: tx %s: %v", b.Number, tx.Hash().Hex(), err)}
+		step, proofErr := newStepProof(snap, b, i, tx, err)
+		if proofErr != nil {
+			log.Printf("⚠️ Failed to build step proof: %v", proofErr)
+		}
+		dErr.step = step
+		return nil, dErr
+	}
+
+	root, err := batch.Commit(true)
+	if err != nil {
+		return nil, err
+	}
+	return core.NewBlock(&core.Header{
+		ParentHash: parentHash,
+		Root:       root,
+		TxRoot:     core.TxRoot(txs),
+		Number:     b.Number,
+		Time:       b.Time,
+		Coinbase:   v.blockCoinbase(),
+		GasUsed:    21000 * uint64(len(txs)),
+	}, txs), nil
+}
+
+// checkDeposit makes sure a deposit in the batch data happened on L1.
+func (v *Verifier) checkDeposit(ctx context.Context, d *core.Deposit, safe uint64) error {
+	if d.L1BlockNumber > safe {
+		return &disputeError{kind: DisputeInvalidDeposit, err: fmt.Errorf("deposit %d from unconfirmed L1 block %d", d.Nonce, d.L1BlockNumber)}
+	}
+	end := d.L1BlockNumber
+	it, err := v.bridge.FilterDepositInitiated(&bind.FilterOpts{Start: d.L1BlockNumber, End: &end, Context: ctx},
+		[]*big.Int{new(big.Int).SetUint64(d.Nonce)}, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to look up deposit %d: %v", d.Nonce, err)
+	}
+	defer it.Close()
+	for it.Next() {
+		ev := it.Event
+		if ev.Raw.TxHash == d.L1TxHash && ev.Sender == d.Sender && ev.Recipient == d.Recipient && ev.Amount.Cmp(d.Amount) == 0 {
+			return nil
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return &disputeError{kind: DisputeInvalidDeposit, err: fmt.Errorf("deposit %d not found on L1 (tx %s)", d.Nonce, d.L1TxHash.Hex())}
+}
+
+// checkPrices makes sure a price update in the batch data matches what the
+// FTSO returned at its L1 block. Nodes without historical L1 state can't
+// read old blocks; those updates are accepted with a warning.
+func (v *Verifier) checkPrices(ctx context.Context, u *core.PriceUpdate, safe uint64) error {
+	if v.ftso == nil {
+		return nil
+	}
+	if u.L1BlockNumber > safe {
+		return &disputeError{kind: DisputeInvalidPrice, err: fmt.Errorf("prices of round %d from unconfirmed L1 block %d", u.Timestamp, u.L1BlockNumber)}
+	}
+	symbols := make([]string, len(u.Prices))
+	for i, p := range u.Prices {
+		symbols[i] = p.Symbol
+	}
+	onL1, err := readFtsoPrices(ctx, v.ftso, symbols, u.L1BlockNumber)
+	if err != nil {
+		log.Printf("⚠️ Can't check prices of round %d: %v", u.Timestamp, err)
+		return nil
+	}
+	if onL1.Timestamp != u.Timestamp {
+		return &disputeError{kind: DisputeInvalidPrice, err: fmt.Errorf("FTSO round at L1 block %d is %d, batch claims %d", u.L1BlockNumber, onL1.Timestamp, u.Timestamp)}
+	}
+	for i, p := range u.Prices {
+		if onL1.Prices[i].Price.Cmp(p.Price) != 0 {
+			return &disputeError{kind: DisputeInvalidPrice, err: fmt.Errorf("%s/USD at L1 block %d is %s, batch claims %s", p.Symbol, u.L1BlockNumber, onL1.Prices[i].Price, p.Price)}
+		}
+	}
+	return nil
+}
+
+// pruneChannels drops channels that were never settled in time, with any
+// frames the assembler still holds for them.
+func (v *Verifier) pruneChannels() {
+	for id, ch := range v.channels {
+		if ch.firstBlock+channelTimeout < v.scanned {
+			delete(v.channels, id)
+			v.assembler.Drop(id)
+		}
+	}
+}
+
+// persistProgress saves the progress. The cursor stays before the first
+// frame of any channel still waiting for its batch, so a restart sees it
+// again.
+func (v *Verifier) persistProgress() {
+	cursor := v.scanned
+	for _, ch := range v.channels {
+		if ch.firstBlock <= cursor {
+			cursor = ch.firstBlock - 1
+		}
+	}
+	if cursor < v.progress.Cursor {
+		cursor = v.progress.Cursor
+	}
+	v.progress.Cursor = cursor
+
+	data, _ := json.Marshal(v.progress)
+	if err := v.state.SetMeta(verifierProgressKey, data); err != nil {
+		log.Printf("⚠️ Failed to persist verifier progress: %v", err)
+	}
+}
+
+// dispute hands a batch that derivation proved invalid to the challenger.
+// Other errors (L1 unreachable, local DB) only stop derivation.
+func (v *Verifier) dispute(ev *bindings.LyrionBridgeBatchSubmitted, err error) {
+	dErr, ok := err.(*disputeError)
+	if !ok {
+		return
+	}
+	d := &Dispute{
+		BatchNumber:      ev.BatchNumber.Uint64(),
+		Kind:             dErr.kind,
+		Reason:           dErr.Error(),
+		ClaimedRoot:      ev.StateRoot,
+		DerivedRoot:      dErr.root,
+		DerivedStateRoot: dErr.stateRoot,
+		BatchTxHash:      ev.Raw.TxHash,
+		Step:             dErr.step,
+	}
+	if v.challenger == nil {
+		log.Printf("🚨 Batch #%d is invalid (%s) but no challenger is configured (--challenger.address)", d.BatchNumber, d.Kind)
+		return
+	}
+	v.challenger.Challenge(d)
+}
+
+// SetSequencerAddress sets the L2 sequencer address (--sequencer.address)
+// that derived blocks carry as their coinbase, like the sequencer's own.
+func (v *Verifier) SetSequencerAddress(addr common.Address) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.coinbase = addr
+}
+
+// blockCoinbase is the coinbase of derived blocks: the configured sequencer,
+// or else the bridge batcher, for setups that sign blocks and batches with
+// the same key. The caller holds v.mu.
+func (v *Verifier) blockCoinbase() common.Address {
+	if v.coinbase != (common.Address{}) {
+		return v.coinbase
+	}
+	return v.batcher
+}
+
+// SetChallenger makes the verifier challenge the invalid batches it finds.
+func (v *Verifier) SetChallenger(c *Challenger) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.challenger = c
+}
+
+// SetPriceFeed makes the verifier check the FTSO prices posted in batches
+// against the FTSOv2 contract at ftsoAddress.
+func (v *Verifier) SetPriceFeed(ftsoAddress common.Address) error {
+	ftso, err := bindings.NewIFtsoV2Caller(ftsoAddress, v.client)
+	if err != nil {
+		return fmt.Errorf("failed to bind FTSOv2: %v", err)
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.ftso = ftso
+	return nil
+}
+
+// fail stops derivation on a batch that does not match L1.
+func (v *Verifier) fail(err error) error {
+	v.mismatch = err
+	v.badBatch = 0
+	log.Printf("🚨 VERIFICATION FAILED: %v", err)
+	return err
+}
+
+// failBatch stops derivation until L1 reverts the batch settled by ev.
+func (v *Verifier) failBatch(ev *bindings.LyrionBridgeBatchSubmitted, err error) error {
+	v.fail(err)
+	v.badBatch = ev.BatchNumber.Uint64()
+	v.badAt = ev.Raw.BlockNumber
+	v.badCount = 0
+	at := new(big.Int).SetUint64(ev.Raw.BlockNumber)
+	if count, err := v.bridge.BatchInvalidations(&bind.CallOpts{BlockNumber: at}, ev.BatchNumber); err == nil {
+		v.badCount = count.Uint64()
+	}
+	return err
+}
+
+// reverted reports whether the batch that stopped derivation was dropped
+// from L1 (challenged), and if so resumes derivation after it: the
+// resubmitted batch is settled in a later L1 block. The batch may already
+// have been settled again, so a challenge is told by its invalidation count.
+func (v *Verifier) reverted(ctx context.Context) bool {
+	if v.badBatch == 0 {
+		return false
+	}
+	count, err := v.bridge.BatchInvalidations(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(v.badBatch))
+	if err != nil || count.Uint64() <= v.badCount {
+		return false
+	}
+	log.Printf("♻️ Batch #%d was reverted on L1, resuming derivation", v.badBatch)
+	v.mismatch = nil
+	v.badBatch = 0
+	v.scanned = v.badAt
+	return true
+}
+
+// GetStats returns verifier statistics
+func (v *Verifier) GetStats() map[string]interface{} {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	stats := map[string]interface{}{
+		"lastVerifiedBatch": v.progress.LastBatch,
+		"lastDerivedBlock":  v.progress.LastBlock,
+		"l1Scanned":         v.scanned,
+		"pendingChannels":   len(v.channels),
+		"batcher":           v.batcher.Hex(),
+		"inbox":             v.inbox.Hex(),
+		"ok":                v.mismatch == nil,
+	}
+	if v.mismatch != nil {
+		stats["error"] = v.mismatch.Error()
+	}
+	return stats
+}
@@ -0,0 +1,120 @@
+package settlement
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/l1sim"
+)
+
+// A batch whose root does not match its data is disputed and leaves the
+// verifier state untouched; once the challenge reverts it on L1 the
+// verifier resumes and verifies the batch submitted in its place. Blocks are
+// sealed with a different key than batches are submitted with.
+func TestVerifierRecoversFromChallengedBatch(t *testing.T) {
+	h := newL1(t)
+	user := l1sim.Address(h.User)
+	to := common.HexToAddress("0xb0b")
+	sequencerKey, _ := crypto.GenerateKey()
+
+	seqNode := newL2Node(t, sequencerKey, user)
+	verNode := newL2Node(t, nil, user)
+	r := newTestRelayer(t, h, seqNode)
+	v := newTestVerifier(t, h, verNode)
+	v.SetSequencerAddress(crypto.PubkeyToAddress(sequencerKey.PublicKey))
+	challenger, err := NewChallengerWithClient(h.Client, h.BridgeAddress, verNode.state, h.Challenger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.SetChallenger(challenger)
+
+	// Batch #1 is valid
+	seqNode.produce(t, h.User, &core.Transaction{Type: core.TxTypeTransfer, To: &to, Value: big.NewInt(1), Gas: 21000})
+	if _, err := r.settleNext(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.poll(); err != nil {
+		t.Fatal(err)
+	}
+	if v.progress.LastBatch != 1 || verNode.seq.CurrentHeight() != 2 {
+		t.Fatalf("verified batch #%d up to block %d, want #1 up to 1", v.progress.LastBatch, verNode.seq.CurrentHeight()-1)
+	}
+	verified, _ := verNode.state.Commit(true)
+
+	// Batch #2 is submitted with a wrong root
+	block := seqNode.produce(t, h.User, &core.Transaction{Type: core.TxTypeTransfer, To: &to, Value: big.NewInt(2), Gas: 21000})
+	bad, err := r.createBatch(2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bad.OutputRoot = common.HexToHash("0xbad")
+	if err := r.settleOnL1(bad); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.poll(); err == nil || v.badBatch != 2 {
+		t.Fatalf("batch with a wrong root was not disputed (error: %v)", err)
+	}
+	if root, _ := verNode.state.Commit(true); root != verified || verNode.seq.CurrentHeight() != 2 {
+		t.Fatal("disputed batch changed the verifier state")
+	}
+
+	waitFor(t, "the challenge", time.Minute, func() bool {
+		current, err := h.Bridge.CurrentBatchNumber(&bind.CallOpts{})
+		return err == nil && current.Uint64() == 1
+	})
+
+	// The sequencer operator drops the challenged batch and settles the
+	// same blocks again, with the right root
+	r.mu.Lock()
+	r.batches = r.batches[:1]
+	r.lastSettled = 1
+	r.mu.Unlock()
+	batch, err := r.settleNext(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if batch.BatchNumber != 2 {
+		t.Fatalf("resubmitted batch #%d, want #2", batch.BatchNumber)
+	}
+
+	if err := v.poll(); err != nil {
+		t.Fatal(err)
+	}
+	if v.mismatch != nil || v.progress.LastBatch != 2 {
+		t.Fatalf("verifier at batch #%d after the resubmission (error: %v)", v.progress.LastBatch, v.mismatch)
+	}
+	derived := verNode.seq.GetBlock(2)
+	if derived == nil || derived.Header.Root != block.Header.Root || derived.Header.Coinbase != block.Header.Coinbase {
+		t.Fatal("derived block #2 does not match the sequencer's")
+	}
+}
+
+// Channels that time out are dropped from the assembler too, so their
+// frames don't pile up.
+func TestPruneChannels(t *testing.T) {
+	v := &Verifier{assembler: NewChannelAssembler(), channels: make(map[ChannelID]*pendingChannel)}
+	stale, fresh := ChannelID{1}, ChannelID{2}
+	for id, firstBlock := range map[ChannelID]uint64{stale: 1, fresh: 10} {
+		if _, err := v.assembler.AddFrame(&Frame{ChannelID: id, Data: []byte{1}}); err != nil {
+			t.Fatal(err)
+		}
+		v.channels[id] = &pendingChannel{firstBlock: firstBlock}
+	}
+
+	v.scanned = 2 + channelTimeout
+	v.pruneChannels()
+	if _, ok := v.channels[stale]; ok {
+		t.Fatal("timed out channel kept")
+	}
+	if _, ok := v.assembler.frames[stale]; ok {
+		t.Fatal("frames of a timed out channel kept in the assembler")
+	}
+	if v.channels[fresh] == nil || v.assembler.frames[fresh] == nil {
+		t.Fatal("channel within the timeout dropped")
+	}
+}
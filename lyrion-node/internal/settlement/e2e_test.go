@@ -0,0 +1,181 @@
+package settlement
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/l1sim"
+)
+
+// End-to-end settlement on a simulated L1: a deposit is credited on L2,
+// withdrawn, settled in a batch that a verifier derives from L1 alone, and
+// claimed on L1 once the batch is final.
+func TestDepositBatchWithdrawal(t *testing.T) {
+	h := newL1(t)
+	if err := h.SetChallengePeriod(2); err != nil {
+		t.Fatal(err)
+	}
+	user := l1sim.Address(h.User)
+	seqNode := newL2Node(t, h.Sequencer, user)
+	verNode := newL2Node(t, nil, user)
+
+	// Deposit
+	amount := new(big.Int).Mul(big.NewInt(5), big.NewInt(params.Ether))
+	if _, err := h.Deposit(h.User, user, amount); err != nil {
+		t.Fatal(err)
+	}
+	watcher, err := NewDepositWatcherWithClient(h.Client, h.BridgeAddress, seqNode.state, seqNode.seq, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := watcher.poll(); err != nil {
+		t.Fatal(err)
+	}
+	if n := seqNode.seq.PendingDeposits(); n != 1 {
+		t.Fatalf("%d deposits queued, want 1", n)
+	}
+	if _, err := seqNode.seq.ProduceBlock(); err != nil {
+		t.Fatal(err)
+	}
+	if bal := seqNode.state.GetBalanceFLR(user); bal.Cmp(amount) != 0 {
+		t.Fatalf("L2 FLR balance %s after the deposit, want %s", bal, amount)
+	}
+
+	// Withdrawal
+	withdrawn := new(big.Int).Mul(big.NewInt(2), big.NewInt(params.Ether))
+	block := seqNode.produce(t, h.User, &core.Transaction{Type: core.TxTypeWithdrawal, Value: withdrawn, Gas: 21000})
+	if len(block.Transactions) != 1 {
+		t.Fatal("withdrawal was not included")
+	}
+	withdrawal := block.Transactions[0].Hash()
+
+	// Batch
+	r := newTestRelayer(t, h, seqNode)
+	batch, err := r.settleNext(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if batch.BatchNumber != 1 || batch.EndBlock != 2 || len(batch.Withdrawals) != 1 {
+		t.Fatalf("settled batch #%d up to block %d with %d withdrawals", batch.BatchNumber, batch.EndBlock, len(batch.Withdrawals))
+	}
+	onL1, err := h.Bridge.BatchStateRoots(&bind.CallOpts{}, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if common.Hash(onL1) != batch.OutputRoot {
+		t.Fatalf("bridge root %x, batch root %s", onL1, batch.OutputRoot.Hex())
+	}
+
+	// The verifier rebuilds the same chain from L1
+	v := newTestVerifier(t, h, verNode)
+	if err := v.poll(); err != nil {
+		t.Fatal(err)
+	}
+	if v.progress.LastBatch != 1 {
+		t.Fatalf("verifier at batch #%d, want #1", v.progress.LastBatch)
+	}
+	if got, want := verNode.seq.GetBlock(2).Header.Root, seqNode.seq.GetBlock(2).Header.Root; got != want {
+		t.Fatalf("verifier state root %s, sequencer %s", got.Hex(), want.Hex())
+	}
+
+	// Claim on L1 once the challenge period is over
+	proof, err := r.GetWithdrawalProof(withdrawal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "batch #1 to finalize", 30*time.Second, func() bool {
+		final, err := h.Bridge.IsBatchFinalized(&bind.CallOpts{}, big.NewInt(1))
+		return err == nil && final
+	})
+	path := make([][32]byte, len(proof.Proof))
+	for i, p := range proof.Proof {
+		path[i] = p
+	}
+	before, err := h.Client.BalanceAt(context.Background(), user, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := h.Transactor(h.User)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := h.Bridge.WithdrawFromL2(opts, new(big.Int).SetUint64(proof.BatchNumber), proof.Recipient, proof.Amount, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := h.Mine(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	after, err := h.Client.BalanceAt(context.Background(), user, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+	if got := new(big.Int).Add(new(big.Int).Sub(after, before), fee); got.Cmp(withdrawn) != 0 {
+		t.Fatalf("L1 balance grew by %s, want %s", got, withdrawn)
+	}
+}
+
+// A batch crediting a deposit that never happened on L1 is disputed by the
+// verifier and challenged off the bridge.
+func TestChallengeInvalidDeposit(t *testing.T) {
+	h := newL1(t)
+	user := l1sim.Address(h.User)
+	seqNode := newL2Node(t, h.Sequencer, user)
+	verNode := newL2Node(t, nil, user)
+
+	fake := &core.Deposit{
+		Nonce:         0,
+		Sender:        user,
+		Recipient:     user,
+		Amount:        big.NewInt(params.Ether),
+		L1TxHash:      common.HexToHash("0xfa4e"),
+		L1BlockNumber: 1,
+	}
+	if err := seqNode.seq.AddDeposit(fake); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := seqNode.seq.ProduceBlock(); err != nil {
+		t.Fatal(err)
+	}
+	r := newTestRelayer(t, h, seqNode)
+	if _, err := r.settleNext(true); err != nil {
+		t.Fatal(err)
+	}
+
+	v := newTestVerifier(t, h, verNode)
+	challenger, err := NewChallengerWithClient(h.Client, h.BridgeAddress, verNode.state, h.Challenger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.SetChallenger(challenger)
+	if err := v.poll(); err == nil {
+		t.Fatal("batch with a fake deposit verified")
+	}
+	if verNode.seq.CurrentHeight() != 1 || verNode.state.GetBalanceFLR(user).Sign() != 0 {
+		t.Fatal("disputed batch changed the verifier chain")
+	}
+
+	waitFor(t, "the challenge", time.Minute, func() bool {
+		disputes := challenger.GetDisputes()
+		return len(disputes) == 1 && disputes[0].Status == DisputeChallenged
+	})
+	d := challenger.GetDisputes()[0]
+	if d.BatchNumber != 1 || d.Kind != DisputeInvalidDeposit {
+		t.Fatalf("disputed batch #%d (%s), want #1 (%s)", d.BatchNumber, d.Kind, DisputeInvalidDeposit)
+	}
+	current, err := h.Bridge.CurrentBatchNumber(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.Sign() != 0 {
+		t.Fatalf("bridge at batch #%s after the challenge, want #0", current)
+	}
+}
@@ -0,0 +1,225 @@
+package l1sim
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/bindings"
+)
+
+func newHarness(t *testing.T) *Harness {
+	t.Helper()
+	artifacts, err := LoadArtifacts("")
+	if errors.Is(err, ErrNoArtifacts) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := New(artifacts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { h.Close() })
+	return h
+}
+
+// call runs method on the contract at to from the given account and
+// returns the decoded custom error it reverted with, if any.
+func call(t *testing.T, h *Harness, meta *bind.MetaData, to common.Address, from common.Address, method string, args ...interface{}) (string, []interface{}) {
+	t.Helper()
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.Client.CallContract(context.Background(), ethereum.CallMsg{From: from, To: &to, Data: data}, nil)
+	if err == nil {
+		return "", nil
+	}
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		t.Fatalf("%s: %v", method, err)
+	}
+	hexData, _ := dataErr.ErrorData().(string)
+	revert, err := hexutil.Decode(hexData)
+	if err != nil || len(revert) < 4 {
+		return "revert", nil
+	}
+	for name, e := range parsed.Errors {
+		if bytes.Equal(revert[:4], e.ID[:4]) {
+			values, err := e.Inputs.Unpack(revert[4:])
+			if err != nil {
+				t.Fatalf("%s: bad %s data: %v", method, name, err)
+			}
+			return name, values
+		}
+	}
+	t.Fatalf("%s: unknown revert data %x", method, revert)
+	return "", nil
+}
+
+func (h *Harness) mustMine(t *testing.T, tx *types.Transaction, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Mine(tx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestToken(t *testing.T) {
+	h := newHarness(t)
+	owner, user := Address(h.Owner), Address(h.User)
+	other := common.HexToAddress("0xb0b")
+	meta := bindings.LyrionTokenMetaData
+	token := h.Token
+
+	name, _ := token.Name(nil)
+	symbol, _ := token.Symbol(nil)
+	decimals, _ := token.Decimals(nil)
+	bridge, _ := token.Bridge(nil)
+	if name != "Lyrion Token" || symbol != "LYR" || decimals != 18 || bridge != h.BridgeAddress {
+		t.Fatalf("token is %q %q, %d decimals, bridge %s", name, symbol, decimals, bridge.Hex())
+	}
+
+	// Only the owner moves the bridge, and only the bridge mints
+	if name, _ := call(t, h, meta, h.TokenAddress, user, "setBridge", user); name != "OwnableUnauthorizedAccount" {
+		t.Fatalf("setBridge by a stranger: %q", name)
+	}
+	opts, _ := h.Transactor(h.Owner)
+	tx, err := token.SetBridge(opts, user)
+	h.mustMine(t, tx, err)
+	if name, _ := call(t, h, meta, h.TokenAddress, owner, "mint", user, big.NewInt(1)); name != "OnlyBridge" {
+		t.Fatalf("mint by the owner: %q", name)
+	}
+	maxSupply, _ := token.MAXSUPPLY(nil)
+	if name, _ := call(t, h, meta, h.TokenAddress, user, "mint", user, new(big.Int).Add(maxSupply, common.Big1)); name != "MaxSupplyExceeded" {
+		t.Fatalf("mint above MAX_SUPPLY: %q", name)
+	}
+	userOpts, _ := h.Transactor(h.User)
+	tx, err = token.Mint(userOpts, user, big.NewInt(100))
+	h.mustMine(t, tx, err)
+
+	name, args := call(t, h, meta, h.TokenAddress, user, "transfer", other, big.NewInt(101))
+	if name != "ERC20InsufficientBalance" || args[0] != user || args[1].(*big.Int).Int64() != 100 || args[2].(*big.Int).Int64() != 101 {
+		t.Fatalf("transfer above balance: %q %v", name, args)
+	}
+	if name, _ := call(t, h, meta, h.TokenAddress, user, "transfer", common.Address{}, big.NewInt(1)); name != "ERC20InvalidReceiver" {
+		t.Fatalf("transfer to zero: %q", name)
+	}
+
+	// Allowances are spent by transferFrom
+	tx, err = token.Approve(userOpts, owner, big.NewInt(30))
+	h.mustMine(t, tx, err)
+	name, args = call(t, h, meta, h.TokenAddress, owner, "transferFrom", user, other, big.NewInt(31))
+	if name != "ERC20InsufficientAllowance" || args[0] != owner || args[1].(*big.Int).Int64() != 30 {
+		t.Fatalf("transferFrom above allowance: %q %v", name, args)
+	}
+	tx, err = token.TransferFrom(opts, user, other, big.NewInt(20))
+	h.mustMine(t, tx, err)
+	tx, err = token.Burn(userOpts, big.NewInt(50))
+	h.mustMine(t, tx, err)
+
+	allowance, _ := token.Allowance(nil, user, owner)
+	userBalance, _ := token.BalanceOf(nil, user)
+	otherBalance, _ := token.BalanceOf(nil, other)
+	supply, _ := token.TotalSupply(nil)
+	if allowance.Int64() != 10 || userBalance.Int64() != 30 || otherBalance.Int64() != 20 || supply.Int64() != 50 {
+		t.Fatalf("allowance %s, balances %s/%s, supply %s; want 10, 30/20, 50", allowance, userBalance, otherBalance, supply)
+	}
+}
+
+func TestMockFtso(t *testing.T) {
+	h := newHarness(t)
+	if h.Ftso == nil {
+		t.Skip("MockFtsoV2 not built")
+	}
+	meta := bindings.MockFtsoV2MetaData
+	flr, btc := core.FtsoFeedID("FLR"), core.FtsoFeedID("BTC")
+
+	if name, args := call(t, h, meta, h.FtsoAddress, Address(h.Owner), "getFeedById", flr); name != "UnknownFeed" || args[0] != flr {
+		t.Fatalf("unknown feed: %q %v", name, args)
+	}
+	if name, _ := call(t, h, meta, h.FtsoAddress, Address(h.User), "setFeed", flr, big.NewInt(1), int8(0)); name != "NotOwner" {
+		t.Fatalf("setFeed by a stranger: %q", name)
+	}
+	if err := h.SetPrice("FLR", big.NewInt(215000), 7); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.SetPrice("BTC", big.NewInt(95000), -2); err != nil {
+		t.Fatal(err)
+	}
+	if name, args := call(t, h, meta, h.FtsoAddress, Address(h.Owner), "getFeedsById", [][21]byte{flr, core.FtsoFeedID("ETH")}); name != "UnknownFeed" || args[0] != core.FtsoFeedID("ETH") {
+		t.Fatalf("unknown feed in a list: %q %v", name, args)
+	}
+
+	feeds, err := h.Ftso.GetFeedsById(nil, [][21]byte{btc, flr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, _ := h.Client.HeaderByNumber(context.Background(), nil)
+	if len(feeds.Values) != 2 || feeds.Values[0].Int64() != 95000 || feeds.Values[1].Int64() != 215000 ||
+		feeds.Decimals[0] != -2 || feeds.Decimals[1] != 7 || feeds.Timestamp != head.Time {
+		t.Fatalf("feeds %v %v at %d, want [95000 215000] [-2 7] at %d", feeds.Values, feeds.Decimals, feeds.Timestamp, head.Time)
+	}
+	feed, err := h.Ftso.Feeds(nil, btc)
+	if err != nil || !feed.Exists || feed.Decimals != -2 {
+		t.Fatalf("feeds(BTC) = %+v (%v)", feed, err)
+	}
+}
+
+func TestBridgeAccessControl(t *testing.T) {
+	h := newHarness(t)
+	user := Address(h.User)
+	meta := bindings.LyrionBridgeMetaData
+
+	for _, c := range []struct {
+		method string
+		args   []interface{}
+		want   string
+	}{
+		{"submitBatch", []interface{}{common.Hash{1}, big.NewInt(1), big.NewInt(1), big.NewInt(1)}, "InvalidSequencer"},
+		{"challengeBatch", []interface{}{big.NewInt(1), common.Hash{}, common.Hash{}}, "NotChallenger"},
+		{"setChallengePeriod", []interface{}{big.NewInt(0)}, "OwnableUnauthorizedAccount"},
+		{"setSequencer", []interface{}{user}, "OwnableUnauthorizedAccount"},
+		{"emergencyWithdraw", []interface{}{user, big.NewInt(1)}, "OwnableUnauthorizedAccount"},
+		{"depositToL20", nil, "InvalidAmount"},
+		{"withdrawFromL2", []interface{}{big.NewInt(1), user, big.NewInt(1), [][32]byte{}}, "BatchNotFinalized"},
+	} {
+		if name, _ := call(t, h, meta, h.BridgeAddress, user, c.method, c.args...); name != c.want {
+			t.Errorf("%s: reverted with %q, want %s", c.method, name, c.want)
+		}
+	}
+
+	// Only registered challengers may challenge, and not a missing batch
+	if name, _ := call(t, h, meta, h.BridgeAddress, Address(h.Challenger), "challengeBatch", big.NewInt(1), common.Hash{}, common.Hash{}); name != "BatchNotChallengeable" {
+		t.Fatalf("challenge of a missing batch: %q", name)
+	}
+
+	if _, err := h.Deposit(h.User, user, big.NewInt(params.Ether)); err != nil {
+		t.Fatal(err)
+	}
+	stats, err := h.Bridge.GetBridgeStats(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.TotalDeposited.Cmp(big.NewInt(params.Ether)) != 0 || stats.BridgeBalance.Cmp(big.NewInt(params.Ether)) != 0 || stats.DepositNonce.Uint64() != 1 {
+		t.Fatalf("bridge stats %+v after one deposit", stats)
+	}
+}
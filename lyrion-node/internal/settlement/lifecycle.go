@@ -0,0 +1,185 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// defaultConfirmations is how many L1 blocks must be on top of a batch
+// before it counts as confirmed.
+const defaultConfirmations = 12
+
+// Finality of an L2 block, as shown by the explorer
+const (
+	FinalityUnsafe    = "unsafe"    // Not in a confirmed batch yet: the sequencer could still reorg it
+	FinalitySafe      = "safe"      // In a batch with enough L1 confirmations
+	FinalityFinalized = "finalized" // In a batch past the challenge period
+)
+
+// SetConfirmations sets how many L1 confirmations a batch needs.
+func (r *Relayer) SetConfirmations(n uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n == 0 {
+		n = 1 // Included is at least one confirmation
+	}
+	r.confirmations = n
+}
+
+// trackBatches advances included batches to confirmed and finalized, and
+// sends batches whose L1 tx was reorged out back to submitted so they get
+// resent.
+func (r *Relayer) trackBatches() {
+	r.settleMu.Lock()
+	defer r.settleMu.Unlock()
+
+	if r.demoMode {
+		r.trackDemoBatches()
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	head, err := r.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		log.Printf("⚠️ Failed to get L1 head for batch tracking: %v", err)
+		return
+	}
+	current, err := r.bridge.CurrentBatchNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		log.Printf("⚠️ Failed to read currentBatchNumber: %v", err)
+		return
+	}
+
+	for _, batch := range r.unfinalizedBatches() {
+		receipt, err := r.client.TransactionReceipt(ctx, common.HexToHash(batch.SettledTxHash))
+		if err != nil && err != ethereum.NotFound {
+			log.Printf("⚠️ Failed to get receipt of batch #%d: %v", batch.BatchNumber, err)
+			return
+		}
+
+		r.mu.Lock()
+		if err == nil && receipt.BlockHash == batch.L1BlockHash && batch.BatchNumber > current.Uint64() {
+			// Mined but gone from the bridge: a challenge invalidated it
+			batch.Status = BatchChallenged
+			r.mu.Unlock()
+			if err := r.saveBatch(batch); err != nil {
+				log.Printf("⚠️ %v", err)
+			}
+			r.halt(fmt.Errorf("batch #%d was invalidated by a challenge on L1; blocks from %d on must be rolled back before settling again", batch.BatchNumber, batch.StartBlock))
+			return
+		} else if err == ethereum.NotFound || receipt.BlockHash != batch.L1BlockHash {
+			log.Printf("⚠️ Batch #%d was reorged out of L1 block %d, resubmitting", batch.BatchNumber, batch.L1BlockNumber)
+			batch.Status = BatchSubmitted
+			batch.SettledOnL1 = false
+			batch.Confirmations = 0
+			batch.L1BlockNumber = 0
+			batch.L1BlockHash = common.Hash{}
+		} else {
+			r.advance(batch, head.Number.Uint64()-batch.L1BlockNumber+1, head.Time)
+		}
+		r.mu.Unlock()
+
+		if err := r.saveBatch(batch); err != nil {
+			log.Printf("⚠️ %v", err)
+			return
+		}
+	}
+}
+
+// trackDemoBatches confirms demo batches right away and finalizes them
+// once the challenge period has passed on the wall clock.
+func (r *Relayer) trackDemoBatches() {
+	now := uint64(time.Now().Unix())
+	for _, batch := range r.unfinalizedBatches() {
+		r.mu.Lock()
+		r.advance(batch, r.confirmations, now)
+		r.mu.Unlock()
+
+		if err := r.saveBatch(batch); err != nil {
+			log.Printf("⚠️ %v", err)
+			return
+		}
+	}
+}
+
+// advance moves an included batch forward given its confirmations and the
+// current L1 time. The caller holds r.mu.
+func (r *Relayer) advance(batch *Batch, confirmations uint64, l1Time uint64) {
+	batch.Confirmations = confirmations
+	if batch.Status == BatchIncluded && confirmations >= r.confirmations {
+		batch.Status = BatchConfirmed
+		log.Printf("🔒 Batch #%d confirmed on L1 (%d confirmations)", batch.BatchNumber, confirmations)
+	}
+	if batch.Status == BatchConfirmed && l1Time >= batch.FinalizesAt {
+		batch.Status = BatchFinalized
+		batch.rawTx = nil
+		log.Printf("🏁 Batch #%d finalized (Blocks %d-%d)", batch.BatchNumber, batch.StartBlock, batch.EndBlock)
+	}
+}
+
+// unfinalizedBatches returns the batches that are on L1 but not final yet.
+func (r *Relayer) unfinalizedBatches() []*Batch {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var batches []*Batch
+	for _, b := range r.batches {
+		if b.Status == BatchIncluded || b.Status == BatchConfirmed {
+			batches = append(batches, b)
+		}
+	}
+	return batches
+}
+
+// BlockFinality returns the finality of L2 block n and the batch holding
+// it, if any.
+func (r *Relayer) BlockFinality(n uint64) (string, *Batch) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if n == 0 {
+		return FinalityFinalized, nil // Genesis is never batched
+	}
+	for _, b := range r.batches {
+		if n < b.StartBlock || n > b.EndBlock {
+			continue
+		}
+		switch b.Status {
+		case BatchFinalized:
+			return FinalityFinalized, b
+		case BatchConfirmed:
+			return FinalitySafe, b
+		}
+		return FinalityUnsafe, b
+	}
+	return FinalityUnsafe, nil
+}
+
+// finalityHeads returns the highest safe and finalized L2 blocks. A batch
+// only counts once every batch before it has reached the same state.
+func (r *Relayer) finalityHeads() (safe, finalized uint64) {
+	safeDone, finalizedDone := false, false
+	for _, b := range r.batches {
+		if b.Status != BatchFinalized {
+			finalizedDone = true
+		}
+		if b.Status != BatchFinalized && b.Status != BatchConfirmed {
+			safeDone = true
+		}
+		if !finalizedDone {
+			finalized = b.EndBlock
+		}
+		if !safeDone {
+			safe = b.EndBlock
+		}
+	}
+	return safe, finalized
+}
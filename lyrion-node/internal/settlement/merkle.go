@@ -0,0 +1,78 @@
+package settlement
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MerkleTree is a binary Merkle tree compatible with OpenZeppelin's
+// MerkleProof.verify: parent = keccak256(sort(a, b)). A node without a
+// sibling is carried up to the next layer unchanged.
+type MerkleTree struct {
+	layers [][]common.Hash
+}
+
+// NewMerkleTree builds the tree over leaves (in the given order).
+func NewMerkleTree(leaves []common.Hash) *MerkleTree {
+	layer := append([]common.Hash{}, leaves...)
+	t := &MerkleTree{layers: [][]common.Hash{layer}}
+	for len(layer) > 1 {
+		next := make([]common.Hash, 0, (len(layer)+1)/2)
+		for i := 0; i < len(layer); i += 2 {
+			if i+1 < len(layer) {
+				next = append(next, hashPair(layer[i], layer[i+1]))
+			} else {
+				next = append(next, layer[i])
+			}
+		}
+		t.layers = append(t.layers, next)
+		layer = next
+	}
+	return t
+}
+
+// Root returns the tree root (zero for an empty tree).
+func (t *MerkleTree) Root() common.Hash {
+	top := t.layers[len(t.layers)-1]
+	if len(top) == 0 {
+		return common.Hash{}
+	}
+	return top[0]
+}
+
+// Proof returns the sibling path of leaf index, bottom-up.
+func (t *MerkleTree) Proof(index int) []common.Hash {
+	proof := make([]common.Hash, 0, len(t.layers))
+	for _, layer := range t.layers[:len(t.layers)-1] {
+		if sibling := index ^ 1; sibling < len(layer) {
+			proof = append(proof, layer[sibling])
+		}
+		index /= 2
+	}
+	return proof
+}
+
+// VerifyMerkleProof mirrors MerkleProof.verify on L1.
+func VerifyMerkleProof(proof []common.Hash, root, leaf common.Hash) bool {
+	computed := leaf
+	for _, p := range proof {
+		computed = hashPair(computed, p)
+	}
+	return computed == root
+}
+
+func hashPair(a, b common.Hash) common.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a[:], b[:])
+}
+
+// WithdrawalLeaf is the leaf LyrionBridge.withdrawFromL2 checks:
+// keccak256(abi.encodePacked(recipient, amount)).
+func WithdrawalLeaf(recipient common.Address, amount *big.Int) common.Hash {
+	return crypto.Keccak256Hash(recipient.Bytes(), common.BigToHash(amount).Bytes())
+}
@@ -0,0 +1,195 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/lyrion-l2/lyrion-node/internal/consensus"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/execution"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/bindings"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+// DefaultPriceSymbols are the FTSO feeds posted to L2 when none are
+// configured.
+var DefaultPriceSymbols = []string{"FLR", "BTC", "ETH"}
+
+// PriceOracle reads FTSOv2 price feeds on L1 and has the sequencer post
+// them to L2 state in TxTypePriceUpdate system txs. Prices are read at a
+// confirmed L1 block, so verifiers can read the same values and check them.
+type PriceOracle struct {
+	client      L1Client
+	ftso        *bindings.IFtsoV2Caller
+	ftsoAddress common.Address
+	state       state.StateDB
+	sequencer   *consensus.Sequencer
+
+	symbols       []string
+	confirmations uint64
+	interval      time.Duration
+
+	queued  uint64 // FTSO timestamp of the last update handed to the sequencer
+	lastErr error
+	mu      sync.Mutex
+}
+
+// NewPriceOracle connects to L1 and binds the FTSOv2 contract.
+func NewPriceOracle(flareRPC string, ftsoAddress common.Address, st state.StateDB, seq *consensus.Sequencer, symbols []string, confirmations uint64, interval time.Duration) (*PriceOracle, error) {
+	client, err := ethclient.Dial(flareRPC)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to Flare L1 at %s: %v", flareRPC, err)
+	}
+	return NewPriceOracleWithClient(client, ftsoAddress, st, seq, symbols, confirmations, interval)
+}
+
+// NewPriceOracleWithClient creates a price oracle on an existing L1 client.
+func NewPriceOracleWithClient(client L1Client, ftsoAddress common.Address, st state.StateDB, seq *consensus.Sequencer, symbols []string, confirmations uint64, interval time.Duration) (*PriceOracle, error) {
+	ftso, err := bindings.NewIFtsoV2Caller(ftsoAddress, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind FTSOv2: %v", err)
+	}
+	if len(symbols) == 0 {
+		symbols = DefaultPriceSymbols
+	}
+	for _, symbol := range symbols {
+		if !core.ValidPriceSymbol(symbol) {
+			return nil, fmt.Errorf("invalid price feed symbol %q", symbol)
+		}
+	}
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &PriceOracle{
+		client:        client,
+		ftso:          ftso,
+		ftsoAddress:   ftsoAddress,
+		state:         st,
+		sequencer:     seq,
+		symbols:       symbols,
+		confirmations: confirmations,
+		interval:      interval,
+	}, nil
+}
+
+// Start begins polling the FTSO for new prices
+func (o *PriceOracle) Start() {
+	log.Printf("💱 Price oracle started (FTSO: %s, Feeds: %v, Interval: %s)", o.ftsoAddress.Hex(), o.symbols, o.interval)
+
+	go func() {
+		if err := o.poll(); err != nil {
+			log.Printf("⚠️ Price oracle: %v", err)
+		}
+		ticker := time.NewTicker(o.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := o.poll(); err != nil {
+				log.Printf("⚠️ Price oracle: %v", err)
+			}
+		}
+	}()
+}
+
+// poll reads the feeds at the latest confirmed L1 block and queues them if
+// the FTSO published a new round since the last update.
+func (o *PriceOracle) poll() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	head, err := o.client.BlockNumber(ctx)
+	if err != nil {
+		o.lastErr = fmt.Errorf("failed to get L1 head: %v", err)
+		return o.lastErr
+	}
+	if head < o.confirmations {
+		return nil
+	}
+	u, err := readFtsoPrices(ctx, o.ftso, o.symbols, head-o.confirmations)
+	if err != nil {
+		o.lastErr = err
+		return err
+	}
+	o.lastErr = nil
+
+	if u.Timestamp <= o.queued || !o.isNewer(u) {
+		return nil
+	}
+	if err := o.sequencer.AddPriceUpdate(u); err != nil {
+		return err
+	}
+	o.queued = u.Timestamp
+	log.Printf("💱 Queued FTSO prices of round %d (L1 block %d)", u.Timestamp, u.L1BlockNumber)
+	return nil
+}
+
+// isNewer reports whether u updates at least one feed in L2 state.
+func (o *PriceOracle) isNewer(u *core.PriceUpdate) bool {
+	for _, p := range u.Prices {
+		if _, ts := execution.GetPrice(o.state, p.Symbol); ts < u.Timestamp {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStats returns price oracle statistics
+func (o *PriceOracle) GetStats() map[string]interface{} {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	stats := map[string]interface{}{
+		"ftso":          o.ftsoAddress.Hex(),
+		"feeds":         o.symbols,
+		"confirmations": o.confirmations,
+		"interval":      o.interval.String(),
+		"lastRound":     o.queued,
+	}
+	if o.lastErr != nil {
+		stats["lastError"] = o.lastErr.Error()
+	}
+	return stats
+}
+
+// readFtsoPrices reads the USD prices of symbols at L1 block number.
+func readFtsoPrices(ctx context.Context, ftso *bindings.IFtsoV2Caller, symbols []string, number uint64) (*core.PriceUpdate, error) {
+	ids := make([][21]byte, len(symbols))
+	for i, symbol := range symbols {
+		ids[i] = core.FtsoFeedID(symbol)
+	}
+	res, err := ftso.GetFeedsById(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(number)}, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read FTSO feeds at L1 block %d: %v", number, err)
+	}
+	if len(res.Values) != len(symbols) || len(res.Decimals) != len(symbols) {
+		return nil, fmt.Errorf("FTSO returned %d values for %d feeds", len(res.Values), len(symbols))
+	}
+	u := &core.PriceUpdate{Timestamp: res.Timestamp, L1BlockNumber: number}
+	for i, symbol := range symbols {
+		price := normalizePrice(res.Values[i], res.Decimals[i])
+		if price.Sign() <= 0 {
+			return nil, fmt.Errorf("FTSO has no %s/USD price", symbol)
+		}
+		u.Prices = append(u.Prices, &core.PriceFeed{Symbol: symbol, Price: price})
+	}
+	return u, nil
+}
+
+// normalizePrice scales an FTSO value with decimals to core.PriceDecimals.
+func normalizePrice(value *big.Int, decimals int8) *big.Int {
+	shift := core.PriceDecimals - int64(decimals)
+	if shift >= 0 {
+		return new(big.Int).Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(shift), nil))
+	}
+	return new(big.Int).Quo(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(-shift), nil))
+}
@@ -0,0 +1,120 @@
+package settlement
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/lyrion-l2/lyrion-node/internal/execution"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/bindings"
+)
+
+// Prices set on the mock FTSO reach L2 state through the oracle, and a
+// verifier deriving the chain checks them against the FTSO on L1.
+func TestPriceOracle(t *testing.T) {
+	h := newL1(t)
+	// 0.0215 USD, 95000 USD
+	if err := h.SetPrice("FLR", big.NewInt(215000), 7); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.SetPrice("BTC", big.NewInt(9500000), 2); err != nil {
+		t.Fatal(err)
+	}
+	seqNode := newL2Node(t, h.Sequencer)
+	verNode := newL2Node(t, nil)
+
+	oracle, err := NewPriceOracleWithClient(h.Client, h.FtsoAddress, seqNode.state, seqNode.seq, []string{"FLR", "BTC"}, 0, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := oracle.poll(); err != nil {
+		t.Fatal(err)
+	}
+	if !seqNode.seq.HasPriceUpdate() {
+		t.Fatal("no price update queued")
+	}
+	if _, err := seqNode.seq.ProduceBlock(); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]*big.Int{
+		"FLR": new(big.Int).Mul(big.NewInt(215), big.NewInt(1e14)),
+		"BTC": new(big.Int).Mul(big.NewInt(95000), big.NewInt(1e18)),
+	}
+	for symbol, price := range want {
+		if got, _ := execution.GetPrice(seqNode.state, symbol); got == nil || got.Cmp(price) != 0 {
+			t.Fatalf("%s/USD is %v on L2, want %s", symbol, got, price)
+		}
+	}
+
+	// Same round again: nothing to post
+	if err := oracle.poll(); err != nil {
+		t.Fatal(err)
+	}
+	if seqNode.seq.HasPriceUpdate() {
+		t.Fatal("unchanged FTSO round queued again")
+	}
+
+	r := newTestRelayer(t, h, seqNode)
+	if _, err := r.settleNext(true); err != nil {
+		t.Fatal(err)
+	}
+	v := newTestVerifier(t, h, verNode)
+	if err := v.SetPriceFeed(h.FtsoAddress); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.poll(); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := execution.GetPrice(verNode.state, "FLR"); got == nil || got.Cmp(want["FLR"]) != 0 {
+		t.Fatalf("verifier FLR/USD is %v, want %s", got, want["FLR"])
+	}
+}
+
+// A batch posting a price the FTSO never published is disputed.
+func TestVerifierDisputesWrongPrice(t *testing.T) {
+	h := newL1(t)
+	if err := h.SetPrice("FLR", big.NewInt(215000), 7); err != nil {
+		t.Fatal(err)
+	}
+	seqNode := newL2Node(t, h.Sequencer)
+	verNode := newL2Node(t, nil)
+
+	ftso, err := bindings.NewIFtsoV2Caller(h.FtsoAddress, h.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := h.Client.BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := readFtsoPrices(context.Background(), ftso, []string{"FLR"}, head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u.Prices[0].Price = new(big.Int).Mul(u.Prices[0].Price, big.NewInt(10))
+	if err := seqNode.seq.AddPriceUpdate(u); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := seqNode.seq.ProduceBlock(); err != nil {
+		t.Fatal(err)
+	}
+	r := newTestRelayer(t, h, seqNode)
+	if _, err := r.settleNext(true); err != nil {
+		t.Fatal(err)
+	}
+
+	v := newTestVerifier(t, h, verNode)
+	if err := v.SetPriceFeed(h.FtsoAddress); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.poll(); err == nil {
+		t.Fatal("batch with a wrong price verified")
+	}
+	if price, _ := execution.GetPrice(verNode.state, "FLR"); price != nil {
+		t.Fatalf("disputed price %s reached the verifier state", price)
+	}
+	if v.badBatch != 1 {
+		t.Fatalf("disputed batch #%d, want #1", v.badBatch)
+	}
+}
@@ -0,0 +1,165 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+// Why a batch was closed
+const (
+	CloseMaxBlocks = "max-blocks" // Enough blocks pending
+	CloseMaxBytes  = "max-bytes"  // Batch data reached the size limit
+	CloseMaxAge    = "max-age"    // Oldest pending block waited long enough
+	CloseGasPrice  = "gas-price"  // L1 gas is cheap right now
+	CloseForced    = "forced"     // lyr_forceSettle / ForceSettle
+)
+
+// maxBatchesPerPoll bounds how many batches one settlement round sends when
+// a backlog built up.
+const maxBatchesPerPoll = 10
+
+// BatchPolicy decides when pending L2 blocks are closed into a batch. A
+// batch is closed by whichever limit is hit first; MaxBlocks and MaxBytes
+// also bound its size, so a backlog is split into several batches. Zero
+// disables a limit.
+type BatchPolicy struct {
+	MaxBlocks    uint64        // Close once this many blocks are pending
+	MaxBytes     uint64        // Close once the compressed batch data reaches this size
+	MaxAge       time.Duration // Close once the oldest pending block is this old
+	GasPriceCap  *big.Int      // Close whatever is pending while the L1 gas price (wei) is at or below this
+	PollInterval time.Duration // How often the policy is checked
+}
+
+// DefaultBatchPolicy closes a batch after 100 blocks, one frame of data
+// (one L1 data tx) or 30 seconds. The gas price trigger is off.
+func DefaultBatchPolicy() BatchPolicy {
+	return BatchPolicy{
+		MaxBlocks:    100,
+		MaxBytes:     MaxFrameSize - frameHeaderSize,
+		MaxAge:       30 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+}
+
+func (p BatchPolicy) String() string {
+	gas := "off"
+	if p.GasPriceCap != nil && p.GasPriceCap.Sign() > 0 {
+		gas = p.GasPriceCap.String() + " wei"
+	}
+	return fmt.Sprintf("max %d blocks / %d bytes / %s, gas price trigger %s", p.MaxBlocks, p.MaxBytes, p.MaxAge, gas)
+}
+
+// cutBatch returns the last block of the next batch starting at start, or 0
+// if the pending blocks up to height should wait. force closes a batch
+// whenever at least one block is pending.
+func (r *Relayer) cutBatch(start, height uint64, force bool) (uint64, string, error) {
+	if height < start {
+		return 0, "", nil
+	}
+	r.mu.RLock()
+	p := r.policy
+	r.mu.RUnlock()
+
+	end, reason := height, ""
+	if force {
+		reason = CloseForced
+	}
+	if p.MaxBlocks > 0 && end-start+1 >= p.MaxBlocks {
+		end = start + p.MaxBlocks - 1
+		reason = CloseMaxBlocks
+	}
+	if p.MaxBytes > 0 {
+		fits, full, err := r.fitBytes(start, end, p.MaxBytes)
+		if err != nil {
+			return 0, "", err
+		}
+		if full {
+			end = fits
+			reason = CloseMaxBytes
+		}
+	}
+	if reason != "" {
+		return end, reason, nil
+	}
+
+	if p.MaxAge > 0 {
+		if first := r.sequencer.GetBlock(start); first != nil && time.Since(time.Unix(int64(first.Header.Time), 0)) >= p.MaxAge {
+			return end, CloseMaxAge, nil
+		}
+	}
+	if p.GasPriceCap != nil && p.GasPriceCap.Sign() > 0 && !r.demoMode {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		price, err := r.client.SuggestGasPrice(ctx)
+		if err != nil {
+			log.Printf("⚠️ Failed to read L1 gas price: %v", err)
+		} else if price.Cmp(p.GasPriceCap) <= 0 {
+			return end, CloseGasPrice, nil
+		}
+	}
+	return 0, "", nil
+}
+
+// fitBytes returns the last block in start..end whose batch data still fits
+// in maxBytes (at least start), and whether the limit is reached.
+func (r *Relayer) fitBytes(start, end, maxBytes uint64) (uint64, bool, error) {
+	blocks := r.batchBlocks(start, end)
+	if len(blocks) == 0 {
+		return end, false, nil
+	}
+	size, err := channelSize(blocks)
+	if err != nil {
+		return 0, false, err
+	}
+	if size < maxBytes {
+		return end, false, nil
+	}
+
+	// Largest prefix that fits, by binary search
+	lo, hi := 1, len(blocks)
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		size, err := channelSize(blocks[:mid])
+		if err != nil {
+			return 0, false, err
+		}
+		if size <= maxBytes {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return blocks[lo-1].Header.Number, true, nil
+}
+
+// channelSize is the compressed size of the batch data of blocks.
+func channelSize(blocks []*core.Block) (uint64, error) {
+	data, err := NewBatchData(0, blocks)
+	if err != nil {
+		return 0, err
+	}
+	frames, err := EncodeChannel(data)
+	if err != nil {
+		return 0, err
+	}
+	var size uint64
+	for _, f := range frames {
+		size += uint64(len(f) - frameHeaderSize)
+	}
+	return size, nil
+}
+
+// SetBatchPolicy replaces the batching policy.
+func (r *Relayer) SetBatchPolicy(p BatchPolicy) {
+	if p.PollInterval <= 0 {
+		p.PollInterval = DefaultBatchPolicy().PollInterval
+	}
+	r.mu.Lock()
+	r.policy = p
+	r.mu.Unlock()
+}
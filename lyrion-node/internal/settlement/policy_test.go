@@ -0,0 +1,125 @@
+package settlement
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/lyrion-l2/lyrion-node/internal/consensus"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/execution"
+	"github.com/lyrion-l2/lyrion-node/internal/mempool"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+// gasPriceClient is an L1 client that only reports a gas price.
+type gasPriceClient struct {
+	L1Client
+	price *big.Int
+}
+
+func (c *gasPriceClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	if c.price == nil {
+		return nil, errors.New("no gas price")
+	}
+	return c.price, nil
+}
+
+// newPolicyRelayer returns a relayer over n pending blocks made age ago,
+// each with one tx of 1000 random (incompressible) data bytes.
+func newPolicyRelayer(t *testing.T, n int, age time.Duration, gasPrice *big.Int) *Relayer {
+	t.Helper()
+	st, err := state.NewInMemoryBadgerStateDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(st.Close)
+	seq := consensus.NewSequencer(st, mempool.NewMempool(testL2ChainID), execution.NewExecutor(st, testL2ChainID), testL2ChainID, nil)
+
+	key, _ := crypto.GenerateKey()
+	to := common.HexToAddress("0xb0b")
+	made := uint64(time.Now().Add(-age).Unix())
+	for i := 1; i <= n; i++ {
+		data := make([]byte, 1000)
+		rand.Read(data)
+		tx, err := core.SignTx(&core.Transaction{Nonce: uint64(i - 1), To: &to, Value: big.NewInt(1), Gas: 50000, Data: data}, testL2ChainID, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := seq.InsertBlock(core.NewBlock(&core.Header{Number: uint64(i), Time: made}, []*core.Transaction{tx})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &Relayer{sequencer: seq, client: &gasPriceClient{price: gasPrice}}
+}
+
+func TestCutBatch(t *testing.T) {
+	gwei := big.NewInt(params.GWei)
+
+	for _, tc := range []struct {
+		name       string
+		policy     BatchPolicy
+		fitBlocks  uint64 // MaxBytes is the size of this many blocks from start
+		slack      uint64 // and this much more
+		blocks     int
+		start      uint64
+		age        time.Duration
+		gasPrice   *big.Int
+		demo       bool
+		force      bool
+		wantEnd    uint64
+		wantReason string
+	}{
+		{name: "nothing pending", policy: BatchPolicy{MaxBlocks: 1}, blocks: 3, start: 4},
+		{name: "below every limit", policy: DefaultBatchPolicy(), blocks: 3, start: 1},
+		{name: "forced", policy: DefaultBatchPolicy(), blocks: 3, start: 2, force: true, wantEnd: 3, wantReason: CloseForced},
+		{name: "nothing pending, forced", policy: DefaultBatchPolicy(), blocks: 3, start: 4, force: true},
+		{name: "limits off", policy: BatchPolicy{}, blocks: 10, start: 1, age: time.Hour},
+
+		{name: "max blocks reached", policy: BatchPolicy{MaxBlocks: 4}, blocks: 4, start: 1, wantEnd: 4, wantReason: CloseMaxBlocks},
+		{name: "max blocks, backlog split", policy: BatchPolicy{MaxBlocks: 4}, blocks: 10, start: 3, wantEnd: 6, wantReason: CloseMaxBlocks},
+		{name: "max blocks bounds a forced batch", policy: BatchPolicy{MaxBlocks: 4}, blocks: 10, start: 1, force: true, wantEnd: 4, wantReason: CloseMaxBlocks},
+		{name: "one block short of max blocks", policy: BatchPolicy{MaxBlocks: 4}, blocks: 3, start: 1},
+
+		{name: "max bytes", policy: BatchPolicy{}, fitBlocks: 3, blocks: 10, start: 1, wantEnd: 3, wantReason: CloseMaxBytes},
+		{name: "max bytes before max blocks", policy: BatchPolicy{MaxBlocks: 8}, fitBlocks: 3, blocks: 10, start: 1, wantEnd: 3, wantReason: CloseMaxBytes},
+		{name: "max blocks before max bytes", policy: BatchPolicy{MaxBlocks: 2}, fitBlocks: 3, blocks: 10, start: 1, wantEnd: 2, wantReason: CloseMaxBlocks},
+		{name: "block over max bytes goes alone", policy: BatchPolicy{MaxBytes: 100}, blocks: 3, start: 2, wantEnd: 2, wantReason: CloseMaxBytes},
+		{name: "under max bytes", policy: BatchPolicy{}, fitBlocks: 3, slack: 1, blocks: 3, start: 1},
+
+		{name: "max age", policy: BatchPolicy{MaxBlocks: 100, MaxAge: 30 * time.Second}, blocks: 3, start: 1, age: time.Minute, wantEnd: 3, wantReason: CloseMaxAge},
+		{name: "younger than max age", policy: BatchPolicy{MaxBlocks: 100, MaxAge: 30 * time.Second}, blocks: 3, start: 1, age: 10 * time.Second},
+		{name: "max blocks before max age", policy: BatchPolicy{MaxBlocks: 2, MaxAge: 30 * time.Second}, blocks: 3, start: 1, age: time.Minute, wantEnd: 2, wantReason: CloseMaxBlocks},
+
+		{name: "gas price at the cap", policy: BatchPolicy{GasPriceCap: new(big.Int).Mul(big.NewInt(10), gwei)}, blocks: 3, start: 1, gasPrice: new(big.Int).Mul(big.NewInt(10), gwei), wantEnd: 3, wantReason: CloseGasPrice},
+		{name: "gas price above the cap", policy: BatchPolicy{GasPriceCap: new(big.Int).Mul(big.NewInt(10), gwei)}, blocks: 3, start: 1, gasPrice: new(big.Int).Mul(big.NewInt(11), gwei)},
+		{name: "gas price unknown", policy: BatchPolicy{GasPriceCap: new(big.Int).Mul(big.NewInt(10), gwei)}, blocks: 3, start: 1},
+		{name: "gas price in demo mode", policy: BatchPolicy{GasPriceCap: new(big.Int).Mul(big.NewInt(10), gwei)}, blocks: 3, start: 1, gasPrice: gwei, demo: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newPolicyRelayer(t, tc.blocks, tc.age, tc.gasPrice)
+			if tc.fitBlocks > 0 {
+				size, err := channelSize(r.batchBlocks(tc.start, tc.start+tc.fitBlocks-1))
+				if err != nil {
+					t.Fatal(err)
+				}
+				tc.policy.MaxBytes = size + tc.slack
+			}
+			r.SetBatchPolicy(tc.policy)
+			r.demoMode = tc.demo
+			end, reason, err := r.cutBatch(tc.start, uint64(tc.blocks), tc.force)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if end != tc.wantEnd || reason != tc.wantReason {
+				t.Fatalf("cut at %d (%q), want %d (%q)", end, reason, tc.wantEnd, tc.wantReason)
+			}
+		})
+	}
+}
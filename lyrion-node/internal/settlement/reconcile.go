@@ -0,0 +1,165 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// reconcile brings the local batches in line with the bridge on startup.
+// A batch left in flight is resolved from its receipt; batches the bridge
+// has but the DB lacks (e.g. crash right after sending) are rebuilt from
+// their BatchSubmitted events. If the bridge is behind the DB, settlement
+// halts rather than submitting duplicate or out-of-order batches.
+func (r *Relayer) reconcile() error {
+	r.settleMu.Lock()
+	defer r.settleMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	current, err := r.bridge.CurrentBatchNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to read currentBatchNumber: %v", err)
+	}
+	onChain := current.Uint64()
+
+	for _, inFlight := range r.submittedBatches() {
+		if err := r.resume(inFlight); err != nil {
+			log.Printf("⚠️ %v", err)
+			break
+		}
+	}
+
+	r.mu.RLock()
+	var local uint64 // Last batch known to be on L1
+	for _, b := range r.batches {
+		if b.SettledOnL1 {
+			local = b.BatchNumber
+		}
+	}
+	r.mu.RUnlock()
+
+	if onChain < local {
+		return r.halt(fmt.Errorf("bridge is at batch #%d but batch #%d was settled locally (wrong bridge or L1 reset?)", onChain, local))
+	}
+
+	for n := local + 1; n <= onChain; n++ {
+		batch, err := r.recoverBatch(ctx, n)
+		if err != nil {
+			return r.halt(fmt.Errorf("failed to recover batch #%d from L1: %v", n, err))
+		}
+		r.replaceBatch(batch)
+		if err := r.saveBatch(batch); err != nil {
+			return err
+		}
+		log.Printf("🔁 Recovered batch #%d from L1 (Blocks %d-%d, tx %s)", n, batch.StartBlock, batch.EndBlock, batch.SettledTxHash)
+	}
+
+	log.Printf("✅ Settlement reconciled with bridge (batch #%d, last settled block %d)", onChain, r.lastSettled)
+	return nil
+}
+
+// recoverBatch rebuilds batch n from its BatchSubmitted event. The event is
+// located from the batch submission time, so no wide log query is needed.
+func (r *Relayer) recoverBatch(ctx context.Context, n uint64) (*Batch, error) {
+	number := new(big.Int).SetUint64(n)
+	submittedAt, err := r.bridge.BatchSubmissionTime(&bind.CallOpts{Context: ctx}, number)
+	if err != nil {
+		return nil, err
+	}
+	from, err := r.findBlockByTime(ctx, submittedAt.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	to := from + maxLogBlockRange - 1
+
+	it, err := r.bridge.FilterBatchSubmitted(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, []*big.Int{number})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("no BatchSubmitted event in L1 blocks %d-%d", from, to)
+	}
+	ev := it.Event
+
+	batch := &Batch{
+		BatchNumber:   n,
+		Status:        BatchIncluded,
+		StartBlock:    ev.StartBlock.Uint64(),
+		EndBlock:      ev.EndBlock.Uint64(),
+		OutputRoot:    ev.StateRoot,
+		TxCount:       ev.TxCount.Uint64(),
+		Timestamp:     ev.Timestamp.Uint64(),
+		SettledTxHash: ev.Raw.TxHash.Hex(),
+		SettledOnL1:   true,
+		L1BlockNumber: ev.Raw.BlockNumber,
+		L1BlockHash:   ev.Raw.BlockHash,
+		L1Timestamp:   ev.Timestamp.Uint64(),
+		FinalizesAt:   ev.Timestamp.Uint64() + r.challengePeriod,
+	}
+
+	// Fill in the L2 side if we still have the blocks and they match
+	blocks := r.batchBlocks(batch.StartBlock, batch.EndBlock)
+	if len(blocks) > 0 {
+		stateRoot := blocks[len(blocks)-1].Header.Root
+		withdrawals := collectWithdrawals(blocks)
+		if batchTree(stateRoot, withdrawals).Root() == batch.OutputRoot {
+			batch.StateRoot = stateRoot
+			batch.Withdrawals = withdrawals
+		} else {
+			log.Printf("⚠️ Batch #%d root on L1 does not match local blocks %d-%d", n, batch.StartBlock, batch.EndBlock)
+		}
+	}
+	return batch, nil
+}
+
+// findBlockByTime returns the first L1 block with a timestamp >= t.
+func (r *Relayer) findBlockByTime(ctx context.Context, t uint64) (uint64, error) {
+	return findL1BlockByTime(ctx, r.client, t)
+}
+
+func findL1BlockByTime(ctx context.Context, client L1Client, t uint64) (uint64, error) {
+	head, err := client.BlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+	lo, hi := uint64(0), head
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(mid))
+		if err != nil {
+			return 0, err
+		}
+		if header.Time < t {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
+
+// replaceBatch puts a recovered batch in place of the local one with the
+// same number (or appends it).
+func (r *Relayer) replaceBatch(batch *Batch) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.indexWithdrawals(batch)
+	for i, b := range r.batches {
+		if b.BatchNumber == batch.BatchNumber {
+			r.batches[i] = batch
+			return
+		}
+	}
+	r.batches = append(r.batches, batch)
+}
@@ -3,74 +3,171 @@ package settlement
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"log"
 	"math/big"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/lyrion-l2/lyrion-node/internal/consensus"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/bindings"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
 )
 
+const (
+	// gasLimitMargin is added on top of the estimated gas (percent)
+	gasLimitMargin = 20
+	
+	// receiptTimeout bounds how long we wait for a batch tx to be mined
+	receiptTimeout = 2 * time.Minute
+)
+
+// BatchStatus is the settlement state of a batch
+type BatchStatus string
+
+// A batch moves submitted -> included -> confirmed -> finalized. If its L1
+// tx is dropped or reorged out it goes back to submitted and is resent. A
+// batch a challenger invalidated on the bridge ends up challenged.
+const (
+	BatchSubmitted  BatchStatus = "submitted"  // submitBatch tx signed and sent, not mined yet
+	BatchIncluded   BatchStatus = "included"   // Mined on L1, BatchSubmitted event seen
+	BatchConfirmed  BatchStatus = "confirmed"  // Buried under the configured number of L1 confirmations
+	BatchFinalized  BatchStatus = "finalized"  // Challenge period over, withdrawals claimable
+	BatchChallenged BatchStatus = "challenged" // Removed from the bridge by a successful challenge
+)
+
+// defaultChallengePeriod matches LyrionBridge.challengePeriod (10 minutes)
+const defaultChallengePeriod = 10 * 60
+
 // Batch represents a collection of L2 blocks to be settled on L1
 type Batch struct {
 	BatchNumber    uint64         `json:"batchNumber"`
+	Status         BatchStatus    `json:"status"`
 	StartBlock     uint64         `json:"startBlock"`
 	EndBlock       uint64         `json:"endBlock"`
-	StateRoot      common.Hash    `json:"stateRoot"`
+	StateRoot      common.Hash    `json:"stateRoot"`  // L2 state root at EndBlock
+	OutputRoot     common.Hash    `json:"outputRoot"` // Root submitted to L1: state root + withdrawals (see batchTree)
+	Withdrawals    []*Withdrawal  `json:"withdrawals,omitempty"`
 	TxCount        uint64         `json:"txCount"`
+	CloseReason    string         `json:"closeReason,omitempty"`   // Batching policy limit that closed it (see policy.go)
 	Timestamp      uint64         `json:"timestamp"`
 	SettledTxHash  string         `json:"settledTxHash,omitempty"`
 	SettledOnL1    bool           `json:"settledOnL1"`
 	L1BlockNumber  uint64         `json:"l1BlockNumber,omitempty"`
+	L1BlockHash    common.Hash    `json:"l1BlockHash,omitempty"`
+	L1Timestamp    uint64         `json:"l1Timestamp,omitempty"`   // Bridge submission time
+	Confirmations  uint64         `json:"confirmations"`
+	FinalizesAt    uint64         `json:"finalizesAt,omitempty"`   // L1 time the challenge period ends
+	
+	// Batch data published to the batch inbox (see channel.go)
+	DataSize       int            `json:"dataSize"`                // Compressed channel bytes
+	DataTxHashes   []string       `json:"dataTxHashes,omitempty"`  // One L1 tx per frame
+	
+	frames [][]byte
+	frameTx []byte // Signed tx of the data frame in flight, kept to rebroadcast it unchanged
+	rawTx []byte // Signed submitBatch tx, kept to rebroadcast it unchanged
 }
 
 // Relayer handles L1 settlement
 type Relayer struct {
 	flareRPC       string
-	client         *ethclient.Client
+	client         L1Client
 	sequencer      *consensus.Sequencer
 	privateKey     *ecdsa.PrivateKey
 	address        common.Address
 	chainID        *big.Int
+	txmgr          *TxManager // Nonces, fees and retries of L1 txs (see txmgr.go)
 	
-	// Batch tracking
+	// Batch tracking (persisted in the node DB, see store.go)
+	store          state.StateDB
 	batches        []*Batch
+	withdrawals    map[common.Hash]uint64 // Withdrawal tx hash -> batch number (see withdrawals.go)
 	lastSettled    uint64
-	batchInterval  int // Blocks between settlements
+	policy         BatchPolicy // When pending blocks are closed into a batch
 	mu             sync.RWMutex
 	
-	// L1 Contract address (in production, this would be a real contract)
-	l1ContractAddr common.Address
+	// Serializes settlement: one batch in flight at a time keeps them in order
+	settleMu       sync.Mutex
+	halted         error // Set when local batches disagree with the bridge
+	
+	// Lifecycle tracking (see lifecycle.go)
+	confirmations   uint64 // L1 blocks on top of a batch before it is confirmed
+	challengePeriod uint64 // Seconds, read from the bridge
+	
+	// L1 address batch data is sent to, zero to skip publishing it
+	batchInbox     common.Address
+	dataPublished  uint64 // Compressed bytes sent to the inbox
+	
+	// LyrionBridge contract on L1
+	bridgeAddress  common.Address
+	bridge         *bindings.LyrionBridge
+	bridgeABI      *abi.ABI
 	
 	// Demo mode - don't actually send L1 transactions
 	demoMode       bool
 }
 
-// NewRelayer creates a new L1 relayer
-func NewRelayer(flareRPC string, sequencer *consensus.Sequencer, privateKeyHex string, batchInterval int) (*Relayer, error) {
+// NewRelayer creates a new L1 relayer submitting batches to the LyrionBridge
+// at bridgeAddress. Batches and progress are persisted in store. A nil
+// privateKey or a zero bridge address runs the relayer in demo mode.
+func NewRelayer(flareRPC string, bridgeAddress common.Address, sequencer *consensus.Sequencer, store state.StateDB, privateKey *ecdsa.PrivateKey, policy BatchPolicy) (*Relayer, error) {
+	var client L1Client
+	if privateKey != nil && bridgeAddress != (common.Address{}) {
+		c, err := ethclient.Dial(flareRPC)
+		if err != nil {
+			log.Printf("⚠️ Could not connect to Flare L1 at %s: %v (running in demo mode)", flareRPC, err)
+		} else {
+			client = c
+		}
+	}
+	r, err := NewRelayerWithClient(client, bridgeAddress, sequencer, store, privateKey, policy)
+	if err != nil {
+		return nil, err
+	}
+	r.flareRPC = flareRPC
+	return r, nil
+}
+
+// NewRelayerWithClient creates a relayer on an existing L1 client. A nil
+// client runs it in demo mode.
+func NewRelayerWithClient(client L1Client, bridgeAddress common.Address, sequencer *consensus.Sequencer, store state.StateDB, privateKey *ecdsa.PrivateKey, policy BatchPolicy) (*Relayer, error) {
+	bridgeABI, err := bindings.LyrionBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bridge ABI: %v", err)
+	}
+	
 	r := &Relayer{
-		flareRPC:       flareRPC,
 		sequencer:      sequencer,
+		store:          store,
 		batches:        make([]*Batch, 0),
+		withdrawals:    make(map[common.Hash]uint64),
 		lastSettled:    0,
-		batchInterval:  batchInterval,
-		l1ContractAddr: common.HexToAddress("0x0000000000000000000000000000000000000000"), // Placeholder
+		confirmations:   defaultConfirmations,
+		challengePeriod: defaultChallengePeriod,
+		bridgeAddress:  bridgeAddress,
+		bridgeABI:      bridgeABI,
 		demoMode:       true, // Enable demo mode by default (no real L1 transactions)
 	}
 	
-	// Parse private key if provided
-	if privateKeyHex != "" && privateKeyHex != "demo" {
-		pk, err := crypto.HexToECDSA(privateKeyHex)
-		if err != nil {
-			return nil, fmt.Errorf("invalid private key: %v", err)
-		}
-		r.privateKey = pk
-		r.address = crypto.PubkeyToAddress(pk.PublicKey)
+	// Use the batch submitter key if provided
+	if privateKey != nil && bridgeAddress == (common.Address{}) {
+		log.Printf("⚠️ No LyrionBridge address configured (running in demo mode)")
+		privateKey = nil
+	}
+	if privateKey != nil && client == nil {
+		privateKey = nil
+	}
+	if privateKey != nil {
+		r.privateKey = privateKey
+		r.address = crypto.PubkeyToAddress(privateKey.PublicKey)
 		r.demoMode = false
 	} else {
 		// Demo mode with placeholder address
@@ -78,34 +175,60 @@ func NewRelayer(flareRPC string, sequencer *consensus.Sequencer, privateKeyHex s
 		r.demoMode = true
 	}
 	
-	// Try to connect to Flare L1
+	// Check the Flare L1 connection
 	if !r.demoMode {
-		client, err := ethclient.Dial(flareRPC)
+		r.client = client
+		chainID, err := client.ChainID(context.Background())
 		if err != nil {
-			log.Printf("⚠️ Could not connect to Flare L1 at %s: %v (running in demo mode)", flareRPC, err)
+			log.Printf("⚠️ Could not get Flare chain ID: %v (running in demo mode)", err)
 			r.demoMode = true
 		} else {
-			r.client = client
-			chainID, err := client.ChainID(context.Background())
-			if err != nil {
-				log.Printf("⚠️ Could not get Flare chain ID: %v (running in demo mode)", err)
-				r.demoMode = true
-			} else {
-				r.chainID = chainID
-				log.Printf("🌐 Connected to Flare L1 (Chain ID: %s)", chainID.String())
-			}
+			r.chainID = chainID
+			r.txmgr = NewTxManager(client, privateKey, chainID, DefaultTxConfig())
+			log.Printf("🌐 Connected to Flare L1 (Chain ID: %s)", chainID.String())
+		}
+	}
+	
+	if !r.demoMode {
+		r.bridge, err = bindings.NewLyrionBridge(bridgeAddress, r.client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind LyrionBridge: %v", err)
+		}
+		
+		// The bridge only accepts batches from its sequencer
+		opts := &bind.CallOpts{Context: context.Background()}
+		if seq, err := r.bridge.Sequencer(opts); err != nil {
+			log.Printf("⚠️ Could not read LyrionBridge at %s: %v", bridgeAddress.Hex(), err)
+		} else if seq != r.address {
+			log.Printf("⚠️ Batch submitter %s is not the bridge sequencer (%s), submissions will revert", r.address.Hex(), seq.Hex())
+		}
+		if current, err := r.bridge.CurrentBatchNumber(opts); err == nil {
+			log.Printf("🌉 LyrionBridge at %s (current batch #%s)", bridgeAddress.Hex(), current.String())
+		}
+		if period, err := r.bridge.ChallengePeriod(opts); err == nil && period.IsUint64() {
+			r.challengePeriod = period.Uint64()
 		}
 	}
 	
+	r.SetBatchPolicy(policy)
+	if err := r.loadBatches(); err != nil {
+		return nil, err
+	}
 	return r, nil
 }
 
 // Start begins the settlement loop
 func (r *Relayer) Start() {
-	log.Printf("🔗 L1 Settlement Relayer started (Batch interval: %d blocks, Demo: %v)", r.batchInterval, r.demoMode)
+	log.Printf("🔗 L1 Settlement Relayer started (Batching: %s, Demo: %v)", r.policy, r.demoMode)
 	
 	go func() {
-		ticker := time.NewTicker(10 * time.Second) // Check every 10 seconds
+		if !r.demoMode {
+			if err := r.reconcile(); err != nil {
+				log.Printf("⚠️ Settlement reconciliation failed: %v", err)
+			}
+		}
+		
+		ticker := time.NewTicker(r.policy.PollInterval)
 		defer ticker.Stop()
 		
 		for range ticker.C {
@@ -114,40 +237,86 @@ func (r *Relayer) Start() {
 	}()
 }
 
-// checkAndSettle checks if we have enough blocks to create a new batch
+// errNothingToSettle is returned when there are not enough new blocks for a batch
+var errNothingToSettle = fmt.Errorf("no new blocks to settle")
+
+// checkAndSettle settles the batches the batching policy closes
 func (r *Relayer) checkAndSettle() {
-	currentHeight := r.sequencer.CurrentHeight() - 1 // Current height is "next block to mine"
+	r.trackBatches()
+	
+	for i := 0; i < maxBatchesPerPoll; i++ {
+		batch, err := r.settleNext(false)
+		if err == errNothingToSettle {
+			return
+		}
+		if err != nil {
+			log.Printf("⚠️ Failed to settle on L1: %v", err)
+			return
+		}
+		
+		log.Printf("✅ Settled Batch #%d on L1 (Blocks %d-%d, %d txs, %d withdrawals, %s, Root: %s...)",
+			batch.BatchNumber, batch.StartBlock, batch.EndBlock, batch.TxCount,
+			len(batch.Withdrawals), batch.CloseReason, batch.OutputRoot.Hex()[:14])
+	}
+}
+
+// settleNext settles the blocks after the last settled one once the
+// batching policy closes a batch (or right away if force is set). A batch
+// still in flight is finished first, so batches reach L1 strictly in order
+// and are never submitted twice.
+func (r *Relayer) settleNext(force bool) (*Batch, error) {
+	r.settleMu.Lock()
+	defer r.settleMu.Unlock()
 	
-	// Check if we have enough new blocks
-	if currentHeight <= r.lastSettled || currentHeight-r.lastSettled < uint64(r.batchInterval) {
-		return
+	r.mu.RLock()
+	halted := r.halted
+	r.mu.RUnlock()
+	if halted != nil {
+		return nil, halted
+	}
+	for _, inFlight := range r.submittedBatches() {
+		if err := r.resume(inFlight); err != nil {
+			return nil, err
+		}
 	}
 	
-	// Create batch
-	startBlock := r.lastSettled + 1
-	endBlock := currentHeight
+	currentHeight := r.sequencer.CurrentHeight() - 1 // Current height is "next block to mine"
+	
+	r.mu.RLock()
+	lastSettled := r.lastSettled
+	r.mu.RUnlock()
 	
-	batch, err := r.createBatch(startBlock, endBlock)
+	// A batch whose data is partly on L1 is finished as it was cut
+	progress, err := r.loadData(lastSettled + 1)
 	if err != nil {
-		log.Printf("⚠️ Failed to create batch: %v", err)
-		return
+		return nil, err
+	}
+	end, reason := uint64(0), ""
+	if progress != nil {
+		end, reason = progress.EndBlock, progress.CloseReason
+	} else {
+		end, reason, err = r.cutBatch(lastSettled+1, currentHeight, force)
+		if err != nil {
+			return nil, fmt.Errorf("batching policy: %v", err)
+		}
+		if end == 0 {
+			return nil, errNothingToSettle
+		}
 	}
 	
-	// Settle on L1
-	err = r.settleOnL1(batch)
+	batch, err := r.createBatch(lastSettled+1, end)
 	if err != nil {
-		log.Printf("⚠️ Failed to settle on L1: %v", err)
-		return
+		return nil, fmt.Errorf("failed to create batch: %v", err)
 	}
-	
-	r.mu.Lock()
-	r.batches = append(r.batches, batch)
-	r.lastSettled = endBlock
-	r.mu.Unlock()
-	
-	log.Printf("✅ Settled Batch #%d on L1 (Blocks %d-%d, %d txs, Root: %s...)",
-		batch.BatchNumber, batch.StartBlock, batch.EndBlock, batch.TxCount,
-		batch.StateRoot.Hex()[:14])
+	batch.CloseReason = reason
+	if progress != nil {
+		batch.DataTxHashes, batch.frameTx = progress.DataTxHashes, progress.FrameTx
+		log.Printf("📦 Resuming batch #%d data at frame %d/%d", batch.BatchNumber, len(batch.DataTxHashes), len(batch.frames))
+	}
+	if err := r.settleOnL1(batch); err != nil {
+		return nil, err
+	}
+	return batch, nil
 }
 
 // createBatch creates a batch from a range of L2 blocks
@@ -155,39 +324,62 @@ func (r *Relayer) createBatch(start, end uint64) (*Batch, error) {
 	var lastStateRoot common.Hash
 	var txCount uint64
 	
-	for i := start; i <= end; i++ {
-		block := r.sequencer.GetBlock(i)
-		if block != nil {
-			lastStateRoot = block.Header.Root
-			txCount += uint64(len(block.Transactions))
-		}
+	blocks := r.batchBlocks(start, end)
+	for _, block := range blocks {
+		lastStateRoot = block.Header.Root
+		txCount += uint64(len(block.Transactions))
 	}
+	withdrawals := collectWithdrawals(blocks)
 	
+	// Batches are numbered like the bridge numbers them: 1, 2, 3, ...
 	r.mu.RLock()
-	batchNum := uint64(len(r.batches) + 1)
+	batchNum := uint64(1)
+	if n := len(r.batches); n > 0 {
+		batchNum = r.batches[n-1].BatchNumber + 1
+	}
 	r.mu.RUnlock()
 	
+	data, err := NewBatchData(batchNum, blocks)
+	if err != nil {
+		return nil, err
+	}
+	frames, err := EncodeChannel(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode batch data: %v", err)
+	}
+	var dataSize int
+	for _, f := range frames {
+		dataSize += len(f) - frameHeaderSize
+	}
+	
 	return &Batch{
 		BatchNumber: batchNum,
 		StartBlock:  start,
 		EndBlock:    end,
 		StateRoot:   lastStateRoot,
+		OutputRoot:  batchTree(lastStateRoot, withdrawals).Root(),
+		Withdrawals: withdrawals,
 		TxCount:     txCount,
 		Timestamp:   uint64(time.Now().Unix()),
+		DataSize:    dataSize,
+		frames:      frames,
 	}, nil
 }
 
-// settleOnL1 submits the batch to Flare L1
+// settleOnL1 submits the batch to Flare L1 and waits for its inclusion
 func (r *Relayer) settleOnL1(batch *Batch) error {
 	if r.demoMode {
 		// Demo mode - simulate L1 settlement
+		batch.Status = BatchIncluded
 		batch.SettledOnL1 = true
 		batch.L1BlockNumber = uint64(time.Now().Unix()) % 1000000 // Fake L1 block number
+		batch.L1Timestamp = uint64(time.Now().Unix())
+		batch.FinalizesAt = batch.L1Timestamp + r.challengePeriod
 		batch.SettledTxHash = fmt.Sprintf("0x%x", crypto.Keccak256([]byte(fmt.Sprintf("%d%d%s", 
-			batch.BatchNumber, batch.Timestamp, batch.StateRoot.Hex()))))[:66]
+			batch.BatchNumber, batch.Timestamp, batch.OutputRoot.Hex()))))[:66]
 		
-		log.Printf("📡 [DEMO] Simulated L1 settlement - TxHash: %s", batch.SettledTxHash[:18]+"...")
-		return nil
+		log.Printf("📡 [DEMO] Simulated L1 settlement - TxHash: %s (%d bytes of batch data)", batch.SettledTxHash[:18]+"...", batch.DataSize)
+		return r.addBatch(batch)
 	}
 	
 	// Real L1 settlement
@@ -195,50 +387,260 @@ func (r *Relayer) settleOnL1(batch *Batch) error {
 		return fmt.Errorf("no L1 client connected")
 	}
 	
-	// In production, this would call a smart contract on Flare
-	// For now, we'll send a simple transaction with the state root as data
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), receiptTimeout)
+	defer cancel()
 	
-	nonce, err := r.client.PendingNonceAt(ctx, r.address)
+	// Never submit out of order: the bridge must be exactly one batch behind
+	current, err := r.bridge.CurrentBatchNumber(&bind.CallOpts{Context: ctx})
 	if err != nil {
-		return fmt.Errorf("failed to get nonce: %v", err)
+		return fmt.Errorf("failed to read currentBatchNumber: %v", err)
+	}
+	if current.Uint64()+1 != batch.BatchNumber {
+		return fmt.Errorf("bridge is at batch #%s, refusing to submit batch #%d (will reconcile on restart)", current.String(), batch.BatchNumber)
 	}
 	
-	gasPrice, err := r.client.SuggestGasPrice(ctx)
+	// Data first: once the root is on L1 its blocks must be derivable
+	if err := r.publishData(ctx, batch); err != nil {
+		return err
+	}
+	
+	tx, err := r.submit(ctx, batch)
 	if err != nil {
-		return fmt.Errorf("failed to get gas price: %v", err)
+		return err
+	}
+	return r.publish(ctx, batch, tx)
+}
+
+// publishData sends the batch's channel frames to the batch inbox, one L1
+// tx per frame, and waits for each to be mined. Each mined frame and the tx
+// of the frame in flight are persisted, so a retry resumes from the first
+// missing frame and rebroadcasts a pending frame tx instead of paying for
+// the same data twice.
+func (r *Relayer) publishData(ctx context.Context, batch *Batch) error {
+	if r.batchInbox == (common.Address{}) || len(batch.DataTxHashes) >= len(batch.frames) {
+		return nil
 	}
 	
-	// Construct settlement data
-	data := append(batch.StateRoot.Bytes(), 
-		big.NewInt(int64(batch.StartBlock)).Bytes()...)
-	data = append(data, big.NewInt(int64(batch.EndBlock)).Bytes()...)
+	for i := len(batch.DataTxHashes); i < len(batch.frames); i++ {
+		tx, err := r.frameTx(ctx, batch, i)
+		if err != nil {
+			return err
+		}
+		receipt, err := r.txmgr.Publish(ctx, tx, func(replacement *types.Transaction) error {
+			return r.persistFrameTx(batch, replacement)
+		})
+		if errors.Is(err, ErrNonceConsumed) {
+			r.persistFrameTx(batch, nil) // Dropped: sign a new one next time
+		}
+		if err != nil {
+			return fmt.Errorf("batch #%d data frame %d not mined: %v", batch.BatchNumber, i, err)
+		}
+		
+		r.mu.Lock()
+		batch.DataTxHashes = append(batch.DataTxHashes, receipt.TxHash.Hex())
+		batch.frameTx = nil
+		r.mu.Unlock()
+		if err := r.saveData(batch); err != nil {
+			return err
+		}
+	}
 	
-	tx := types.NewTransaction(
-		nonce,
-		r.l1ContractAddr,
-		big.NewInt(0),
-		100000,
-		gasPrice,
-		data,
-	)
+	r.mu.Lock()
+	r.dataPublished += uint64(batch.DataSize)
+	r.mu.Unlock()
+	log.Printf("📦 Published batch #%d data to L1 inbox %s (%d bytes in %d frames)", batch.BatchNumber, r.batchInbox.Hex(), batch.DataSize, len(batch.DataTxHashes))
+	return nil
+}
+
+// frameTx returns the tx of data frame i: the one already in flight, or a
+// newly signed one, persisted before it can reach L1.
+func (r *Relayer) frameTx(ctx context.Context, batch *Batch, i int) (*types.Transaction, error) {
+	if len(batch.frameTx) > 0 {
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(batch.frameTx); err == nil {
+			return &tx, nil
+		}
+	}
+	tx, err := r.txmgr.Sign(ctx, r.batchInbox, batch.frames[i])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign batch data frame %d: %v", i, err)
+	}
+	if err := r.persistFrameTx(batch, tx); err != nil {
+		r.txmgr.ResetNonce() // The nonce was never used
+		return nil, err
+	}
+	return tx, nil
+}
+
+// persistFrameTx records tx (nil for none) as the frame tx in flight.
+func (r *Relayer) persistFrameTx(batch *Batch, tx *types.Transaction) error {
+	var rawTx []byte
+	if tx != nil {
+		var err error
+		if rawTx, err = tx.MarshalBinary(); err != nil {
+			return fmt.Errorf("failed to encode tx: %v", err)
+		}
+	}
+	r.mu.Lock()
+	batch.frameTx = rawTx
+	r.mu.Unlock()
+	return r.saveData(batch)
+}
+
+// submit signs a submitBatch tx for the batch and persists it as
+// submitted, before it can reach L1. The caller checks the batch is next in
+// line and then publishes the tx.
+func (r *Relayer) submit(ctx context.Context, batch *Batch) (*types.Transaction, error) {
+	data, err := r.bridgeABI.Pack("submitBatch", batch.OutputRoot,
+		new(big.Int).SetUint64(batch.StartBlock),
+		new(big.Int).SetUint64(batch.EndBlock),
+		new(big.Int).SetUint64(batch.TxCount))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode submitBatch: %v", err)
+	}
+	tx, err := r.txmgr.Sign(ctx, r.bridgeAddress, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.persistTx(batch, tx); err != nil {
+		r.txmgr.ResetNonce() // The nonce was never used
+		return nil, err
+	}
+	r.clearData()
+	log.Printf("📡 Submitting batch #%d to Flare L1 - TxHash: %s (gas limit %d)", batch.BatchNumber, tx.Hash().Hex(), tx.Gas())
+	return tx, nil
+}
+
+// persistTx records tx as the batch's current submitBatch tx.
+func (r *Relayer) persistTx(batch *Batch, tx *types.Transaction) error {
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to encode tx: %v", err)
+	}
 	
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(r.chainID), r.privateKey)
+	r.mu.Lock()
+	batch.rawTx = rawTx
+	batch.Status = BatchSubmitted
+	batch.SettledTxHash = tx.Hash().Hex()
+	batch.SettledOnL1 = false
+	batch.Confirmations = 0
+	resent := len(r.batches) > 0 && r.batches[len(r.batches)-1].BatchNumber >= batch.BatchNumber
+	r.mu.Unlock()
+	if resent {
+		return r.saveBatch(batch)
+	}
+	return r.addBatch(batch)
+}
+
+// publish gets the batch tx mined through the tx manager, persisting every
+// fee-bumped replacement, and records the inclusion.
+func (r *Relayer) publish(ctx context.Context, batch *Batch, tx *types.Transaction) error {
+	receipt, err := r.txmgr.Publish(ctx, tx, func(replacement *types.Transaction) error {
+		return r.persistTx(batch, replacement)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to sign tx: %v", err)
+		return fmt.Errorf("batch #%d not mined yet (tx %s): %v", batch.BatchNumber, batch.SettledTxHash, err)
+	}
+	return r.processReceipt(batch, receipt)
+}
+
+// resume finishes a batch whose submitBatch tx was sent earlier (before a
+// restart or a timeout): it checks for the receipt and otherwise keeps
+// publishing the same nonce, so the batch can't land twice.
+func (r *Relayer) resume(batch *Batch) error {
+	ctx, cancel := context.WithTimeout(context.Background(), receiptTimeout)
+	defer cancel()
+	
+	receipt, err := r.client.TransactionReceipt(ctx, common.HexToHash(batch.SettledTxHash))
+	if err == nil {
+		return r.processReceipt(batch, receipt)
+	}
+	if err != ethereum.NotFound {
+		return fmt.Errorf("failed to get receipt of batch #%d: %v", batch.BatchNumber, err)
+	}
+	
+	var tx types.Transaction
+	if len(batch.rawTx) == 0 || tx.UnmarshalBinary(batch.rawTx) != nil {
+		return r.resubmit(ctx, batch)
 	}
 	
-	err = r.client.SendTransaction(ctx, signedTx)
+	// A consumed nonce without our receipt means the tx was dropped or
+	// replaced: sign a new one for the same batch
+	nonce, err := r.client.NonceAt(ctx, r.address, nil)
 	if err != nil {
-		return fmt.Errorf("failed to send tx: %v", err)
+		return fmt.Errorf("failed to get L1 nonce: %v", err)
+	}
+	if nonce > tx.Nonce() {
+		log.Printf("⚠️ Batch #%d tx %s was dropped from L1, resubmitting", batch.BatchNumber, batch.SettledTxHash)
+		return r.resubmit(ctx, batch)
 	}
 	
-	batch.SettledTxHash = signedTx.Hash().Hex()
-	batch.SettledOnL1 = true
+	return r.publish(ctx, batch, &tx)
+}
+
+// resubmit sends a fresh submitBatch tx for a batch whose tx was lost. If
+// the bridge already has the batch, reconciliation takes over instead.
+func (r *Relayer) resubmit(ctx context.Context, batch *Batch) error {
+	current, err := r.bridge.CurrentBatchNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to read currentBatchNumber: %v", err)
+	}
+	if current.Uint64() >= batch.BatchNumber {
+		// Another tx of ours landed it (e.g. a replacement): take it from L1
+		recovered, err := r.recoverBatch(ctx, batch.BatchNumber)
+		if err != nil {
+			return fmt.Errorf("failed to recover batch #%d from L1: %v", batch.BatchNumber, err)
+		}
+		r.replaceBatch(recovered)
+		return r.saveBatch(recovered)
+	}
+	if current.Uint64()+1 != batch.BatchNumber {
+		return r.halt(fmt.Errorf("bridge is at batch #%s while batch #%d was lost, restart to reconcile", current.String(), batch.BatchNumber))
+	}
+	tx, err := r.submit(ctx, batch)
+	if err != nil {
+		return err
+	}
+	return r.publish(ctx, batch, tx)
+}
+
+// processReceipt marks a batch as included once its submitBatch tx is mined.
+func (r *Relayer) processReceipt(batch *Batch, receipt *types.Receipt) error {
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("submitBatch tx %s reverted", receipt.TxHash.Hex())
+	}
 	
-	log.Printf("📡 Submitted to Flare L1 - TxHash: %s", batch.SettledTxHash)
+	// The bridge assigns the batch number
+	submitted, err := r.parseBatchSubmitted(receipt)
+	if err != nil {
+		return err
+	}
+	if n := submitted.BatchNumber.Uint64(); n != batch.BatchNumber {
+		return r.halt(fmt.Errorf("bridge recorded batch #%d as #%d", batch.BatchNumber, n))
+	}
 	
-	return nil
+	r.mu.Lock()
+	batch.Status = BatchIncluded
+	batch.SettledOnL1 = true
+	batch.SettledTxHash = receipt.TxHash.Hex() // May be an earlier version of a bumped tx
+	batch.L1BlockNumber = receipt.BlockNumber.Uint64()
+	batch.L1BlockHash = receipt.BlockHash
+	batch.L1Timestamp = submitted.Timestamp.Uint64()
+	batch.FinalizesAt = batch.L1Timestamp + r.challengePeriod
+	r.mu.Unlock()
+	return r.saveBatch(batch)
+}
+
+// parseBatchSubmitted extracts the BatchSubmitted event emitted by the bridge.
+func (r *Relayer) parseBatchSubmitted(receipt *types.Receipt) (*bindings.LyrionBridgeBatchSubmitted, error) {
+	eventID := r.bridgeABI.Events["BatchSubmitted"].ID
+	for _, l := range receipt.Logs {
+		if l.Address != r.bridgeAddress || len(l.Topics) == 0 || l.Topics[0] != eventID {
+			continue
+		}
+		return r.bridge.ParseBatchSubmitted(*l)
+	}
+	return nil, fmt.Errorf("no BatchSubmitted event in tx %s", receipt.TxHash.Hex())
 }
 
 // GetBatches returns all batches
@@ -262,33 +664,24 @@ func (r *Relayer) GetLatestBatch() *Batch {
 	return r.batches[len(r.batches)-1]
 }
 
+// SetBatchInbox sets the L1 address batch data is published to. A zero
+// address only posts state roots.
+func (r *Relayer) SetBatchInbox(inbox common.Address) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batchInbox = inbox
+}
+
+// SetTxConfig sets how L1 transactions are priced and retried.
+func (r *Relayer) SetTxConfig(cfg TxConfig) {
+	if r.txmgr != nil {
+		r.txmgr.SetConfig(cfg)
+	}
+}
+
 // ForceSettle immediately creates and submits a batch
 func (r *Relayer) ForceSettle() (*Batch, error) {
-	currentHeight := r.sequencer.CurrentHeight() - 1
-	
-	if currentHeight <= r.lastSettled {
-		return nil, fmt.Errorf("no new blocks to settle")
-	}
-	
-	startBlock := r.lastSettled + 1
-	endBlock := currentHeight
-	
-	batch, err := r.createBatch(startBlock, endBlock)
-	if err != nil {
-		return nil, err
-	}
-	
-	err = r.settleOnL1(batch)
-	if err != nil {
-		return nil, err
-	}
-	
-	r.mu.Lock()
-	r.batches = append(r.batches, batch)
-	r.lastSettled = endBlock
-	r.mu.Unlock()
-	
-	return batch, nil
+	return r.settleNext(true)
 }
 
 // GetStats returns settlement statistics
@@ -300,13 +693,27 @@ func (r *Relayer) GetStats() map[string]interface{} {
 	for _, b := range r.batches {
 		totalTxs += b.TxCount
 	}
+	safe, finalized := r.finalityHeads()
 	
-	return map[string]interface{}{
+	stats := map[string]interface{}{
 		"totalBatches":     len(r.batches),
 		"lastSettledBlock": r.lastSettled,
 		"totalTxsSettled":  totalTxs,
 		"demoMode":         r.demoMode,
 		"flareRPC":         r.flareRPC,
 		"relayerAddress":   r.address.Hex(),
+		"bridgeAddress":    r.bridgeAddress.Hex(),
+		"halted":           r.halted != nil,
+		"safeBlock":        safe,
+		"finalizedBlock":   finalized,
+		"confirmations":    r.confirmations,
+		"challengePeriod":  r.challengePeriod,
+		"batchInbox":       r.batchInbox.Hex(),
+		"dataPublished":    r.dataPublished,
+		"batchPolicy":      r.policy.String(),
+	}
+	if r.txmgr != nil {
+		stats["l1Tx"] = r.txmgr.GetStats()
 	}
+	return stats
 }
@@ -0,0 +1,141 @@
+package settlement
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/lyrion-l2/lyrion-node/internal/consensus"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/execution"
+	"github.com/lyrion-l2/lyrion-node/internal/mempool"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/l1sim"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+// Helpers for the tests that run settlement against a simulated L1 (see
+// l1sim). They skip when the contracts have not been compiled.
+
+var testL2ChainID = big.NewInt(42069)
+
+// genesisLYR is what newL2Node gives every funded account, for gas
+var genesisLYR = new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))
+
+// newL1 starts a simulated L1 mining a block every 100ms.
+func newL1(t *testing.T) *l1sim.Harness {
+	t.Helper()
+	artifacts, err := l1sim.LoadArtifacts("")
+	if errors.Is(err, l1sim.ErrNoArtifacts) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := l1sim.New(artifacts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { h.Close() })
+	h.StartMining(100 * time.Millisecond)
+	return h
+}
+
+// l2Node is an L2 node on an in-memory state.
+type l2Node struct {
+	state *state.BadgerStateDB
+	exec  *execution.Executor
+	seq   *consensus.Sequencer
+	pool  *mempool.Mempool
+}
+
+// newL2Node creates an L2 node whose genesis funds the given accounts.
+// key seals blocks, nil for verifiers.
+func newL2Node(t *testing.T, key *ecdsa.PrivateKey, funded ...common.Address) *l2Node {
+	t.Helper()
+	st, err := state.NewInMemoryBadgerStateDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(st.Close)
+	exec := execution.NewExecutor(st, testL2ChainID)
+	for _, addr := range funded {
+		exec.Mint(addr, genesisLYR, new(big.Int))
+	}
+	if _, err := st.Commit(true); err != nil {
+		t.Fatal(err)
+	}
+	pool := mempool.NewMempool(testL2ChainID)
+	return &l2Node{
+		state: st,
+		exec:  exec,
+		seq:   consensus.NewSequencer(st, pool, exec, testL2ChainID, key),
+		pool:  pool,
+	}
+}
+
+// produce signs txs with key, in nonce order, and seals them into a block.
+func (n *l2Node) produce(t *testing.T, key *ecdsa.PrivateKey, txs ...*core.Transaction) *core.Block {
+	t.Helper()
+	nonce := n.state.GetNonce(crypto.PubkeyToAddress(key.PublicKey))
+	for _, tx := range txs {
+		tx.Nonce = nonce
+		nonce++
+		signed, err := core.SignTx(tx, testL2ChainID, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := n.pool.Add(signed); err != nil {
+			t.Fatal(err)
+		}
+	}
+	block, err := n.seq.ProduceBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
+
+// newTestRelayer creates a relayer submitting node's blocks with the bridge
+// sequencer key. Its records are kept apart from the node state.
+func newTestRelayer(t *testing.T, h *l1sim.Harness, node *l2Node) *Relayer {
+	t.Helper()
+	store, err := state.NewInMemoryBadgerStateDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(store.Close)
+	r, err := NewRelayerWithClient(h.Client, h.BridgeAddress, node.seq, store, h.Sequencer, DefaultBatchPolicy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.SetBatchInbox(DefaultBatchInbox(testL2ChainID.Uint64()))
+	return r
+}
+
+// newTestVerifier creates a verifier deriving into node from L1 block 1.
+func newTestVerifier(t *testing.T, h *l1sim.Harness, node *l2Node) *Verifier {
+	t.Helper()
+	v, err := NewVerifierWithClient(h.Client, h.BridgeAddress, DefaultBatchInbox(testL2ChainID.Uint64()),
+		node.state, node.exec, node.seq, testL2ChainID, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// waitFor polls cond until it holds or the timeout expires.
+func waitFor(t *testing.T, what string, timeout time.Duration, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,194 @@
+package settlement
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Metadata keys of the relayer. Demo batches are kept apart from real
+// ones so switching modes never mixes fake and real L1 history.
+func (r *Relayer) batchKey(number uint64) string {
+	return fmt.Sprintf("%sbatch-%020d", r.storePrefix(), number)
+}
+
+func (r *Relayer) progressKey() string {
+	return r.storePrefix() + "progress"
+}
+
+func (r *Relayer) dataKey() string {
+	return r.storePrefix() + "data"
+}
+
+func (r *Relayer) storePrefix() string {
+	if r.demoMode {
+		return "settlement-demo-"
+	}
+	return "settlement-"
+}
+
+// storedBatch is the persisted form of a batch, including its signed tx.
+type storedBatch struct {
+	*Batch
+	RawTx hexutil.Bytes `json:"rawTx,omitempty"`
+}
+
+// settlementProgress records how far settlement got.
+type settlementProgress struct {
+	LastBatch   uint64 `json:"lastBatch"`   // Highest batch number stored
+	LastSettled uint64 `json:"lastSettled"` // Last L2 block of the last included batch
+}
+
+// dataProgress records how far the data of the next batch got, so a retry
+// or a restart resumes from the first frame not mined yet instead of paying
+// for the frames on L1 again.
+type dataProgress struct {
+	BatchNumber  uint64        `json:"batchNumber"`
+	StartBlock   uint64        `json:"startBlock"`
+	EndBlock     uint64        `json:"endBlock"`
+	CloseReason  string        `json:"closeReason"`
+	DataTxHashes []string      `json:"dataTxHashes"`      // Mined frames, in order
+	FrameTx      hexutil.Bytes `json:"frameTx,omitempty"` // Signed tx of the frame in flight
+}
+
+// loadBatches restores batches and progress from the node DB.
+func (r *Relayer) loadBatches() error {
+	data := r.store.GetMeta(r.progressKey())
+	if data == nil {
+		return nil
+	}
+	var progress settlementProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return fmt.Errorf("corrupt settlement progress: %v", err)
+	}
+
+	for n := uint64(1); n <= progress.LastBatch; n++ {
+		data := r.store.GetMeta(r.batchKey(n))
+		if data == nil {
+			continue
+		}
+		stored := storedBatch{Batch: new(Batch)}
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return fmt.Errorf("corrupt settlement batch #%d: %v", n, err)
+		}
+		stored.Batch.rawTx = stored.RawTx
+		r.batches = append(r.batches, stored.Batch)
+		r.indexWithdrawals(stored.Batch)
+	}
+	r.lastSettled = progress.LastSettled
+
+	if len(r.batches) > 0 {
+		log.Printf("📜 Loaded %d settlement batches from DB (last settled block: %d)", len(r.batches), r.lastSettled)
+	}
+	return nil
+}
+
+// addBatch appends a new batch and persists it.
+func (r *Relayer) addBatch(batch *Batch) error {
+	r.mu.Lock()
+	r.batches = append(r.batches, batch)
+	r.indexWithdrawals(batch)
+	r.mu.Unlock()
+	return r.saveBatch(batch)
+}
+
+// saveBatch persists a batch and the settlement progress. Included batches
+// advance the last settled block.
+func (r *Relayer) saveBatch(batch *Batch) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if batch.SettledOnL1 && batch.EndBlock > r.lastSettled {
+		r.lastSettled = batch.EndBlock
+	}
+	data, err := json.Marshal(storedBatch{Batch: batch, RawTx: batch.rawTx})
+	if err != nil {
+		return err
+	}
+	if err := r.store.SetMeta(r.batchKey(batch.BatchNumber), data); err != nil {
+		return fmt.Errorf("failed to persist batch #%d: %v", batch.BatchNumber, err)
+	}
+
+	progress := settlementProgress{LastSettled: r.lastSettled}
+	if n := len(r.batches); n > 0 {
+		progress.LastBatch = r.batches[n-1].BatchNumber
+	}
+	data, _ = json.Marshal(progress)
+	return r.store.SetMeta(r.progressKey(), data)
+}
+
+// saveData persists the data progress of a batch not stored yet.
+func (r *Relayer) saveData(batch *Batch) error {
+	r.mu.RLock()
+	data, err := json.Marshal(dataProgress{
+		BatchNumber:  batch.BatchNumber,
+		StartBlock:   batch.StartBlock,
+		EndBlock:     batch.EndBlock,
+		CloseReason:  batch.CloseReason,
+		DataTxHashes: batch.DataTxHashes,
+		FrameTx:      batch.frameTx,
+	})
+	r.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	if err := r.store.SetMeta(r.dataKey(), data); err != nil {
+		return fmt.Errorf("failed to persist batch #%d data progress: %v", batch.BatchNumber, err)
+	}
+	return nil
+}
+
+// loadData returns the data progress of the batch starting at block start,
+// or nil if there is none (or it belongs to a batch already stored).
+func (r *Relayer) loadData(start uint64) (*dataProgress, error) {
+	data := r.store.GetMeta(r.dataKey())
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var progress dataProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return nil, fmt.Errorf("corrupt batch data progress: %v", err)
+	}
+	r.mu.RLock()
+	next := uint64(1)
+	if n := len(r.batches); n > 0 {
+		next = r.batches[n-1].BatchNumber + 1
+	}
+	r.mu.RUnlock()
+	if progress.BatchNumber != next || progress.StartBlock != start {
+		return nil, nil
+	}
+	return &progress, nil
+}
+
+// clearData drops the data progress once its batch is stored.
+func (r *Relayer) clearData() {
+	if err := r.store.SetMeta(r.dataKey(), []byte{}); err != nil {
+		log.Printf("⚠️ Failed to clear batch data progress: %v", err)
+	}
+}
+
+// submittedBatches returns the batches whose L1 tx is not mined yet, in order.
+func (r *Relayer) submittedBatches() []*Batch {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var pending []*Batch
+	for _, b := range r.batches {
+		if b.Status == BatchSubmitted {
+			pending = append(pending, b)
+		}
+	}
+	return pending
+}
+
+// halt stops settlement until an operator looks at the mismatch.
+func (r *Relayer) halt(err error) error {
+	r.mu.Lock()
+	r.halted = err
+	r.mu.Unlock()
+	log.Printf("🛑 Settlement halted: %v", err)
+	return err
+}
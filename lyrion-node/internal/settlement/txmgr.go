@@ -0,0 +1,378 @@
+package settlement
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ErrNonceConsumed is returned when the nonce of a tx was used by another
+// tx before any of its versions was mined.
+var ErrNonceConsumed = errors.New("nonce already used by another transaction")
+
+// errUnderpriced marks a replacement the L1 node rejected for its fees.
+var errUnderpriced = errors.New("replacement transaction underpriced")
+
+// TxConfig tunes how the TxManager gets transactions mined.
+type TxConfig struct {
+	FeeBumpPercent   uint64        // Fee increase for a replacement (nodes require >= 10)
+	ResubmitInterval time.Duration // How long a tx may be pending before its fees are bumped
+	MaxFeeCap        *big.Int      // Upper bound on maxFeePerGas (wei), nil for none
+	MinBalance       *big.Int      // Alert when the submitter balance drops below this (wei)
+	SendRetries      int           // Attempts to send before giving up
+	RetryBackoff     time.Duration // Wait after the first failed send, doubled each attempt
+}
+
+// DefaultTxConfig returns settings suitable for Flare.
+func DefaultTxConfig() TxConfig {
+	return TxConfig{
+		FeeBumpPercent:   15,
+		ResubmitInterval: 45 * time.Second,
+		MaxFeeCap:        new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.GWei)),
+		MinBalance:       new(big.Int).Mul(big.NewInt(10), big.NewInt(params.Ether)),
+		SendRetries:      5,
+		RetryBackoff:     2 * time.Second,
+	}
+}
+
+// TxManager signs and sends L1 transactions from one account. It tracks the
+// account nonce locally, estimates gas, bumps EIP-1559 fees of stuck txs and
+// warns when the account runs low on funds.
+type TxManager struct {
+	client  L1Client
+	key     *ecdsa.PrivateKey
+	from    common.Address
+	chainID *big.Int
+	cfg     TxConfig // Guarded by mu
+
+	mu         sync.Mutex
+	nonce      uint64
+	nonceValid bool // False until synced from L1 (and after nonce errors)
+	balance    *big.Int
+	lowBalance bool
+	bumps      uint64
+}
+
+// NewTxManager creates a tx manager sending from key's account.
+func NewTxManager(client L1Client, key *ecdsa.PrivateKey, chainID *big.Int, cfg TxConfig) *TxManager {
+	return &TxManager{
+		client:  client,
+		key:     key,
+		from:    crypto.PubkeyToAddress(key.PublicKey),
+		chainID: chainID,
+		cfg:     cfg,
+	}
+}
+
+// SetConfig replaces the tx settings.
+func (m *TxManager) SetConfig(cfg TxConfig) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cfg = cfg
+}
+
+// config returns a copy of the tx settings, which SetConfig may replace
+// while a tx is in flight.
+func (m *TxManager) config() TxConfig {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.cfg
+}
+
+// Sign builds and signs a tx calling to with data, using the next local
+// nonce, an estimated gas limit and current fees. It does not send it.
+func (m *TxManager) Sign(ctx context.Context, to common.Address, data []byte) (*types.Transaction, error) {
+	// Estimating first also surfaces reverts (e.g. wrong sequencer)
+	// before we pay for a failing transaction
+	gas, err := m.client.EstimateGas(ctx, ethereum.CallMsg{From: m.from, To: &to, Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %v", err)
+	}
+	gas = gas * (100 + gasLimitMargin) / 100
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.nonceValid {
+		nonce, err := m.client.PendingNonceAt(ctx, m.from)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get L1 nonce: %v", err)
+		}
+		m.nonce, m.nonceValid = nonce, true
+	}
+
+	tip, feeCap, err := m.fees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := m.sign(&types.DynamicFeeTx{
+		ChainID:   m.chainID,
+		Nonce:     m.nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        &to,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.nonce++
+	return tx, nil
+}
+
+// Publish sends tx and waits until it or one of its fee-bumped replacements
+// is mined. Every replacement is passed to onReplace before it is sent so
+// the caller can persist it. Sends are retried with backoff.
+//
+// If no version of tx ever reached the L1 node, its nonce is released so the
+// next Sign doesn't leave a gap that blocks every later tx.
+func (m *TxManager) Publish(ctx context.Context, tx *types.Transaction, onReplace func(*types.Transaction) error) (*types.Receipt, error) {
+	accepted := false
+	receipt, err := m.publish(ctx, tx, onReplace, &accepted)
+	if err != nil && !accepted {
+		m.ResetNonce()
+	}
+	return receipt, err
+}
+
+func (m *TxManager) publish(ctx context.Context, tx *types.Transaction, onReplace func(*types.Transaction) error, accepted *bool) (*types.Receipt, error) {
+	cfg := m.config()
+	sent := []common.Hash{tx.Hash()}
+	for {
+		err := m.send(ctx, cfg, tx)
+		switch {
+		case err == nil:
+			*accepted = true
+			m.checkBalance(ctx)
+			receipt, err := m.waitMined(ctx, cfg, sent, false)
+			if receipt != nil {
+				return receipt, nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("tx %s not mined yet: %v", tx.Hash().Hex(), err)
+			}
+		case errors.Is(err, errUnderpriced):
+			// A replacement must beat the pending one: bump right away
+		case errors.Is(err, ErrNonceConsumed):
+			// Fine if one of our versions took the nonce
+			*accepted = true
+			if receipt, _ := m.waitMined(ctx, cfg, sent, true); receipt != nil {
+				return receipt, nil
+			}
+			return nil, err
+		default:
+			return nil, err
+		}
+
+		bumped, err := m.bump(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		if err := onReplace(bumped); err != nil {
+			return nil, err
+		}
+		log.Printf("⛽ Bumped fees of L1 tx %s -> %s (tip %s, fee cap %s wei)", tx.Hash().Hex(), bumped.Hash().Hex(), bumped.GasTipCap(), bumped.GasFeeCap())
+		tx = bumped
+		sent = append(sent, tx.Hash())
+	}
+}
+
+// send broadcasts tx, retrying transient failures with exponential backoff.
+func (m *TxManager) send(ctx context.Context, cfg TxConfig, tx *types.Transaction) error {
+	backoff := cfg.RetryBackoff
+	var err error
+	for attempt := 0; attempt < cfg.SendRetries; attempt++ {
+		err = m.client.SendTransaction(ctx, tx)
+		if err == nil {
+			return nil
+		}
+		msg := strings.ToLower(err.Error())
+		switch {
+		case strings.Contains(msg, "already known"), strings.Contains(msg, "known transaction"):
+			return nil
+		case strings.Contains(msg, "nonce too low"):
+			m.ResetNonce()
+			return ErrNonceConsumed
+		case strings.Contains(msg, "underpriced"):
+			return errUnderpriced
+		case strings.Contains(msg, "insufficient funds"):
+			m.checkBalance(ctx)
+			return fmt.Errorf("failed to send tx %s: %v", tx.Hash().Hex(), err)
+		}
+
+		log.Printf("⚠️ Sending L1 tx %s failed (attempt %d/%d): %v", tx.Hash().Hex(), attempt+1, cfg.SendRetries, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+	return fmt.Errorf("failed to send tx %s after %d attempts: %v", tx.Hash().Hex(), cfg.SendRetries, err)
+}
+
+// waitMined polls for a receipt of any of the hashes (all versions of one
+// nonce) until the resubmit interval passes. With once set it checks only
+// once.
+func (m *TxManager) waitMined(ctx context.Context, cfg TxConfig, hashes []common.Hash, once bool) (*types.Receipt, error) {
+	deadline := time.NewTimer(cfg.ResubmitInterval)
+	defer deadline.Stop()
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		for _, hash := range hashes {
+			receipt, err := m.client.TransactionReceipt(ctx, hash)
+			if err == nil {
+				return receipt, nil
+			}
+			if err != ethereum.NotFound {
+				log.Printf("⚠️ Failed to get receipt of %s: %v", hash.Hex(), err)
+			}
+		}
+		if once {
+			return nil, nil
+		}
+		select {
+		case <-ticker.C:
+		case <-deadline.C:
+			return nil, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// bump re-signs tx with the same nonce and higher fees: at least
+// FeeBumpPercent above the old ones, or current market fees if higher.
+func (m *TxManager) bump(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tip, feeCap, err := m.fees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tip = maxBig(tip, m.increase(tx.GasTipCap()))
+	feeCap = maxBig(feeCap, m.increase(tx.GasFeeCap()))
+	if m.cfg.MaxFeeCap != nil && feeCap.Cmp(m.cfg.MaxFeeCap) > 0 {
+		if tx.GasFeeCap().Cmp(m.cfg.MaxFeeCap) >= 0 {
+			return nil, fmt.Errorf("tx %s is stuck at the max fee cap (%s wei)", tx.Hash().Hex(), m.cfg.MaxFeeCap)
+		}
+		feeCap = new(big.Int).Set(m.cfg.MaxFeeCap)
+	}
+	if tip.Cmp(feeCap) > 0 {
+		tip = new(big.Int).Set(feeCap)
+	}
+	m.bumps++
+
+	return m.sign(&types.DynamicFeeTx{
+		ChainID:   m.chainID,
+		Nonce:     tx.Nonce(),
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       tx.Gas(),
+		To:        tx.To(),
+		Value:     tx.Value(),
+		Data:      tx.Data(),
+	})
+}
+
+// fees returns the current tip and a fee cap of twice the base fee plus the
+// tip, so the tx stays valid through a few full blocks.
+func (m *TxManager) fees(ctx context.Context) (*big.Int, *big.Int, error) {
+	head, err := m.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get L1 head: %v", err)
+	}
+	if head.BaseFee == nil {
+		return nil, nil, fmt.Errorf("L1 does not support EIP-1559 fees")
+	}
+	tip, err := m.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get gas tip: %v", err)
+	}
+	feeCap := new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), tip)
+	if m.cfg.MaxFeeCap != nil && feeCap.Cmp(m.cfg.MaxFeeCap) > 0 {
+		feeCap = new(big.Int).Set(m.cfg.MaxFeeCap)
+	}
+	if tip.Cmp(feeCap) > 0 {
+		tip = new(big.Int).Set(feeCap)
+	}
+	return tip, feeCap, nil
+}
+
+func (m *TxManager) sign(inner *types.DynamicFeeTx) (*types.Transaction, error) {
+	tx, err := types.SignNewTx(m.key, types.LatestSignerForChainID(m.chainID), inner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign tx: %v", err)
+	}
+	return tx, nil
+}
+
+func (m *TxManager) increase(v *big.Int) *big.Int {
+	out := new(big.Int).Mul(v, new(big.Int).SetUint64(100+m.cfg.FeeBumpPercent))
+	return out.Div(out, big.NewInt(100))
+}
+
+// ResetNonce makes the next Sign resync the nonce from L1. The L1 node's
+// pending nonce skips nothing, so a nonce signed but never sent is reused.
+func (m *TxManager) ResetNonce() {
+	m.mu.Lock()
+	m.nonceValid = false
+	m.mu.Unlock()
+}
+
+// checkBalance logs an alert when the account balance drops below the
+// configured minimum, once per drop.
+func (m *TxManager) checkBalance(ctx context.Context) {
+	balance, err := m.client.BalanceAt(ctx, m.from, nil)
+	if err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.balance = balance
+	low := m.cfg.MinBalance != nil && balance.Cmp(m.cfg.MinBalance) < 0
+	if low && !m.lowBalance {
+		log.Printf("🪫 LOW BALANCE: batch submitter %s has %s wei on L1 (minimum %s), top it up to keep settling", m.from.Hex(), balance, m.cfg.MinBalance)
+	}
+	m.lowBalance = low
+}
+
+// GetStats returns tx manager statistics.
+func (m *TxManager) GetStats() map[string]interface{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := map[string]interface{}{
+		"nonce":      m.nonce,
+		"feeBumps":   m.bumps,
+		"lowBalance": m.lowBalance,
+	}
+	if m.balance != nil {
+		stats["balance"] = m.balance.String()
+	}
+	return stats
+}
+
+func maxBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,194 @@
+package settlement
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lyrion-l2/lyrion-node/internal/settlement/l1sim"
+)
+
+// testClient is an L1 client that fails the next sends with the queued
+// errors and calls onSend after each tx it forwards.
+type testClient struct {
+	L1Client
+
+	mu       sync.Mutex
+	failures []error
+	sent     []*types.Transaction // Every tx sent, failed or not
+	accepted int
+	onSend   func(accepted int)
+}
+
+func (c *testClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	c.mu.Lock()
+	c.sent = append(c.sent, tx)
+	if len(c.failures) > 0 {
+		err := c.failures[0]
+		c.failures = c.failures[1:]
+		c.mu.Unlock()
+		return err
+	}
+	c.mu.Unlock()
+
+	if err := c.L1Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.accepted++
+	accepted, onSend := c.accepted, c.onSend
+	c.mu.Unlock()
+	if onSend != nil {
+		onSend(accepted)
+	}
+	return nil
+}
+
+// newTestTxManager returns a tx manager sending from the bridge sequencer
+// account of a simulated L1 that only mines when the test commits.
+func newTestTxManager(t *testing.T) (*TxManager, *testClient, *l1sim.Harness) {
+	t.Helper()
+	h := newL1(t)
+	h.StopMining()
+	client := &testClient{L1Client: h.Client}
+	cfg := DefaultTxConfig()
+	cfg.ResubmitInterval = 50 * time.Millisecond
+	cfg.RetryBackoff = time.Millisecond
+	cfg.SendRetries = 3
+	return NewTxManager(client, h.Sequencer, h.ChainID, cfg), client, h
+}
+
+var txTarget = common.HexToAddress("0xb0b")
+
+func signTestTx(t *testing.T, m *TxManager) *types.Transaction {
+	t.Helper()
+	tx, err := m.Sign(context.Background(), txTarget, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func noReplace(*types.Transaction) error { return nil }
+
+// Transient send failures are retried up to SendRetries times, and a tx
+// that never reached L1 gives its nonce back.
+func TestTxManagerSendRetries(t *testing.T) {
+	m, client, h := newTestTxManager(t)
+	client.onSend = func(int) { h.Commit() }
+	transient := errors.New("connection reset by peer")
+
+	client.failures = []error{transient, transient}
+	tx := signTestTx(t, m)
+	receipt, err := m.Publish(context.Background(), tx, noReplace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.TxHash != tx.Hash() || len(client.sent) != 3 {
+		t.Fatalf("mined %s after %d sends, want %s after 3", receipt.TxHash.Hex(), len(client.sent), tx.Hash().Hex())
+	}
+
+	client.failures = []error{transient, transient, transient}
+	tx = signTestTx(t, m)
+	if _, err := m.Publish(context.Background(), tx, noReplace); err == nil || !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Fatalf("publish with every send failing: %v", err)
+	}
+	if next := signTestTx(t, m); next.Nonce() != tx.Nonce() {
+		t.Fatalf("nonce %d after a tx that was never sent, want %d reused", next.Nonce(), tx.Nonce())
+	}
+}
+
+// A tx pending past the resubmit interval is replaced with higher fees at
+// the same nonce until one version is mined.
+func TestTxManagerFeeBump(t *testing.T) {
+	m, client, h := newTestTxManager(t)
+	client.onSend = func(accepted int) {
+		if accepted == 3 {
+			h.Commit()
+		}
+	}
+
+	tx := signTestTx(t, m)
+	var replacements []*types.Transaction
+	receipt, err := m.Publish(context.Background(), tx, func(bumped *types.Transaction) error {
+		replacements = append(replacements, bumped)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replacements) != 2 || receipt.TxHash != replacements[1].Hash() {
+		t.Fatalf("mined %s after %d replacements, want the second one", receipt.TxHash.Hex(), len(replacements))
+	}
+	prev := tx
+	for _, r := range replacements {
+		minFeeCap := new(big.Int).Div(new(big.Int).Mul(prev.GasFeeCap(), big.NewInt(115)), big.NewInt(100))
+		minTip := new(big.Int).Div(new(big.Int).Mul(prev.GasTipCap(), big.NewInt(115)), big.NewInt(100))
+		if r.Nonce() != tx.Nonce() || r.GasFeeCap().Cmp(minFeeCap) < 0 || r.GasTipCap().Cmp(minTip) < 0 {
+			t.Fatalf("replacement nonce %d, fees %s/%s; want nonce %d, fees >= %s/%s", r.Nonce(), r.GasTipCap(), r.GasFeeCap(), tx.Nonce(), minTip, minFeeCap)
+		}
+		prev = r
+	}
+	if bumps := m.GetStats()["feeBumps"]; bumps != uint64(2) {
+		t.Fatalf("%v fee bumps, want 2", bumps)
+	}
+}
+
+// Bumping stops at MaxFeeCap, including a cap lowered while the tx is in
+// flight, and the nonce of a tx that reached L1 is kept.
+func TestTxManagerMaxFeeCap(t *testing.T) {
+	m, client, _ := newTestTxManager(t)
+	tx := signTestTx(t, m)
+	cfg := m.config()
+	cfg.MaxFeeCap = tx.GasFeeCap()
+	m.SetConfig(cfg)
+
+	_, err := m.Publish(context.Background(), tx, func(*types.Transaction) error {
+		t.Fatal("replaced a tx at the max fee cap")
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "max fee cap") {
+		t.Fatalf("publish at the max fee cap: %v", err)
+	}
+	if len(client.sent) != 1 {
+		t.Fatalf("%d sends, want 1", len(client.sent))
+	}
+	if next := signTestTx(t, m); next.Nonce() != tx.Nonce()+1 {
+		t.Fatalf("nonce %d after a pending tx at %d", next.Nonce(), tx.Nonce())
+	}
+}
+
+// A nonce taken by a tx the manager didn't send fails the tx and resyncs
+// the nonce from L1.
+func TestTxManagerNonceConsumed(t *testing.T) {
+	m, _, h := newTestTxManager(t)
+	tx := signTestTx(t, m)
+
+	// Another process sending from the same account takes the nonce
+	opts, _ := h.Transactor(h.Sequencer)
+	other, err := opts.Signer(opts.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID: h.ChainID, Nonce: tx.Nonce(), GasTipCap: tx.GasTipCap(), GasFeeCap: tx.GasFeeCap(), Gas: 21000, To: &txTarget,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Client.SendTransaction(context.Background(), other); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Mine(other); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.Publish(context.Background(), tx, noReplace); !errors.Is(err, ErrNonceConsumed) {
+		t.Fatalf("publish with a used nonce: %v", err)
+	}
+	if next := signTestTx(t, m); next.Nonce() != tx.Nonce()+1 {
+		t.Fatalf("nonce %d after %d was taken, want %d", next.Nonce(), tx.Nonce(), tx.Nonce()+1)
+	}
+}
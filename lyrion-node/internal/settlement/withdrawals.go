@@ -0,0 +1,142 @@
+package settlement
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+// Withdrawal is an L2 -> L1 payout committed to by a settlement batch.
+// Withdrawals to the same recipient within a batch are merged into one leaf:
+// the bridge accepts a single claim per (batch, recipient, amount).
+type Withdrawal struct {
+	Recipient common.Address `json:"recipient"`
+	Amount    *big.Int       `json:"amount"`
+	TxHashes  []common.Hash  `json:"txHashes"`
+}
+
+// WithdrawalProof is everything needed to call LyrionBridge.withdrawFromL2.
+type WithdrawalProof struct {
+	BatchNumber uint64         `json:"batchNumber"`
+	Recipient   common.Address `json:"recipient"`
+	Amount      *big.Int       `json:"amount"`
+	Proof       []common.Hash  `json:"proof"`
+	Root        common.Hash    `json:"root"`
+	Leaf        common.Hash    `json:"leaf"`
+}
+
+// collectWithdrawals returns the withdrawals in blocks, in order of first appearance.
+func collectWithdrawals(blocks []*core.Block) []*Withdrawal {
+	var withdrawals []*Withdrawal
+	byRecipient := make(map[common.Address]*Withdrawal)
+
+	for _, block := range blocks {
+		for _, tx := range block.Transactions {
+			if tx.Type != core.TxTypeWithdrawal || tx.From == nil {
+				continue
+			}
+			recipient, err := core.WithdrawalRecipient(tx, *tx.From)
+			if err != nil {
+				continue // Never executed, so never included
+			}
+			w, ok := byRecipient[recipient]
+			if !ok {
+				w = &Withdrawal{Recipient: recipient, Amount: new(big.Int)}
+				byRecipient[recipient] = w
+				withdrawals = append(withdrawals, w)
+			}
+			w.Amount.Add(w.Amount, tx.Value)
+			w.TxHashes = append(w.TxHashes, tx.Hash())
+		}
+	}
+	return withdrawals
+}
+
+// batchTree builds the tree whose root a batch submits to L1: the L2 state
+// root is the first leaf, followed by one leaf per withdrawal. Without
+// withdrawals the root is the state root itself.
+func batchTree(stateRoot common.Hash, withdrawals []*Withdrawal) *MerkleTree {
+	leaves := make([]common.Hash, 0, len(withdrawals)+1)
+	leaves = append(leaves, stateRoot)
+	for _, w := range withdrawals {
+		leaves = append(leaves, WithdrawalLeaf(w.Recipient, w.Amount))
+	}
+	return NewMerkleTree(leaves)
+}
+
+// batchBlocks loads the L2 blocks of a batch range.
+func (r *Relayer) batchBlocks(start, end uint64) []*core.Block {
+	blocks := make([]*core.Block, 0, end-start+1)
+	for i := start; i <= end; i++ {
+		if block := r.sequencer.GetBlock(i); block != nil {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// indexWithdrawals maps the withdrawal txs of batch to it. A batch settled
+// again under the same number replaces the earlier one. The caller holds
+// r.mu.
+func (r *Relayer) indexWithdrawals(batch *Batch) {
+	if r.withdrawals == nil {
+		r.withdrawals = make(map[common.Hash]uint64)
+	}
+	for _, w := range batch.Withdrawals {
+		for _, h := range w.TxHashes {
+			r.withdrawals[h] = batch.BatchNumber
+		}
+	}
+}
+
+// GetWithdrawalProof returns the Merkle proof of the withdrawal made by txHash.
+// The withdrawal must be part of a batch on L1 that was not challenged.
+func (r *Relayer) GetWithdrawalProof(txHash common.Hash) (*WithdrawalProof, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	n, ok := r.withdrawals[txHash]
+	if !ok {
+		return nil, fmt.Errorf("withdrawal %s not found in a settled batch", txHash.Hex())
+	}
+	var batch *Batch
+	for i := len(r.batches) - 1; i >= 0; i-- {
+		if r.batches[i].BatchNumber == n {
+			batch = r.batches[i]
+			break
+		}
+	}
+	if batch == nil {
+		return nil, fmt.Errorf("withdrawal %s not found in a settled batch", txHash.Hex())
+	}
+	switch batch.Status {
+	case BatchIncluded, BatchConfirmed, BatchFinalized:
+	case BatchChallenged:
+		return nil, fmt.Errorf("batch #%d with withdrawal %s was challenged on L1", n, txHash.Hex())
+	default:
+		return nil, fmt.Errorf("batch #%d with withdrawal %s is not on L1 yet", n, txHash.Hex())
+	}
+
+	tree := batchTree(batch.StateRoot, batch.Withdrawals)
+	if tree.Root() != batch.OutputRoot {
+		return nil, fmt.Errorf("batch #%d root mismatch: rebuilt %s, submitted %s", n, tree.Root().Hex(), batch.OutputRoot.Hex())
+	}
+	for i, w := range batch.Withdrawals {
+		for _, h := range w.TxHashes {
+			if h != txHash {
+				continue
+			}
+			return &WithdrawalProof{
+				BatchNumber: n,
+				Recipient:   w.Recipient,
+				Amount:      new(big.Int).Set(w.Amount),
+				Proof:       tree.Proof(i + 1), // Leaf 0 is the state root
+				Root:        tree.Root(),
+				Leaf:        WithdrawalLeaf(w.Recipient, w.Amount),
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("withdrawal %s not found in batch #%d", txHash.Hex(), n)
+}
@@ -0,0 +1,137 @@
+package settlement
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+	"github.com/lyrion-l2/lyrion-node/internal/state"
+)
+
+// Withdrawals re-executed from batch data, whose txs carry no sender, give
+// the output root the sequencer computed.
+func TestDerivedWithdrawals(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	user := crypto.PubkeyToAddress(key.PublicKey)
+	seqNode := newL2Node(t, key, user)
+	verNode := newL2Node(t, nil, user)
+	for _, n := range []*l2Node{seqNode, verNode} {
+		n.exec.Mint(user, nil, big.NewInt(2*params.Ether))
+		if _, err := n.state.Commit(true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	block := seqNode.produce(t, key,
+		&core.Transaction{Type: core.TxTypeWithdrawal, Value: big.NewInt(params.Ether), Gas: 21000},
+		&core.Transaction{Type: core.TxTypeWithdrawal, Value: big.NewInt(params.GWei), Gas: 21000},
+	)
+	want := collectWithdrawals([]*core.Block{block})
+	if len(want) != 1 || len(want[0].TxHashes) != 2 {
+		t.Fatalf("sequencer block has %d withdrawals, want 1 merged from 2 txs", len(want))
+	}
+
+	bd, err := NewBatchData(1, []*core.Block{block})
+	if err != nil {
+		t.Fatal(err)
+	}
+	frames, err := EncodeChannel(bd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame, err := ParseFrame(frames[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	derived, err := NewChannelAssembler().AddFrame(frame)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txs, err := derived.Blocks[0].Transactions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	verNode.exec.SetBlockTime(block.Header.Time)
+	for _, tx := range txs {
+		if tx.From != nil {
+			t.Fatal("decoded tx carries a sender")
+		}
+		if err := executeTx(verNode.exec, tx, testL2ChainID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	root, err := verNode.state.Commit(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != block.Header.Root {
+		t.Fatalf("derived state root %s, sequencer %s", root.Hex(), block.Header.Root.Hex())
+	}
+
+	got := collectWithdrawals([]*core.Block{core.NewBlock(block.Header, txs)})
+	if len(got) != 1 || got[0].Recipient != user || got[0].Amount.Cmp(want[0].Amount) != 0 {
+		t.Fatalf("derived withdrawals %v, want %s to %s", got, want[0].Amount, user.Hex())
+	}
+	if derivedRoot, seqRoot := batchTree(root, got).Root(), batchTree(block.Header.Root, want).Root(); derivedRoot != seqRoot {
+		t.Fatalf("derived output root %s, sequencer %s", derivedRoot.Hex(), seqRoot.Hex())
+	}
+}
+
+// Proofs are only served for batches on L1 that were not challenged, and
+// the latest submission of a batch number wins.
+func TestWithdrawalProofStatus(t *testing.T) {
+	store, err := state.NewInMemoryBadgerStateDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	r := &Relayer{store: store}
+
+	txHash := common.HexToHash("0x1")
+	batch := func(stateRoot common.Hash, status BatchStatus) *Batch {
+		withdrawals := []*Withdrawal{
+			{Recipient: common.HexToAddress("0xa"), Amount: big.NewInt(1), TxHashes: []common.Hash{common.HexToHash("0x2")}},
+			{Recipient: common.HexToAddress("0xb"), Amount: big.NewInt(2), TxHashes: []common.Hash{txHash}},
+		}
+		return &Batch{
+			BatchNumber: 1,
+			Status:      status,
+			StateRoot:   stateRoot,
+			OutputRoot:  batchTree(stateRoot, withdrawals).Root(),
+			Withdrawals: withdrawals,
+		}
+	}
+
+	challenged := batch(common.HexToHash("0xbad"), BatchChallenged)
+	if err := r.addBatch(challenged); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.GetWithdrawalProof(txHash); err == nil {
+		t.Fatal("proof served from a challenged batch")
+	}
+
+	resubmitted := batch(common.HexToHash("0x600d"), BatchSubmitted)
+	if err := r.addBatch(resubmitted); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.GetWithdrawalProof(txHash); err == nil {
+		t.Fatal("proof served before the batch was on L1")
+	}
+
+	for _, status := range []BatchStatus{BatchIncluded, BatchConfirmed, BatchFinalized} {
+		resubmitted.Status = status
+		proof, err := r.GetWithdrawalProof(txHash)
+		if err != nil {
+			t.Fatalf("%s batch: %v", status, err)
+		}
+		if proof.Root != resubmitted.OutputRoot || !VerifyMerkleProof(proof.Proof, proof.Root, proof.Leaf) {
+			t.Fatalf("%s batch: proof does not verify against the resubmitted root", status)
+		}
+	}
+	if _, err := r.GetWithdrawalProof(common.HexToHash("0x3")); err == nil {
+		t.Fatal("proof served for an unknown tx")
+	}
+}
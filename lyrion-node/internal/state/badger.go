@@ -2,12 +2,15 @@ package state
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"math/big"
+	"sync"
 
 	"github.com/dgraph-io/badger/v4"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/lyrion-l2/lyrion-node/internal/core"
 )
 
@@ -20,6 +23,15 @@ var (
 type BadgerStateDB struct {
 	db *badger.DB
 	// Cache could be added here
+	
+	trie    *trie.Trie        // State trie at the last Commit (see trie.go)
+	dirty   map[string][]byte // State keys written since the last Commit (nil = deleted)
+	trieMu  sync.Mutex
+	
+	// Set on snapshots only (see overlay.go)
+	parent *BadgerStateDB
+	writes map[string][]byte // Every key written, not yet flushed to the parent (nil = deleted)
+	pre    map[string][]byte // Parent value of every key read or written (the witness)
 }
 
 func NewBadgerStateDB(path string) (*BadgerStateDB, error) {
@@ -31,7 +43,29 @@ func NewBadgerStateDB(path string) (*BadgerStateDB, error) {
 		return nil, err
 	}
 	
-	return &BadgerStateDB{db: db}, nil
+	return openBadgerStateDB(db)
+}
+
+// NewInMemoryBadgerStateDB opens an empty Badger state kept in memory only.
+// Used to re-execute a single step from a witness.
+func NewInMemoryBadgerStateDB() (*BadgerStateDB, error) {
+	opts := badger.DefaultOptions("").WithInMemory(true)
+	opts.Logger = nil
+	
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	return openBadgerStateDB(db)
+}
+
+func openBadgerStateDB(db *badger.DB) (*BadgerStateDB, error) {
+	s := &BadgerStateDB{db: db, dirty: make(map[string][]byte)}
+	if err := s.openTrie(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to open state trie: %v", err)
+	}
+	return s, nil
 }
 
 func (s *BadgerStateDB) Close() {
@@ -42,24 +76,15 @@ func (s *BadgerStateDB) Close() {
 
 func (s *BadgerStateDB) getAccount(addr common.Address) (*Account, error) {
 	key := append(PrefixAccount, addr.Bytes()...)
-	var acc Account
-	
-	err := s.db.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(key)
-		if err != nil {
-			return err
-		}
-		return item.Value(func(val []byte) error {
-			return json.Unmarshal(val, &acc)
-		})
-	})
-	
-	if err == badger.ErrKeyNotFound {
+	val := s.GetRaw(key)
+	if val == nil {
 		return &Account{
 			BalanceLYR: new(big.Int),
 			BalanceFLR: new(big.Int),
 		}, nil
 	}
+	var acc Account
+	err := json.Unmarshal(val, &acc)
 	return &acc, err
 }
 
@@ -67,10 +92,7 @@ func (s *BadgerStateDB) setAccount(addr common.Address, acc *Account) {
 	key := append(PrefixAccount, addr.Bytes()...)
 	val, _ := json.Marshal(acc) // In prod use RLP or Protobuf
 	
-	err := s.db.Update(func(txn *badger.Txn) error {
-		return txn.Set(key, val)
-	})
-	if err != nil {
+	if err := s.put(key, val); err != nil {
 		log.Printf("Failed to set account: %v", err)
 	}
 }
@@ -160,32 +182,14 @@ func (s *BadgerStateDB) GetState(addr common.Address, key common.Hash) common.Ha
 	storageKey := append(PrefixStorage, addr.Bytes()...)
 	storageKey = append(storageKey, key.Bytes()...)
 
-	var value common.Hash
-	err := s.db.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(storageKey)
-		if err != nil {
-			return err
-		}
-		return item.Value(func(val []byte) error {
-			value = common.BytesToHash(val)
-			return nil
-		})
-	})
-
-	if err != nil {
-		return common.Hash{}
-	}
-	return value
+	return common.BytesToHash(s.GetRaw(storageKey))
 }
 
 func (s *BadgerStateDB) SetState(addr common.Address, key common.Hash, value common.Hash) {
 	storageKey := append(PrefixStorage, addr.Bytes()...)
 	storageKey = append(storageKey, key.Bytes()...)
 
-	err := s.db.Update(func(txn *badger.Txn) error {
-		return txn.Set(storageKey, value.Bytes())
-	})
-	if err != nil {
+	if err := s.put(storageKey, value.Bytes()); err != nil {
 		log.Printf("Failed to set state: %v", err)
 	}
 }
@@ -196,25 +200,16 @@ var PrefixPool = []byte("pool-")
 
 func (s *BadgerStateDB) GetPool(pairName string) *core.Pool {
 	key := append(PrefixPool, []byte(pairName)...)
-	var pool core.Pool
-	
-	err := s.db.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(key)
-		if err != nil {
-			return err
-		}
-		return item.Value(func(val []byte) error {
-			return json.Unmarshal(val, &pool)
-		})
-	})
-	
-	if err == badger.ErrKeyNotFound {
+	val := s.GetRaw(key)
+	if val == nil {
 		return &core.Pool{
 			Reserve0:    new(big.Int),
 			Reserve1:    new(big.Int),
 			TotalSupply: new(big.Int),
 		}
 	}
+	var pool core.Pool
+	json.Unmarshal(val, &pool)
 	return &pool
 }
 
@@ -222,17 +217,137 @@ func (s *BadgerStateDB) SetPool(pairName string, pool *core.Pool) {
 	key := append(PrefixPool, []byte(pairName)...)
 	val, _ := json.Marshal(pool)
 	
+	if err := s.put(key, val); err != nil {
+		log.Printf("Failed to set pool: %v", err)
+	}
+}
+
+// put writes a key (nil deletes it). State keys are staged for the next
+// Commit, which writes them together with the state root; other keys are
+// written immediately.
+func (s *BadgerStateDB) put(key, value []byte) error {
+	if s.parent != nil {
+		s.putOverlay(key, value)
+		return nil
+	}
+	if !isStateKey(key) {
+		return s.db.Update(func(txn *badger.Txn) error {
+			if value == nil {
+				return txn.Delete(key)
+			}
+			return txn.Set(key, value)
+		})
+	}
+	if len(value) == 0 {
+		value = nil
+	}
+	s.trieMu.Lock()
+	s.dirty[string(key)] = common.CopyBytes(value)
+	s.trieMu.Unlock()
+	return nil
+}
+
+// Commit returns the state root. It folds the state keys written since the
+// previous call into the state trie, then writes the new trie nodes and,
+// in one transaction, the keys and the new root, so a crash leaves the
+// state at the previous or the new root but never in between. Two nodes
+// that executed the same txs get the same root.
+func (s *BadgerStateDB) Commit(deleteEmptyObjects bool) (common.Hash, error) {
+	if s.parent != nil {
+		return s.commitOverlay()
+	}
+	s.trieMu.Lock()
+	defer s.trieMu.Unlock()
+	
+	prevRoot := s.trie.Hash()
+	root, err := s.commitTrie()
+	if err != nil {
+		// A committed trie can't be used further: go back to the last root
+		// and keep the writes for the next attempt
+		if t, terr := trie.New(trie.StateTrieID(prevRoot), nodeStore{s.db}); terr == nil {
+			s.trie = t
+		}
+		return common.Hash{}, err
+	}
+	
+	// A committed trie can't be updated further: reopen it at the new root
+	if s.trie, err = trie.New(trie.StateTrieID(root), nodeStore{s.db}); err != nil {
+		return common.Hash{}, err
+	}
+	s.dirty = make(map[string][]byte)
+	return root, nil
+}
+
+// commitTrie applies the dirty keys to the trie and persists them with it.
+func (s *BadgerStateDB) commitTrie() (common.Hash, error) {
+	if err := updateTrie(s.trie, s.dirty); err != nil {
+		return common.Hash{}, err
+	}
+	root, nodes := s.trie.Commit(false)
+	if nodes != nil {
+		// Nodes are keyed by hash: writing them ahead of the root is harmless
+		wb := s.db.NewWriteBatch()
+		defer wb.Cancel()
+		for hash, blob := range nodes.HashSet() {
+			if err := wb.Set(append(append([]byte{}, PrefixTrie...), hash[:]...), blob); err != nil {
+				return common.Hash{}, err
+			}
+		}
+		if err := wb.Flush(); err != nil {
+			return common.Hash{}, fmt.Errorf("failed to write state trie: %v", err)
+		}
+	}
 	err := s.db.Update(func(txn *badger.Txn) error {
-		return txn.Set(key, val)
+		for key, value := range s.dirty {
+			var err error
+			if value == nil {
+				err = txn.Delete([]byte(key))
+			} else {
+				err = txn.Set([]byte(key), value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return txn.Set(KeyStateRoot, root.Bytes())
 	})
 	if err != nil {
-		log.Printf("Failed to set pool: %v", err)
+		return common.Hash{}, fmt.Errorf("failed to write state: %v", err)
 	}
+	return root, nil
 }
 
-func (s *BadgerStateDB) Commit(deleteEmptyObjects bool) (common.Hash, error) {
-	// Badger commits immediately in this direct-DB model
-	return common.Hash{}, nil
+// -- Raw Keys --
+
+// GetRaw returns the stored value of a state key (nil if missing)
+func (s *BadgerStateDB) GetRaw(key []byte) []byte {
+	if s.parent != nil {
+		return s.getOverlay(key)
+	}
+	if isStateKey(key) {
+		s.trieMu.Lock()
+		value, ok := s.dirty[string(key)]
+		s.trieMu.Unlock()
+		if ok {
+			return common.CopyBytes(value)
+		}
+	}
+	var value []byte
+	s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	return value
+}
+
+// SetRaw stores a state key as-is (nil deletes it). Used to seed a state
+// from a witness, or to roll one back to its pre-values.
+func (s *BadgerStateDB) SetRaw(key, value []byte) error {
+	return s.put(key, value)
 }
 
 // -- Block Persistence --
@@ -298,3 +413,32 @@ func (s *BadgerStateDB) GetBlockHeight() uint64 {
 	return height
 }
 
+// -- Node Metadata --
+
+var PrefixMeta = []byte("meta-")
+
+// metaKey builds the DB key for a metadata entry (copying the shared prefix)
+func metaKey(key string) []byte {
+	return append(append([]byte{}, PrefixMeta...), key...)
+}
+
+// GetMeta returns the metadata value stored under key (nil if missing)
+func (s *BadgerStateDB) GetMeta(key string) []byte {
+	var value []byte
+	s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(metaKey(key))
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	return value
+}
+
+// SetMeta stores a metadata value under key
+func (s *BadgerStateDB) SetMeta(key string, value []byte) error {
+	return s.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(metaKey(key), value)
+	})
+}
@@ -0,0 +1,143 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// A snapshot is a BadgerStateDB that stages its writes in memory on top of a
+// parent state. Reads fall through to the parent, Flush applies the writes to
+// it and dropping the snapshot discards them. The first parent value of every
+// key the snapshot touches is recorded, so the witness of an execution holds
+// exactly the keys that execution used, whatever else reads the parent.
+//
+// A snapshot is not safe for concurrent use. Block and meta methods are not
+// staged: they go straight to the database.
+
+// Snapshot returns a new snapshot on top of s. Snapshots can be nested.
+func (s *BadgerStateDB) Snapshot() *BadgerStateDB {
+	return &BadgerStateDB{
+		db:     s.db,
+		parent: s,
+		writes: make(map[string][]byte),
+		pre:    make(map[string][]byte),
+	}
+}
+
+// Witness returns the parent value of every key read or written since the
+// snapshot was taken (nil if missing).
+func (s *BadgerStateDB) Witness() map[string][]byte {
+	witness := make(map[string][]byte, len(s.pre))
+	for key, value := range s.pre {
+		witness[key] = value
+	}
+	return witness
+}
+
+// Flush applies the staged writes to the parent and empties the snapshot.
+func (s *BadgerStateDB) Flush() error {
+	if s.parent == nil {
+		return fmt.Errorf("state is not a snapshot")
+	}
+	if p := s.parent; p.parent != nil {
+		// p recorded its own pre-values when s read through it
+		for key, value := range s.writes {
+			p.writes[key] = value
+		}
+	} else if err := p.putBatch(s.writes); err != nil {
+		return err
+	}
+	s.writes = make(map[string][]byte)
+	s.pre = make(map[string][]byte)
+	return nil
+}
+
+// putBatch writes keys (nil deletes them) like put: state keys are staged
+// for the next Commit, the others written in one batch.
+func (s *BadgerStateDB) putBatch(writes map[string][]byte) error {
+	wb := s.db.NewWriteBatch()
+	defer wb.Cancel()
+	for key, value := range writes {
+		if isStateKey([]byte(key)) {
+			continue
+		}
+		var err error
+		if value == nil {
+			err = wb.Delete([]byte(key))
+		} else {
+			err = wb.Set([]byte(key), value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return fmt.Errorf("failed to write state: %v", err)
+	}
+
+	s.trieMu.Lock()
+	defer s.trieMu.Unlock()
+	for key, value := range writes {
+		if isStateKey([]byte(key)) {
+			s.dirty[key] = value
+		}
+	}
+	return nil
+}
+
+func (s *BadgerStateDB) getOverlay(key []byte) []byte {
+	if value, ok := s.writes[string(key)]; ok {
+		return common.CopyBytes(value)
+	}
+	value := s.parent.GetRaw(key)
+	if _, ok := s.pre[string(key)]; !ok {
+		s.pre[string(key)] = common.CopyBytes(value)
+	}
+	return value
+}
+
+func (s *BadgerStateDB) putOverlay(key, value []byte) {
+	if _, ok := s.writes[string(key)]; !ok {
+		if _, ok := s.pre[string(key)]; !ok {
+			s.pre[string(key)] = s.parent.GetRaw(key)
+		}
+	}
+	if len(value) == 0 {
+		value = nil
+	}
+	s.writes[string(key)] = common.CopyBytes(value)
+}
+
+// currentTrie returns a copy of the state trie with every staged change
+// applied, from the last Commit of the database up to s.
+func (s *BadgerStateDB) currentTrie() (*trie.Trie, error) {
+	if s.parent == nil {
+		s.trieMu.Lock()
+		defer s.trieMu.Unlock()
+		t := s.trie.Copy()
+		return t, updateTrie(t, s.dirty)
+	}
+	t, err := s.parent.currentTrie()
+	if err != nil {
+		return nil, err
+	}
+	changes := make(map[string][]byte, len(s.writes))
+	for key, value := range s.writes {
+		if isStateKey([]byte(key)) {
+			changes[key] = value
+		}
+	}
+	return t, updateTrie(t, changes)
+}
+
+// commitOverlay returns the state root the snapshot would give once flushed,
+// without writing anything.
+func (s *BadgerStateDB) commitOverlay() (common.Hash, error) {
+	t, err := s.currentTrie()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return t.Hash(), nil
+}
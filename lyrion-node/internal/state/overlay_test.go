@@ -0,0 +1,62 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// A snapshot reads through to its parent, keeps its writes until Flush and
+// gives the root the parent gets once they are flushed.
+func TestSnapshot(t *testing.T) {
+	s, _ := NewInMemoryBadgerStateDB()
+	defer s.Close()
+	a, b := common.HexToAddress("0xa"), common.HexToAddress("0xb")
+	s.SetBalanceLYR(a, big.NewInt(100))
+	base, _ := s.Commit(true)
+
+	snap := s.Snapshot()
+	if bal := snap.GetBalanceLYR(a); bal.Int64() != 100 {
+		t.Fatalf("snapshot reads %d, want 100", bal)
+	}
+	snap.SetBalanceLYR(a, big.NewInt(40))
+	snap.SetBalanceLYR(b, big.NewInt(60))
+	if bal := s.GetBalanceLYR(b); bal.Sign() != 0 {
+		t.Fatal("snapshot write reached the parent before Flush")
+	}
+	if root, _ := s.Commit(true); root != base {
+		t.Fatal("snapshot changed the parent root")
+	}
+
+	// Reads of the parent do not end up in the snapshot witness
+	s.GetBalanceLYR(common.HexToAddress("0xc"))
+	witness := snap.Witness()
+	if len(witness) != 2 {
+		t.Fatalf("witness has %d keys, want 2", len(witness))
+	}
+	if witness[string(append(append([]byte{}, PrefixAccount...), b.Bytes()...))] != nil {
+		t.Fatal("witness of a new account is not empty")
+	}
+
+	// Nested snapshot, discarded
+	nested := snap.Snapshot()
+	nested.SetBalanceLYR(a, big.NewInt(1))
+	if bal := snap.GetBalanceLYR(a); bal.Int64() != 40 {
+		t.Fatal("discarded snapshot changed its parent")
+	}
+
+	staged, err := snap.Commit(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := snap.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if bal := s.GetBalanceLYR(b); bal.Int64() != 60 {
+		t.Fatalf("flushed balance is %d, want 60", bal)
+	}
+	if root, _ := s.Commit(true); root != staged {
+		t.Fatalf("flushed root %s, snapshot root %s", root.Hex(), staged.Hex())
+	}
+}
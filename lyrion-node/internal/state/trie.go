@@ -0,0 +1,150 @@
+package state
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb/database"
+)
+
+// The state root is the root of a Merkle Patricia trie (go-ethereum's) that
+// maps keccak256(key) to the raw value of every account, storage slot and
+// pool key. Writes mark their key dirty and Commit only rehashes the paths
+// of the dirty keys, so a block costs O(changes * log(state)) instead of a
+// scan of the whole state. Trie nodes are stored by hash and never pruned.
+// State keys only reach the database at Commit, in the same transaction as
+// the root that covers them.
+
+var (
+	PrefixTrie = []byte("trie-")
+	// Outside the meta- key space, so no SetMeta key can overwrite it
+	KeyStateRoot = []byte("root-state")
+	// Where the root was kept before; such a state gets its trie rebuilt
+	legacyKeyStateRoot = []byte("meta-stateroot")
+)
+
+// stateRootPrefixes are the key spaces covered by the state root. Blocks and
+// node metadata are not part of the state.
+var stateRootPrefixes = [][]byte{PrefixAccount, PrefixStorage, PrefixPool}
+
+// isStateKey reports whether key is covered by the state root.
+func isStateKey(key []byte) bool {
+	for _, prefix := range stateRootPrefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeStore serves trie nodes from Badger. Nodes are keyed by hash, so it
+// serves every state root.
+type nodeStore struct {
+	db *badger.DB
+}
+
+func (n nodeStore) NodeReader(common.Hash) (database.NodeReader, error) {
+	return n, nil
+}
+
+func (n nodeStore) Node(owner common.Hash, path []byte, hash common.Hash) ([]byte, error) {
+	var blob []byte
+	err := n.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(append(append([]byte{}, PrefixTrie...), hash[:]...))
+		if err != nil {
+			return err
+		}
+		blob, err = item.ValueCopy(nil)
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, nil
+	}
+	return blob, err
+}
+
+// updateTrie applies changed state keys (nil value = deleted) to t.
+func updateTrie(t *trie.Trie, changes map[string][]byte) error {
+	keys := make([]string, 0, len(changes))
+	for key := range changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		path := crypto.Keccak256([]byte(key))
+		var err error
+		if value := changes[key]; len(value) == 0 {
+			err = t.Delete(path)
+		} else {
+			err = t.Update(path, value)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update state trie: %v", err)
+		}
+	}
+	return nil
+}
+
+// openTrie loads the state trie at the stored root. A state written before
+// the trie existed, or before the root got its own key, gets it built once
+// from a full scan.
+func (s *BadgerStateDB) openTrie() error {
+	var root common.Hash
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(KeyStateRoot)
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			root = common.BytesToHash(val)
+			return nil
+		})
+	})
+	if err == nil {
+		s.trie, err = trie.New(trie.StateTrieID(root), nodeStore{s.db})
+		return err
+	}
+	if err != badger.ErrKeyNotFound {
+		return err
+	}
+
+	// The keys are in the database already: only the trie needs them
+	s.trie = trie.NewEmpty(nodeStore{s.db})
+	existing := make(map[string][]byte)
+	err = s.db.View(func(txn *badger.Txn) error {
+		for _, prefix := range stateRootPrefixes {
+			it := txn.NewIterator(badger.IteratorOptions{Prefix: prefix, PrefetchValues: true, PrefetchSize: 100})
+			for it.Rewind(); it.Valid(); it.Next() {
+				val, err := it.Item().ValueCopy(nil)
+				if err != nil {
+					it.Close()
+					return err
+				}
+				existing[string(it.Item().KeyCopy(nil))] = val
+			}
+			it.Close()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		log.Printf("🌳 Building the state trie over %d existing keys", len(existing))
+	}
+	if err := updateTrie(s.trie, existing); err != nil {
+		return err
+	}
+	if _, err := s.Commit(true); err != nil {
+		return err
+	}
+	return s.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(legacyKeyStateRoot)
+	})
+}
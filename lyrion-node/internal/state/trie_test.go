@@ -0,0 +1,131 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lyrion-l2/lyrion-node/internal/core"
+)
+
+// writeState makes some changes over several commits and returns the roots.
+func writeState(t *testing.T, s *BadgerStateDB) []common.Hash {
+	var roots []common.Hash
+	for i := int64(1); i <= 3; i++ {
+		for j := int64(0); j < 20; j++ {
+			addr := common.BigToAddress(big.NewInt(j))
+			s.SetBalanceLYR(addr, big.NewInt(i*j))
+			s.SetState(core.RouterAddress, common.BigToHash(big.NewInt(j)), common.BigToHash(big.NewInt(i)))
+		}
+		s.SetPool("LYR-FLR", &core.Pool{Reserve0: big.NewInt(i), Reserve1: big.NewInt(i), TotalSupply: big.NewInt(i)})
+		if err := s.SetRaw(append(append([]byte{}, PrefixAccount...), common.BigToAddress(big.NewInt(i)).Bytes()...), nil); err != nil {
+			t.Fatal(err)
+		}
+		root, err := s.Commit(true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots = append(roots, root)
+	}
+	return roots
+}
+
+// The incremental root equals the root of the same state written at once.
+func TestCommitMatchesRebuild(t *testing.T) {
+	a, _ := NewInMemoryBadgerStateDB()
+	defer a.Close()
+	roots := writeState(t, a)
+	if roots[0] == roots[1] || roots[1] == roots[2] {
+		t.Fatal("root did not change with the state")
+	}
+
+	b, _ := NewInMemoryBadgerStateDB()
+	defer b.Close()
+	err := a.db.View(func(txn *badger.Txn) error {
+		for _, prefix := range stateRootPrefixes {
+			it := txn.NewIterator(badger.IteratorOptions{Prefix: prefix})
+			for it.Rewind(); it.Valid(); it.Next() {
+				val, _ := it.Item().ValueCopy(nil)
+				b.SetRaw(it.Item().KeyCopy(nil), val)
+			}
+			it.Close()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := b.Commit(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != roots[2] {
+		t.Fatalf("rebuilt root %s, incremental %s", root.Hex(), roots[2].Hex())
+	}
+}
+
+// A reopened state keeps its root, and a state without a trie gets one
+// built with the same root.
+func TestStateTrieReopen(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewBadgerStateDB(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := writeState(t, s)
+	want := roots[len(roots)-1]
+	s.Close()
+
+	s, err = NewBadgerStateDB(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root, _ := s.Commit(true); root != want {
+		t.Fatalf("reopened root %s, want %s", root.Hex(), want.Hex())
+	}
+	s.db.Update(func(txn *badger.Txn) error { return txn.Delete(KeyStateRoot) })
+	s.Close()
+
+	s, err = NewBadgerStateDB(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if root, _ := s.Commit(true); root != want {
+		t.Fatalf("migrated root %s, want %s", root.Hex(), want.Hex())
+	}
+}
+
+// State keys reach the database only with the root that covers them: a
+// state closed before Commit reopens at the last root with the last
+// committed values.
+func TestUncommittedWritesDropped(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewBadgerStateDB(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := writeState(t, s)
+	want := roots[len(roots)-1]
+	addr := common.BigToAddress(big.NewInt(5))
+	balance := s.GetBalanceLYR(addr)
+	s.SetBalanceLYR(addr, big.NewInt(1e18))
+	if s.GetBalanceLYR(addr).Cmp(big.NewInt(1e18)) != 0 {
+		t.Fatal("staged write not visible before Commit")
+	}
+	s.SetMeta("stateroot", []byte("not a root"))
+	s.Close()
+
+	s, err = NewBadgerStateDB(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if got := s.GetBalanceLYR(addr); got.Cmp(balance) != 0 {
+		t.Fatalf("uncommitted balance %s survived a restart, want %s", got, balance)
+	}
+	if root, _ := s.Commit(true); root != want {
+		t.Fatalf("reopened root %s, want %s", root.Hex(), want.Hex())
+	}
+}
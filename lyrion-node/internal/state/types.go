@@ -52,6 +52,10 @@ type StateDB interface {
 	SetBlockHeight(height uint64)
 	GetBlockHeight() uint64
 	
+	// Node Metadata (L1 cursors, settlement progress), not part of the state root
+	GetMeta(key string) []byte
+	SetMeta(key string, value []byte) error
+	
 	// Commit writes state to the underlying DB and returns the new root.
 	Commit(deleteEmptyObjects bool) (common.Hash, error)
 }